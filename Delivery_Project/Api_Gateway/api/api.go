@@ -1,23 +1,47 @@
 package api
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"time"
+
 	"api-gateway-service/api/handler"
+	"api-gateway-service/api/response"
 	"api-gateway-service/config"
+	"api-gateway-service/pkg/helper"
+	"api-gateway-service/pkg/identity"
 
 	_ "api-gateway-service/api/docs"
 
 	"github.com/gin-gonic/gin"
+	"github.com/spf13/cast"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"google.golang.org/grpc/metadata"
 )
 
 // @securityDefinitions.apikey ApiKeyAuth
 // @in header
 // @name Authorization
 func SetUpApi(r *gin.Engine, h *handler.Handler, cfg config.Config) {
+	metrics, metricsHandler := metricsMiddleware()
+
+	// the middlewares put the request id and identity metadata on the request
+	// context, a gin context passed to a gRPC call falls back to it
+	r.ContextWithFallback = true
+
 	r.Use(customCORSMiddleware())
+	r.Use(requestIDMiddleware())
+	r.Use(identityMiddleware(cfg))
+	r.Use(metrics)
 	r.Use(MaxAllowed(500))
 
+	// probes and metrics stay outside of /v1
+	r.GET("/metrics", metricsHandler)
+	r.GET("/healthz", h.Healthz)
+	r.GET("/readyz", h.Readyz)
+
 	v1 := r.Group("/v1")
 
 	//Product service
@@ -27,6 +51,8 @@ func SetUpApi(r *gin.Engine, h *handler.Handler, cfg config.Config) {
 	v1.GET("/product/:id", h.GetProduct)
 	v1.PUT("/product/:id", h.UpdateProduct)
 	v1.DELETE("/product/:id", h.DeleteProduct)
+	v1.GET("/product/deleted", h.GetListDeletedProduct)
+	v1.POST("/product/:id/restore", h.RestoreProduct)
 
 	// category api
 	v1.POST("/category", h.CreateCategory)
@@ -34,14 +60,47 @@ func SetUpApi(r *gin.Engine, h *handler.Handler, cfg config.Config) {
 	v1.GET("/category/:id", h.GetCategory)
 	v1.PUT("/category/:id", h.UpdateCategory)
 	v1.DELETE("/category/:id", h.DeleteCategory)
+	v1.GET("/category/deleted", h.GetListDeletedCategory)
+	v1.POST("/category/:id/restore", h.RestoreCategory)
 
 	//Order service
 	// // product api
 	v1.POST("/order", h.CreateOrder)
 	v1.GET("/order", h.GetListOrder)
+	v1.GET("/order/late", h.GetListLateOrder)
 	v1.GET("/order/:id", h.GetOrder)
 	v1.PUT("/order/:id", h.UpdateOrder)
 	v1.DELETE("/order/:id", h.DeleteOrder)
+	v1.GET("/order/deleted", h.GetListDeletedOrder)
+	v1.POST("/order/:id/restore", h.RestoreOrder)
+	v1.GET("/order/:id/payment", h.GetOrderPayment)
+	v1.POST("/order/:id/payment", h.CreateOrderPayment)
+
+	// feedback api
+	v1.POST("/order/:id/feedback", h.CreateOrderFeedback)
+	v1.GET("/order/:id/feedback", h.GetOrderFeedback)
+	v1.POST("/order/:id/pickup", h.CompleteOrderPickup)
+	v1.GET("/feedback", h.GetListFeedback)
+	v1.GET("/feedback/alerts", h.GetListFeedbackAlert)
+	v1.GET("/rating/:type/:id", h.GetRating)
+
+	// payment provider callbacks
+	v1.POST("/payment/webhook/:provider", h.PaymentWebhook)
+
+	// promo_code api
+	v1.POST("/promo_code", h.CreatePromoCode)
+	v1.GET("/promo_code", h.GetListPromoCode)
+	v1.GET("/promo_code/:id", h.GetPromoCode)
+	v1.PUT("/promo_code/:id", h.UpdatePromoCode)
+	v1.DELETE("/promo_code/:id", h.DeletePromoCode)
+	v1.POST("/promo_code/apply", h.ApplyPromoCode)
+
+	// compensation_scheme api
+	v1.POST("/compensation_scheme", h.CreateCompensationScheme)
+	v1.GET("/compensation_scheme", h.GetListCompensationScheme)
+	v1.GET("/compensation_scheme/:id", h.GetCompensationScheme)
+	v1.PUT("/compensation_scheme/:id", h.UpdateCompensationScheme)
+	v1.DELETE("/compensation_scheme/:id", h.DeleteCompensationScheme)
 
 	// delivery_tariff api
 	v1.POST("/delivery_tariff", h.CreateDeliveryTariff)
@@ -49,6 +108,8 @@ func SetUpApi(r *gin.Engine, h *handler.Handler, cfg config.Config) {
 	v1.GET("/delivery_tariff/:id", h.GetDeliveryTariff)
 	v1.PUT("/delivery_tariff/:id", h.UpdateDeliveryTariff)
 	v1.DELETE("/delivery_tariff/:id", h.DeleteDeliveryTariff)
+	v1.GET("/delivery_tariff/deleted", h.GetListDeletedDeliveryTariff)
+	v1.POST("/delivery_tariff/:id/restore", h.RestoreDeliveryTariff)
 
 	//User service
 	// // branch api
@@ -57,6 +118,8 @@ func SetUpApi(r *gin.Engine, h *handler.Handler, cfg config.Config) {
 	v1.GET("/branch/:id", h.GetBranch)
 	v1.PUT("/branch/:id", h.UpdateBranch)
 	v1.DELETE("/branch/:id", h.DeleteBranch)
+	v1.GET("/branch/deleted", h.GetListDeletedBranch)
+	v1.POST("/branch/:id/restore", h.RestoreBranch)
 
 	// user api
 	v1.POST("/user", h.CreateUser)
@@ -64,6 +127,8 @@ func SetUpApi(r *gin.Engine, h *handler.Handler, cfg config.Config) {
 	v1.GET("/user/:id", h.GetUser)
 	v1.PUT("/user/:id", h.UpdateUser)
 	v1.DELETE("/user/:id", h.DeleteUser)
+	v1.GET("/user/deleted", h.GetListDeletedUser)
+	v1.POST("/user/:id/restore", h.RestoreUser)
 
 	// client api
 	v1.POST("/client", h.CreateClients)
@@ -71,6 +136,20 @@ func SetUpApi(r *gin.Engine, h *handler.Handler, cfg config.Config) {
 	v1.GET("/client/:id", h.GetClients)
 	v1.PUT("/client/:id", h.UpdateClients)
 	v1.DELETE("/client/:id", h.DeleteClients)
+	v1.GET("/client/deleted", h.GetListDeletedClients)
+	v1.POST("/client/:id/restore", h.RestoreClients)
+	v1.GET("/client/:id/bonus", h.GetClientBonusBalance)
+	v1.GET("/client/:id/bonus/statement", h.GetClientBonusStatement)
+	v1.GET("/client/:id/export", h.ExportClientData)
+	v1.GET("/client/:id/export/zip", h.ExportClientDataZip)
+	v1.POST("/client/:id/anonymize", h.AnonymizeClient)
+
+	// client address api
+	v1.POST("/client/:id/address", h.CreateClientAddress)
+	v1.GET("/client/:id/address", h.GetListClientAddress)
+	v1.GET("/client_address/:id", h.GetClientAddress)
+	v1.PUT("/client_address/:id", h.UpdateClientAddress)
+	v1.DELETE("/client_address/:id", h.DeleteClientAddress)
 
 	// courier api
 	v1.POST("/courier", h.CreateCourier)
@@ -78,6 +157,31 @@ func SetUpApi(r *gin.Engine, h *handler.Handler, cfg config.Config) {
 	v1.GET("/courier/:id", h.GetCourier)
 	v1.PUT("/courier/:id", h.UpdateCourier)
 	v1.DELETE("/courier/:id", h.DeleteCourier)
+	v1.GET("/courier/deleted", h.GetListDeletedCourier)
+	v1.POST("/courier/:id/restore", h.RestoreCourier)
+
+	// courier shift api
+	v1.POST("/courier/:id/shift/start", h.StartCourierShift)
+	v1.POST("/courier/:id/shift/end", h.EndCourierShift)
+	v1.PUT("/courier/:id/availability", h.SetCourierAvailability)
+	v1.GET("/courier/:id/shifts", h.GetListCourierShift)
+	v1.GET("/branch/:id/online_couriers", h.GetListOnlineCourier)
+
+	// kitchen api
+	v1.GET("/branch/:id/kitchen", h.GetKitchenQueue)
+	v1.GET("/branch/:id/kitchen/stream", h.WatchKitchenQueue)
+	v1.PUT("/kitchen/:id/preparing", h.StartPreparingOrder)
+	v1.PUT("/kitchen/:id/ready", h.MarkOrderReady)
+
+	// courier payout api
+	v1.GET("/courier/:id/payout", h.GetCourierPayout)
+	v1.GET("/courier/:id/payout/csv", h.ExportCourierPayout)
+
+	// courier cash api
+	v1.POST("/courier/:id/cash/handover", h.CourierCashHandover)
+	v1.GET("/courier/:id/cash/balance", h.GetCourierCashBalance)
+	v1.GET("/courier/:id/cash/transactions", h.GetCourierCashTransactions)
+	v1.GET("/courier/:id/cash/reconciliation", h.GetCourierCashReconciliation)
 
 	// Logic api
 	v1.GET("/logic", h.GetCourierOrders)
@@ -87,6 +191,9 @@ func SetUpApi(r *gin.Engine, h *handler.Handler, cfg config.Config) {
 	v1.GET("/courier/delete_order/:id", h.DeleteCourierInOrder)
 	v1.GET("/courier/get_order/:id", h.GetCourierOrders)
 
+	// Audit api
+	v1.GET("/admin/audit", h.GetListAudit)
+
 	url := ginSwagger.URL("swagger/doc.json") // The url pointing to API definition
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler, url))
 }
@@ -124,7 +231,8 @@ func customCORSMiddleware() gin.HandlerFunc {
 		c.Header("Access-Control-Allow-Origin", "*")
 		c.Header("Access-Control-Allow-Credentials", "true")
 		c.Header("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, PATCH, DELETE, HEAD")
-		c.Header("Access-Control-Allow-Headers", "Platform-Id, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
+		c.Header("Access-Control-Allow-Headers", "Platform-Id, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, X-Request-Id, Idempotency-Key, Authorization, accept, origin, Cache-Control, X-Requested-With")
+		c.Header("Access-Control-Expose-Headers", "X-Request-Id, Idempotent-Replayed, Link")
 		c.Header("Access-Control-Max-Age", "3600")
 
 		if c.Request.Method == "OPTIONS" {
@@ -135,3 +243,65 @@ func customCORSMiddleware() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// requestIDMiddleware keeps the client's X-Request-Id or makes a new one, and
+// passes it to the services in the gRPC metadata of every call
+func requestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader("X-Request-Id")
+		if id == "" {
+			b := make([]byte, 16)
+			_, _ = rand.Read(b)
+			id = hex.EncodeToString(b)
+		}
+
+		c.Header("X-Request-Id", id)
+		c.Request = c.Request.WithContext(metadata.AppendToOutgoingContext(c.Request.Context(), "x-request-id", id))
+
+		c.Next()
+	}
+}
+
+// identityMiddleware checks the bearer token of the request and passes the
+// caller to the services as a signed identity, bound to the request id.
+// Requests without a token stay anonymous.
+func identityMiddleware(cfg config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		bearer := c.GetHeader("Authorization")
+		if bearer == "" {
+			c.Next()
+			return
+		}
+
+		token, err := helper.ExtractToken(bearer)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, response.ErrorResp{Code: "UNAUTHENTICATED", Message: err.Error()})
+			return
+		}
+
+		claims, err := helper.ParseClaims(token, config.JWTSecretKey)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, response.ErrorResp{Code: "UNAUTHENTICATED", Message: "invalid token"})
+			return
+		}
+
+		id := identity.Identity{
+			UserID:    cast.ToInt32(claims.UserID),
+			Role:      claims.Role,
+			BranchID:  claims.BranchID,
+			RequestID: c.Writer.Header().Get("X-Request-Id"),
+			IssuedAt:  time.Now().Unix(),
+		}
+
+		signed, err := identity.Sign(id, cfg.IdentitySecret)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, response.ErrorResp{Code: "INTERNAL", Message: http.StatusText(http.StatusInternalServerError)})
+			return
+		}
+
+		c.Set(identity.ContextKey, id)
+		c.Request = c.Request.WithContext(metadata.AppendToOutgoingContext(c.Request.Context(), identity.MetadataKey, signed))
+
+		c.Next()
+	}
+}
@@ -0,0 +1,251 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+
+	"api-gateway-service/api/response"
+	order_service "api-gateway-service/genproto/order_service"
+	product_service "api-gateway-service/genproto/product_service"
+	user_service "api-gateway-service/genproto/user_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// auditWindow caps page*limit when all services are listed, each service is
+// asked for that many entries to merge them by time
+const auditWindow = 1000
+
+// auditFilter is the part of ListAuditRequest all services share
+type auditFilter struct {
+	Page     int32
+	Limit    int32
+	Entity   string
+	EntityId string
+	Actor    string
+	From     string
+	To       string
+}
+
+// auditSource lists the audit log of one service
+type auditSource func(ctx context.Context, f auditFilter) ([]response.AuditEntry, int32, error)
+
+// auditEntry is what the generated AuditEntry of every service has
+type auditEntry interface {
+	GetId() int64
+	GetEntity() string
+	GetEntityId() string
+	GetAction() string
+	GetActor() string
+	GetRequestId() string
+	GetBefore() string
+	GetAfter() string
+	GetCreatedAt() string
+}
+
+func auditEntries[E auditEntry](service string, entries []E) []response.AuditEntry {
+	resp := make([]response.AuditEntry, 0, len(entries))
+	for _, e := range entries {
+		resp = append(resp, response.AuditEntry{
+			Service:   service,
+			Id:        e.GetId(),
+			Entity:    e.GetEntity(),
+			EntityId:  e.GetEntityId(),
+			Action:    e.GetAction(),
+			Actor:     e.GetActor(),
+			RequestId: e.GetRequestId(),
+			Before:    rawJSON(e.GetBefore()),
+			After:     rawJSON(e.GetAfter()),
+			CreatedAt: e.GetCreatedAt(),
+		})
+	}
+	return resp
+}
+
+// rawJSON keeps a JSON column as is, an empty one is null
+func rawJSON(value string) json.RawMessage {
+	if value == "" {
+		return nil
+	}
+	return json.RawMessage(value)
+}
+
+func (h *Handler) auditSources() map[string]auditSource {
+	return map[string]auditSource{
+		"product_service": func(ctx context.Context, f auditFilter) ([]response.AuditEntry, int32, error) {
+			resp, err := h.services.ProductAuditService().List(ctx, &product_service.ListAuditRequest{
+				Page: f.Page, Limit: f.Limit, Entity: f.Entity, EntityId: f.EntityId, Actor: f.Actor, From: f.From, To: f.To,
+			})
+			if err != nil {
+				return nil, 0, err
+			}
+			return auditEntries("product_service", resp.Entries), resp.Count, nil
+		},
+		"user_service": func(ctx context.Context, f auditFilter) ([]response.AuditEntry, int32, error) {
+			resp, err := h.services.UserAuditService().List(ctx, &user_service.ListAuditRequest{
+				Page: f.Page, Limit: f.Limit, Entity: f.Entity, EntityId: f.EntityId, Actor: f.Actor, From: f.From, To: f.To,
+			})
+			if err != nil {
+				return nil, 0, err
+			}
+			return auditEntries("user_service", resp.Entries), resp.Count, nil
+		},
+		"order_service": func(ctx context.Context, f auditFilter) ([]response.AuditEntry, int32, error) {
+			resp, err := h.services.OrderAuditService().List(ctx, &order_service.ListAuditRequest{
+				Page: f.Page, Limit: f.Limit, Entity: f.Entity, EntityId: f.EntityId, Actor: f.Actor, From: f.From, To: f.To,
+			})
+			if err != nil {
+				return nil, 0, err
+			}
+			return auditEntries("order_service", resp.Entries), resp.Count, nil
+		},
+	}
+}
+
+// GetListAudit godoc
+// @Security ApiKeyAuth
+// @Router       /v1/admin/audit [get]
+// @Summary      GetAll audit log entries
+// @Description  Changes of all services newest first, for staff users only. Without service every service is listed and page*limit may not exceed 1000
+// @Tags         audit
+// @Accept       json
+// @Produce      json
+// @Param        limit      query     int     false  "limit for response"  Default(10)
+// @Param		 page       query     int     false  "page for response"   Default(1)
+// @Param        service    query     string  false  "product_service, user_service or order_service"
+// @Param        entity     query     string  false  "table of the changed row, e.g. orders"
+// @Param        entity_id  query     string  false  "id of the changed row"
+// @Param        actor      query     string  false  "role:id of the caller, e.g. user:1"
+// @Param        from       query     string  false  "2006-01-02 or 2006-01-02 15:04:05"
+// @Param        to         query     string  false  "2006-01-02 or 2006-01-02 15:04:05"
+// @Success      200  {object}  response.AuditListResp
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      403  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetListAudit(ctx *gin.Context) {
+	caller, ok := h.identity(ctx)
+	if !ok || caller.Role != "user" {
+		h.handlerResponse(ctx, "error GetListAudit", http.StatusForbidden, "only staff users can read the audit log")
+		return
+	}
+
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		h.handlerResponse(ctx, "error get page", http.StatusBadRequest, "page must be a positive number")
+		return
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	if err != nil || limit < 1 {
+		h.handlerResponse(ctx, "error get limit", http.StatusBadRequest, "limit must be a positive number")
+		return
+	}
+
+	filter := auditFilter{
+		Page:     int32(page),
+		Limit:    int32(limit),
+		Entity:   ctx.Query("entity"),
+		EntityId: ctx.Query("entity_id"),
+		Actor:    ctx.Query("actor"),
+		From:     ctx.Query("from"),
+		To:       ctx.Query("to"),
+	}
+
+	sources := h.auditSources()
+	if name := ctx.Query("service"); name != "" {
+		source, ok := sources[name]
+		if !ok {
+			h.handlerResponse(ctx, "error get service", http.StatusBadRequest, "service must be product_service, user_service or order_service")
+			return
+		}
+
+		entries, count, err := source(ctx.Request.Context(), filter)
+		if err != nil {
+			h.handlerError(ctx, "error GetListAudit", err)
+			return
+		}
+
+		h.handlerResponse(ctx, "get AllAudit response", http.StatusOK, response.AuditListResp{Entries: entries, Count: count})
+		return
+	}
+
+	if page*limit > auditWindow {
+		h.handlerResponse(ctx, "error GetListAudit", http.StatusBadRequest, "page*limit can not exceed 1000 without service, narrow the list by service or time")
+		return
+	}
+
+	resp, err := h.mergeAudit(ctx.Request.Context(), sources, filter)
+	if err != nil {
+		h.handlerError(ctx, "error GetListAudit", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get AllAudit response", http.StatusOK, resp)
+}
+
+// mergeAudit asks every service for the first page*limit entries at once and
+// cuts the page out of them merged newest first
+func (h *Handler) mergeAudit(ctx context.Context, sources map[string]auditSource, filter auditFilter) (response.AuditListResp, error) {
+	window := filter
+	window.Page = 1
+	window.Limit = filter.Page * filter.Limit
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		resp     = response.AuditListResp{Entries: make([]response.AuditEntry, 0)}
+	)
+	for _, source := range sources {
+		wg.Add(1)
+		go func(source auditSource) {
+			defer wg.Done()
+
+			entries, count, err := source(ctx, window)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			resp.Entries = append(resp.Entries, entries...)
+			resp.Count += count
+		}(source)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return response.AuditListResp{}, firstErr
+	}
+
+	sort.Slice(resp.Entries, func(i, j int) bool {
+		a, b := resp.Entries[i], resp.Entries[j]
+		if a.CreatedAt != b.CreatedAt {
+			return a.CreatedAt > b.CreatedAt
+		}
+		if a.Service != b.Service {
+			return a.Service < b.Service
+		}
+		return a.Id > b.Id
+	})
+
+	offset := int((filter.Page - 1) * filter.Limit)
+	if offset >= len(resp.Entries) {
+		resp.Entries = resp.Entries[:0]
+		return resp, nil
+	}
+	end := offset + int(filter.Limit)
+	if end > len(resp.Entries) {
+		end = len(resp.Entries)
+	}
+	resp.Entries = resp.Entries[offset:end]
+
+	return resp, nil
+}
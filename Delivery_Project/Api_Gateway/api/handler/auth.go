@@ -44,7 +44,7 @@ func (h *Handler) Login(c *gin.Context) {
 
 	if err != nil {
 		h.log.Error("error while binding:", logger.Error(err))
-		res := response.ErrorResp{Code: "BAD REQUEST", Message: "invalid fields in body"}
+		res := response.ErrorResp{Code: "INVALID_ARGUMENT", Message: "invalid fields in body"}
 		c.JSON(http.StatusBadRequest, res)
 		return
 	}
@@ -53,7 +53,7 @@ func (h *Handler) Login(c *gin.Context) {
 
 	if err != nil {
 		h.log.Error("error while binding:", logger.Error(err))
-		res := response.ErrorResp{Code: "INVALID Password", Message: "invalid password"}
+		res := response.ErrorResp{Code: "INVALID_ARGUMENT", Message: "invalid password"}
 		c.JSON(http.StatusBadRequest, res)
 		return
 	}
@@ -65,7 +65,7 @@ func (h *Handler) Login(c *gin.Context) {
 
 		if err != nil {
 			fmt.Println("error Staff GetByLoging:", err.Error())
-			res := response.ErrorResp{Code: "INTERNAL ERROR", Message: "internal server error"}
+			res := response.ErrorResp{Code: "INTERNAL", Message: "internal server error"}
 			c.JSON(http.StatusInternalServerError, res)
 			return
 		}
@@ -74,13 +74,15 @@ func (h *Handler) Login(c *gin.Context) {
 
 		if err != nil {
 			h.log.Error("error while binding:", logger.Error(err))
-			res := response.ErrorResp{Code: "INVALID Password", Message: "invalid password"}
+			res := response.ErrorResp{Code: "INVALID_ARGUMENT", Message: "invalid password"}
 			c.JSON(http.StatusBadRequest, res)
 			return
 		}
 
 		m := make(map[string]interface{})
 		m["user_id"] = resp.Id
+		m["role"] = req.Role
+		m["branch_id"] = resp.BranchId
 		token, err := helper.GenerateJWT(m, config.TokenExpireTime, config.JWTSecretKey)
 
 		if err != nil {
@@ -99,7 +101,7 @@ func (h *Handler) Login(c *gin.Context) {
 
 		if err != nil {
 			fmt.Println("error Staff GetByLoging:", err.Error())
-			res := response.ErrorResp{Code: "INTERNAL ERROR", Message: "internal server error"}
+			res := response.ErrorResp{Code: "INTERNAL", Message: "internal server error"}
 			c.JSON(http.StatusInternalServerError, res)
 			return
 		}
@@ -108,13 +110,15 @@ func (h *Handler) Login(c *gin.Context) {
 
 		if err != nil {
 			h.log.Error("error while binding:", logger.Error(err))
-			res := response.ErrorResp{Code: "INVALID Password", Message: "invalid password"}
+			res := response.ErrorResp{Code: "INVALID_ARGUMENT", Message: "invalid password"}
 			c.JSON(http.StatusBadRequest, res)
 			return
 		}
 
 		m := make(map[string]interface{})
 		m["user_id"] = resp.Id
+		m["role"] = req.Role
+		m["branch_id"] = resp.BranchId
 		token, err := helper.GenerateJWT(m, config.TokenExpireTime, config.JWTSecretKey)
 
 		if err != nil {
@@ -127,7 +131,7 @@ func (h *Handler) Login(c *gin.Context) {
 
 	} else {
 		fmt.Println("Role undefined")
-		res := response.ErrorResp{Code: "INVALID Role", Message: "invalid role"}
+		res := response.ErrorResp{Code: "INVALID_ARGUMENT", Message: "invalid role"}
 		c.JSON(http.StatusBadRequest, res)
 		return
 	}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	user_service "api-gateway-service/genproto/user_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetClientBonusBalance godoc
+// @Security ApiKeyAuth
+// @Router       /v1/client/{id}/bonus [get]
+// @Summary      Get bonus balance of a client
+// @Description  Returns how many bonus points the client can spend
+// @Tags         client
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "Client ID"
+// @Success      200  {object}  user_service.BonusBalance
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetClientBonusBalance(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error client parse id", err)
+		return
+	}
+
+	resp, err := h.services.BonusService().GetBalance(ctx.Request.Context(), &user_service.IdRequest{Id: int32(id)})
+	if err != nil {
+		h.handlerError(ctx, "error bonus GetBalance", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get bonus balance response", http.StatusOK, resp)
+}
+
+// GetClientBonusStatement godoc
+// @Security ApiKeyAuth
+// @Router       /v1/client/{id}/bonus/statement [get]
+// @Summary      Get bonus statement of a client
+// @Description  Lists bonus point transactions of the client, newest first
+// @Tags         client
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "Client ID"
+// @Param        limit    query     int  false  "limit for response"  Default(10)
+// @Param		 page     query     int  false  "page for response"   Default(1)
+// @Param        created_at_from     query     string false "search by created_at_from"
+// @Param        created_at_to     query     string false "search by created_at_to"
+// @Success      200  {object}  user_service.BonusStatementResponse
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetClientBonusStatement(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error client parse id", err)
+		return
+	}
+
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		h.handlerError(ctx, "error get page", err)
+		return
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	if err != nil {
+		h.handlerError(ctx, "error get limit", err)
+		return
+	}
+
+	resp, err := h.services.BonusService().GetStatement(ctx.Request.Context(), &user_service.BonusStatementRequest{
+		ClientId:      int32(id),
+		Page:          int32(page),
+		Limit:         int32(limit),
+		CreatedAtFrom: ctx.Query("created_at_from"),
+		CreatedAtTo:   ctx.Query("created_at_to"),
+	})
+	if err != nil {
+		h.handlerError(ctx, "error bonus GetStatement", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get bonus statement response", http.StatusOK, resp)
+}
@@ -19,19 +19,19 @@ import (
 // @Produce      json
 // @Param        branch     body  user_service.CreateBranchRequest true  "data of the branch"
 // @Success      201  {object}  user_service.Response
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) CreateBranch(ctx *gin.Context) {
 	var branch = user_service.CreateBranchRequest{}
 
 	err := ctx.ShouldBindJSON(&branch)
 	if err != nil {
-		h.handlerResponse(ctx, "CreateBranch", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "CreateBranch", err)
 		return
 	}
 
-	resp, err := h.services.BranchService().Create(ctx, &user_service.CreateBranchRequest{
+	resp, err := h.services.BranchService().Create(ctx.Request.Context(), &user_service.CreateBranchRequest{
 		Name:            branch.Name,
 		Photo:           branch.Photo,
 		Phone:           branch.Phone,
@@ -43,7 +43,7 @@ func (h *Handler) CreateBranch(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		h.handlerResponse(ctx, "BranchService().Create", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "BranchService().Create", err)
 
 		return
 	}
@@ -65,19 +65,19 @@ func (h *Handler) CreateBranch(ctx *gin.Context) {
 // @Param        created_at_from     query     string false "search by created_at_from"
 // @Param        created_at_to     query     string false "search by created_at_to"
 // @Success      200  {array}   user_service.ListBranchResponse
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) GetListBranch(ctx *gin.Context) {
 	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
 	if err != nil {
-		h.handlerResponse(ctx, "error get page", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error get page", err)
 		return
 	}
 
 	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
 	if err != nil {
-		h.handlerResponse(ctx, "error get limit", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error get limit", err)
 		return
 	}
 
@@ -90,7 +90,7 @@ func (h *Handler) GetListBranch(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		h.handlerResponse(ctx, "error GetListBranch", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error GetListBranch", err)
 		return
 	}
 
@@ -107,20 +107,20 @@ func (h *Handler) GetListBranch(ctx *gin.Context) {
 // @Produce      json
 // @Param        id   path    string     true    "Branch ID to retrieve"
 // @Success      200  {object}  user_service.Branch
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) GetBranch(ctx *gin.Context) {
 	idStr := ctx.Param("id")
 
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
-		h.handlerResponse(ctx, "error branch GetById", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error branch GetById", err)
 		return
 	}
 	resp, err := h.services.BranchService().Get(ctx.Request.Context(), &user_service.IdRequest{Id: int32(id)})
 	if err != nil {
-		h.handlerResponse(ctx, "error branch GetById", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error branch GetById", err)
 		return
 	}
 
@@ -138,16 +138,16 @@ func (h *Handler) GetBranch(ctx *gin.Context) {
 // @Param        id       path    int     true    "Branch ID to update"
 // @Param        branch   body    user_service.UpdateBranchRequest  true    "Updated data for the branch"
 // @Success      200  {object}  user_service.UpdateBranchRequest
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) UpdateBranch(ctx *gin.Context) {
 	var branch = user_service.UpdateBranchRequest{}
 	id, err := strconv.Atoi(ctx.Param("id"))
 
 	err = ctx.ShouldBind(&branch)
 	if err != nil {
-		h.handlerResponse(ctx, "error while binding", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error while binding", err)
 		return
 	}
 
@@ -156,7 +156,7 @@ func (h *Handler) UpdateBranch(ctx *gin.Context) {
 	resp, err := h.services.BranchService().Update(ctx.Request.Context(), &branch)
 	fmt.Println("before  send bind", resp)
 	if err != nil {
-		h.handlerResponse(ctx, "error user Update", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error user Update", err)
 		return
 	}
 
@@ -173,23 +173,91 @@ func (h *Handler) UpdateBranch(ctx *gin.Context) {
 // @Produce      json
 // @Param        id   path    int     true    "Catgeory ID to retrieve"
 // @Success      200  {object}  user_service.Response
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) DeleteBranch(ctx *gin.Context) {
 	idStr := ctx.Param("id")
 
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
-		h.handlerResponse(ctx, "error branch Delete", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error branch Delete", err)
 		return
 	}
 
 	resp, err := h.services.BranchService().Delete(ctx.Request.Context(), &user_service.IdRequest{Id: int32(id)})
 	if err != nil {
-		h.handlerResponse(ctx, "error branch Delete", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error branch Delete", err)
 		return
 	}
 
 	h.handlerResponse(ctx, "delete branch response", http.StatusOK, resp)
 }
+
+// RestoreBranch godoc
+// @Security ApiKeyAuth
+// @Router       /v1/branch/{id}/restore [post]
+// @Summary      Restore a deleted branch
+// @Description  Undoes the delete of a branch that is not purged yet
+// @Tags         branch
+// @Accept       json
+// @Produce      json
+// @Param        id   path    int     true    "ID of the deleted branch"
+// @Success      200  {object}  user_service.Response
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      409  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) RestoreBranch(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error branch Restore", err)
+		return
+	}
+
+	resp, err := h.services.BranchService().Restore(ctx.Request.Context(), &user_service.IdRequest{Id: int32(id)})
+	if err != nil {
+		h.handlerError(ctx, "error branch Restore", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "restore branch response", http.StatusOK, resp)
+}
+
+// GetListDeletedBranch godoc
+// @Security ApiKeyAuth
+// @Router       /v1/branch/deleted [get]
+// @Summary      GetAll deleted branches
+// @Description  Deleted branches that can still be restored, they are purged once the retention is over
+// @Tags         branch
+// @Accept       json
+// @Produce      json
+// @Param        limit    query     int  false  "limit for response"  Default(10)
+// @Param		 page     query     int  false  "page for response"   Default(1)
+// @Success      200  {object}  user_service.ListBranchResponse
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetListDeletedBranch(ctx *gin.Context) {
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		h.handlerError(ctx, "error get page", err)
+		return
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	if err != nil {
+		h.handlerError(ctx, "error get limit", err)
+		return
+	}
+
+	resp, err := h.services.BranchService().ListDeleted(ctx.Request.Context(), &user_service.ListDeletedRequest{
+		Page:  int32(page),
+		Limit: int32(limit),
+	})
+	if err != nil {
+		h.handlerError(ctx, "error GetListDeletedBranch", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get deleted branch response", http.StatusOK, resp)
+}
@@ -19,19 +19,19 @@ import (
 // @Produce      json
 // @Param        category     body  product_service.CreateCategoryRequest true  "data of the category"
 // @Success      201  {object}  product_service.Response
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) CreateCategory(ctx *gin.Context) {
 	var category = product_service.CreateCategoryRequest{}
 
 	err := ctx.ShouldBindJSON(&category)
 	if err != nil {
-		h.handlerResponse(ctx, "CreateCategory", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "CreateCategory", err)
 		return
 	}
 
-	resp, err := h.services.CategoryService().Create(ctx, &product_service.CreateCategoryRequest{
+	resp, err := h.services.CategoryService().Create(ctx.Request.Context(), &product_service.CreateCategoryRequest{
 		Title:       category.Title,
 		Image:       category.Image,
 		ParentId:    category.ParentId,
@@ -39,7 +39,7 @@ func (h *Handler) CreateCategory(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		h.handlerResponse(ctx, "CatgeoryService().Create", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "CatgeoryService().Create", err)
 
 		return
 	}
@@ -59,19 +59,19 @@ func (h *Handler) CreateCategory(ctx *gin.Context) {
 // @Param		 page     query     int  false  "page for response"   Default(1)
 // @Param        title     query     string false "search by title"
 // @Success      200  {array}   product_service.ListCategoryResponse
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) GetListCategory(ctx *gin.Context) {
 	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
 	if err != nil {
-		h.handlerResponse(ctx, "error get page", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error get page", err)
 		return
 	}
 
 	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
 	if err != nil {
-		h.handlerResponse(ctx, "error get limit", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error get limit", err)
 		return
 	}
 
@@ -82,7 +82,7 @@ func (h *Handler) GetListCategory(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		h.handlerResponse(ctx, "error GetListCategory", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error GetListCategory", err)
 		return
 	}
 
@@ -99,15 +99,15 @@ func (h *Handler) GetListCategory(ctx *gin.Context) {
 // @Produce      json
 // @Param        id   path    string     true    "Category ID to retrieve"
 // @Success      200  {object}  product_service.Category
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) GetCategory(ctx *gin.Context) {
 	id := ctx.Param("id")
 
 	resp, err := h.services.CategoryService().Get(ctx.Request.Context(), &product_service.IdRequest{Id: id})
 	if err != nil {
-		h.handlerResponse(ctx, "error category GetById", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error category GetById", err)
 		return
 	}
 
@@ -125,16 +125,16 @@ func (h *Handler) GetCategory(ctx *gin.Context) {
 // @Param        id       path    int     true    "Category ID to update"
 // @Param        category   body    product_service.UpdateCategoryRequest  true    "Updated data for the category"
 // @Success      200  {object}  product_service.UpdateCategoryRequest
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) UpdateCategory(ctx *gin.Context) {
 	var category = product_service.UpdateCategoryRequest{}
 	id, err := strconv.Atoi(ctx.Param("id"))
 
 	err = ctx.ShouldBind(&category)
 	if err != nil {
-		h.handlerResponse(ctx, "error while binding", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error while binding", err)
 		return
 	}
 
@@ -143,7 +143,7 @@ func (h *Handler) UpdateCategory(ctx *gin.Context) {
 	resp, err := h.services.CategoryService().Update(ctx.Request.Context(), &category)
 	fmt.Println("before  send bind", resp)
 	if err != nil {
-		h.handlerResponse(ctx, "error product Update", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error product Update", err)
 		return
 	}
 
@@ -160,17 +160,81 @@ func (h *Handler) UpdateCategory(ctx *gin.Context) {
 // @Produce      json
 // @Param        id   path    string     true    "Catgeory ID to retrieve"
 // @Success      200  {object}  product_service.Response
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) DeleteCategory(ctx *gin.Context) {
 	id := ctx.Param("id")
 
 	resp, err := h.services.CategoryService().Delete(ctx.Request.Context(), &product_service.IdRequest{Id: id})
 	if err != nil {
-		h.handlerResponse(ctx, "error category Delete", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error category Delete", err)
 		return
 	}
 
 	h.handlerResponse(ctx, "delete category response", http.StatusOK, resp)
 }
+
+// RestoreCategory godoc
+// @Security ApiKeyAuth
+// @Router       /v1/category/{id}/restore [post]
+// @Summary      Restore a deleted category
+// @Description  Undoes the delete of a category that is not purged yet
+// @Tags         category
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "ID of the deleted category"
+// @Success      200  {object}  product_service.Response
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      409  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) RestoreCategory(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	resp, err := h.services.CategoryService().Restore(ctx.Request.Context(), &product_service.IdRequest{Id: id})
+	if err != nil {
+		h.handlerError(ctx, "error category Restore", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "restore category response", http.StatusOK, resp)
+}
+
+// GetListDeletedCategory godoc
+// @Security ApiKeyAuth
+// @Router       /v1/category/deleted [get]
+// @Summary      GetAll deleted categories
+// @Description  Deleted categories that can still be restored, they are purged once the retention is over
+// @Tags         category
+// @Accept       json
+// @Produce      json
+// @Param        limit    query     int  false  "limit for response"  Default(10)
+// @Param		 page     query     int  false  "page for response"   Default(1)
+// @Success      200  {object}  product_service.ListCategoryResponse
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetListDeletedCategory(ctx *gin.Context) {
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		h.handlerError(ctx, "error get page", err)
+		return
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	if err != nil {
+		h.handlerError(ctx, "error get limit", err)
+		return
+	}
+
+	resp, err := h.services.CategoryService().ListDeleted(ctx.Request.Context(), &product_service.ListDeletedRequest{
+		Page:  int32(page),
+		Limit: int32(limit),
+	})
+	if err != nil {
+		h.handlerError(ctx, "error GetListDeletedCategory", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get deleted category response", http.StatusOK, resp)
+}
@@ -19,19 +19,19 @@ import (
 // @Produce      json
 // @Param        client     body  user_service.CreateClientsRequest true  "data of the client"
 // @Success      201  {object}  user_service.Response
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) CreateClients(ctx *gin.Context) {
 	var client = user_service.CreateClientsRequest{}
 
 	err := ctx.ShouldBindJSON(&client)
 	if err != nil {
-		h.handlerResponse(ctx, "CreateClients", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "CreateClients", err)
 		return
 	}
 
-	resp, err := h.services.ClientService().Create(ctx, &user_service.CreateClientsRequest{
+	resp, err := h.services.ClientService().Create(ctx.Request.Context(), &user_service.CreateClientsRequest{
 		Firstname:      client.Firstname,
 		Lastname:       client.Lastname,
 		Phone:          client.Phone,
@@ -42,7 +42,7 @@ func (h *Handler) CreateClients(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		h.handlerResponse(ctx, "ClientsService().Create", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "ClientsService().Create", err)
 
 		return
 	}
@@ -72,20 +72,22 @@ func (h *Handler) CreateClients(ctx *gin.Context) {
 // @Param        discount_type    query     string false "search by discount_type"
 // @Param        discount_from    query     string false "search by discount_from"
 // @Param        discount_to    query     string false "search by discount_to"
+// @Param        cursor    query     string false "next_cursor of the previous page, page is ignored with it"
+// @Param        with_count    query     bool false "count the clients with a cursor too"
 // @Success      200  {array}   user_service.ListClientsResponse
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) GetListClients(ctx *gin.Context) {
 	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
 	if err != nil {
-		h.handlerResponse(ctx, "error get page", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error get page", err)
 		return
 	}
 
 	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
 	if err != nil {
-		h.handlerResponse(ctx, "error get limit", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error get limit", err)
 		return
 	}
 
@@ -127,13 +129,16 @@ func (h *Handler) GetListClients(ctx *gin.Context) {
 		DiscountType:         ctx.Query("dicount_type"),
 		DiscountAmountFrom:   ctx.Query("discount_amount_from"),
 		DiscountAmountTo:     ctx.Query("discount_amount_to"),
+		Cursor:               ctx.Query("cursor"),
+		WithCount:            ctx.Query("with_count") == "true",
 	})
 
 	if err != nil {
-		h.handlerResponse(ctx, "error GetListClients", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error GetListClients", err)
 		return
 	}
 
+	h.setNextLink(ctx, resp.NextCursor)
 	h.handlerResponse(ctx, "get AllClients response", http.StatusOK, resp)
 }
 
@@ -147,20 +152,20 @@ func (h *Handler) GetListClients(ctx *gin.Context) {
 // @Produce      json
 // @Param        id   path    string     true    "Clients ID to retrieve"
 // @Success      200  {object}  user_service.Clients
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) GetClients(ctx *gin.Context) {
 	idStr := ctx.Param("id")
 
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
-		h.handlerResponse(ctx, "error branch GetById", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error branch GetById", err)
 		return
 	}
 	resp, err := h.services.ClientService().Get(ctx.Request.Context(), &user_service.IdRequest{Id: int32(id)})
 	if err != nil {
-		h.handlerResponse(ctx, "error client GetById", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error client GetById", err)
 		return
 	}
 
@@ -178,16 +183,16 @@ func (h *Handler) GetClients(ctx *gin.Context) {
 // @Param        id       path    int     true    "Clients ID to update"
 // @Param        client   body    user_service.UpdateClientsRequest  true    "Updated data for the client"
 // @Success      200  {object}  user_service.UpdateClientsRequest
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) UpdateClients(ctx *gin.Context) {
 	var client = user_service.UpdateClientsRequest{}
 	id, err := strconv.Atoi(ctx.Param("id"))
 
 	err = ctx.ShouldBind(&client)
 	if err != nil {
-		h.handlerResponse(ctx, "error while binding", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error while binding", err)
 		return
 	}
 
@@ -196,7 +201,7 @@ func (h *Handler) UpdateClients(ctx *gin.Context) {
 	resp, err := h.services.ClientService().Update(ctx.Request.Context(), &client)
 	fmt.Println("before  send bind", resp)
 	if err != nil {
-		h.handlerResponse(ctx, "error user Update", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error user Update", err)
 		return
 	}
 
@@ -213,22 +218,90 @@ func (h *Handler) UpdateClients(ctx *gin.Context) {
 // @Produce      json
 // @Param        id   path    string     true    "Catgeory ID to retrieve"
 // @Success      200  {object}  user_service.Response
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) DeleteClients(ctx *gin.Context) {
 	idStr := ctx.Param("id")
 
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
-		h.handlerResponse(ctx, "error branch GetById", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error branch GetById", err)
 		return
 	}
 	resp, err := h.services.ClientService().Delete(ctx.Request.Context(), &user_service.IdRequest{Id: int32(id)})
 	if err != nil {
-		h.handlerResponse(ctx, "error client Delete", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error client Delete", err)
 		return
 	}
 
 	h.handlerResponse(ctx, "delete client response", http.StatusOK, resp)
 }
+
+// RestoreClients godoc
+// @Security ApiKeyAuth
+// @Router       /v1/client/{id}/restore [post]
+// @Summary      Restore a deleted client
+// @Description  Undoes the delete of a client that is not purged yet
+// @Tags         client
+// @Accept       json
+// @Produce      json
+// @Param        id   path    int     true    "ID of the deleted client"
+// @Success      200  {object}  user_service.Response
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      409  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) RestoreClients(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error client Restore", err)
+		return
+	}
+
+	resp, err := h.services.ClientService().Restore(ctx.Request.Context(), &user_service.IdRequest{Id: int32(id)})
+	if err != nil {
+		h.handlerError(ctx, "error client Restore", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "restore client response", http.StatusOK, resp)
+}
+
+// GetListDeletedClients godoc
+// @Security ApiKeyAuth
+// @Router       /v1/client/deleted [get]
+// @Summary      GetAll deleted clients
+// @Description  Deleted clients that can still be restored, they are purged once the retention is over
+// @Tags         client
+// @Accept       json
+// @Produce      json
+// @Param        limit    query     int  false  "limit for response"  Default(10)
+// @Param		 page     query     int  false  "page for response"   Default(1)
+// @Success      200  {object}  user_service.ListClientsResponse
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetListDeletedClients(ctx *gin.Context) {
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		h.handlerError(ctx, "error get page", err)
+		return
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	if err != nil {
+		h.handlerError(ctx, "error get limit", err)
+		return
+	}
+
+	resp, err := h.services.ClientService().ListDeleted(ctx.Request.Context(), &user_service.ListDeletedRequest{
+		Page:  int32(page),
+		Limit: int32(limit),
+	})
+	if err != nil {
+		h.handlerError(ctx, "error GetListDeletedClients", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get deleted client response", http.StatusOK, resp)
+}
@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	user_service "api-gateway-service/genproto/user_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreateClientAddress godoc
+// @Security ApiKeyAuth
+// @Router       /v1/client/{id}/address [post]
+// @Summary      Save an address of a client
+// @Description  Adds an address to the address book of the client, client_id is taken from the path
+// @Tags         client_address
+// @Accept       json
+// @Produce      json
+// @Param        id       path    string  true  "Client ID"
+// @Param        address  body    user_service.CreateClientAddressRequest  true  "data of the address"
+// @Success      201  {object}  user_service.ClientAddress
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) CreateClientAddress(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error client parse id", err)
+		return
+	}
+
+	var address user_service.CreateClientAddressRequest
+	if err = ctx.ShouldBindJSON(&address); err != nil {
+		h.handlerError(ctx, "error while binding client address", err)
+		return
+	}
+	address.ClientId = int32(id)
+
+	resp, err := h.services.ClientAddressService().Create(ctx.Request.Context(), &address)
+	if err != nil {
+		h.handlerError(ctx, "error client address Create", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "create client address response", http.StatusOK, resp)
+}
+
+// GetListClientAddress godoc
+// @Security ApiKeyAuth
+// @Router       /v1/client/{id}/address [get]
+// @Summary      Get saved addresses of a client
+// @Description  Lists the address book of the client, newest first
+// @Tags         client_address
+// @Accept       json
+// @Produce      json
+// @Param        id       path    string  true  "Client ID"
+// @Param        limit    query     int  false  "limit for response"  Default(10)
+// @Param		 page     query     int  false  "page for response"   Default(1)
+// @Success      200  {object}  user_service.ListClientAddressResponse
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetListClientAddress(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error client parse id", err)
+		return
+	}
+
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		h.handlerError(ctx, "error get page", err)
+		return
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	if err != nil {
+		h.handlerError(ctx, "error get limit", err)
+		return
+	}
+
+	resp, err := h.services.ClientAddressService().List(ctx.Request.Context(), &user_service.ListClientAddressRequest{
+		ClientId: int32(id),
+		Page:     int32(page),
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		h.handlerError(ctx, "error client address GetList", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get list client address response", http.StatusOK, resp)
+}
+
+// GetClientAddress godoc
+// @Security ApiKeyAuth
+// @Router       /v1/client_address/{id} [get]
+// @Summary      Get a saved address by ID
+// @Description  Retrieve a saved client address by its unique identifier
+// @Tags         client_address
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "Address ID"
+// @Success      200  {object}  user_service.ClientAddress
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetClientAddress(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error client address parse id", err)
+		return
+	}
+
+	resp, err := h.services.ClientAddressService().Get(ctx.Request.Context(), &user_service.IdRequest{Id: int32(id)})
+	if err != nil {
+		h.handlerError(ctx, "error client address GetById", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get client address response", http.StatusOK, resp)
+}
+
+// UpdateClientAddress godoc
+// @Security ApiKeyAuth
+// @Router       /v1/client_address/{id} [put]
+// @Summary      Update a saved address
+// @Description  Orders created earlier keep the address they were created with
+// @Tags         client_address
+// @Accept       json
+// @Produce      json
+// @Param        id       path    string  true  "Address ID"
+// @Param        address  body    user_service.UpdateClientAddressRequest  true  "Updated data of the address"
+// @Success      200  {object}  user_service.ClientAddress
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) UpdateClientAddress(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error client address parse id", err)
+		return
+	}
+
+	var address user_service.UpdateClientAddressRequest
+	if err = ctx.ShouldBindJSON(&address); err != nil {
+		h.handlerError(ctx, "error while binding client address", err)
+		return
+	}
+	address.Id = int32(id)
+
+	resp, err := h.services.ClientAddressService().Update(ctx.Request.Context(), &address)
+	if err != nil {
+		h.handlerError(ctx, "error client address Update", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "update client address response", http.StatusOK, resp)
+}
+
+// DeleteClientAddress godoc
+// @Security ApiKeyAuth
+// @Router       /v1/client_address/{id} [delete]
+// @Summary      Delete a saved address
+// @Description  Removes the address from the address book of the client
+// @Tags         client_address
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "Address ID"
+// @Success      200  {object}  user_service.Response
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) DeleteClientAddress(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error client address parse id", err)
+		return
+	}
+
+	resp, err := h.services.ClientAddressService().Delete(ctx.Request.Context(), &user_service.IdRequest{Id: int32(id)})
+	if err != nil {
+		h.handlerError(ctx, "error client address Delete", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "delete client address response", http.StatusOK, resp)
+}
@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"api-gateway-service/api/response"
+	order_service "api-gateway-service/genproto/order_service"
+	user_service "api-gateway-service/genproto/user_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ExportClientData godoc
+// @Security ApiKeyAuth
+// @Router       /v1/client/{id}/export [get]
+// @Summary      Export all data of a client
+// @Description  Personal data, addresses, bonuses, orders, payments and feedback of the client, deleted orders included. For staff users only
+// @Tags         client
+// @Accept       json
+// @Produce      json
+// @Param        id   path    int     true    "Client ID"
+// @Success      200  {object}  response.ClientDataExport
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      403  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) ExportClientData(ctx *gin.Context) {
+	resp, ok := h.exportClientData(ctx)
+	if !ok {
+		return
+	}
+
+	h.handlerResponse(ctx, "export client data response", http.StatusOK, resp)
+}
+
+// ExportClientDataZip godoc
+// @Security ApiKeyAuth
+// @Router       /v1/client/{id}/export/zip [get]
+// @Summary      Export all data of a client as ZIP
+// @Description  The export of /v1/client/{id}/export split into one JSON file per kind of data. For staff users only
+// @Tags         client
+// @Produce      application/zip
+// @Param        id   path    int     true    "Client ID"
+// @Success      200  {file}  file
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      403  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) ExportClientDataZip(ctx *gin.Context) {
+	resp, ok := h.exportClientData(ctx)
+	if !ok {
+		return
+	}
+
+	files := []struct {
+		name string
+		data interface{}
+	}{
+		{"client.json", resp.User.Client},
+		{"addresses.json", resp.User.Addresses},
+		{"bonus.json", gin.H{"balance": resp.User.BonusBalance, "transactions": resp.User.BonusTransactions}},
+		{"orders.json", resp.Orders.Orders},
+		{"payments.json", resp.Orders.Payments},
+		{"feedback.json", resp.Orders.Feedback},
+	}
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, f := range files {
+		file, err := w.Create(f.name)
+		if err != nil {
+			h.handlerError(ctx, "error writing client data zip", err)
+			return
+		}
+
+		enc := json.NewEncoder(file)
+		enc.SetIndent("", "  ")
+		if err = enc.Encode(f.data); err != nil {
+			h.handlerError(ctx, "error writing client data zip", err)
+			return
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		h.handlerError(ctx, "error writing client data zip", err)
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=client_%s.zip", ctx.Param("id")))
+	ctx.Data(http.StatusOK, "application/zip", buf.Bytes())
+}
+
+// AnonymizeClient godoc
+// @Security ApiKeyAuth
+// @Router       /v1/client/{id}/anonymize [post]
+// @Summary      Anonymize a client
+// @Description  Scrubs the personal data of the client and the addresses of their orders and deletes the client. Order prices and totals are kept for reports. For staff users only
+// @Tags         client
+// @Accept       json
+// @Produce      json
+// @Param        id   path    int     true    "Client ID"
+// @Success      200  {object}  user_service.Response
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      403  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) AnonymizeClient(ctx *gin.Context) {
+	id, ok := h.clientDataId(ctx, "error AnonymizeClient")
+	if !ok {
+		return
+	}
+
+	// orders go first, the client can not be found anymore once it is
+	// anonymized, and a failed call is safe to repeat
+	_, err := h.services.ClientOrdersService().Anonymize(ctx.Request.Context(), &order_service.ClientIdRequest{ClientId: id})
+	if err != nil {
+		h.handlerError(ctx, "error ClientOrdersService().Anonymize", err)
+		return
+	}
+
+	resp, err := h.services.ClientDataService().Anonymize(ctx.Request.Context(), &user_service.IdRequest{Id: id})
+	if err != nil {
+		h.handlerError(ctx, "error ClientDataService().Anonymize", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "anonymize client response", http.StatusOK, resp)
+}
+
+func (h *Handler) exportClientData(ctx *gin.Context) (*response.ClientDataExport, bool) {
+	id, ok := h.clientDataId(ctx, "error ExportClientData")
+	if !ok {
+		return nil, false
+	}
+
+	user, err := h.services.ClientDataService().Export(ctx.Request.Context(), &user_service.IdRequest{Id: id})
+	if err != nil {
+		h.handlerError(ctx, "error ClientDataService().Export", err)
+		return nil, false
+	}
+
+	orders, err := h.services.ClientOrdersService().Export(ctx.Request.Context(), &order_service.ClientIdRequest{ClientId: id})
+	if err != nil {
+		h.handlerError(ctx, "error ClientOrdersService().Export", err)
+		return nil, false
+	}
+
+	return &response.ClientDataExport{User: user, Orders: orders}, true
+}
+
+// clientDataId checks that the caller is a staff user and parses the client id
+func (h *Handler) clientDataId(ctx *gin.Context, path string) (int32, bool) {
+	caller, ok := h.identity(ctx)
+	if !ok || caller.Role != "user" {
+		h.handlerResponse(ctx, path, http.StatusForbidden, "only staff users can export or anonymize client data")
+		return 0, false
+	}
+
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil || id < 1 {
+		h.handlerResponse(ctx, path, http.StatusBadRequest, "id must be a positive number")
+		return 0, false
+	}
+
+	return int32(id), true
+}
@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	order_service "api-gateway-service/genproto/order_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreateCompensationScheme godoc
+// @Security ApiKeyAuth
+// @Router       /v1/compensation_scheme [post]
+// @Summary      Create a new compensation_scheme
+// @Description  Create a courier compensation scheme, branch_id 0 is the default for all branches
+// @Tags         compensation_scheme
+// @Accept       json
+// @Produce      json
+// @Param        compensation_scheme     body  order_service.CreateCompensationSchemeRequest true  "data of the compensation_scheme"
+// @Success      201  {object}  order_service.Response
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) CreateCompensationScheme(ctx *gin.Context) {
+	var scheme = order_service.CreateCompensationSchemeRequest{}
+
+	err := ctx.ShouldBindJSON(&scheme)
+	if err != nil {
+		h.handlerError(ctx, "CreateCompensationScheme", err)
+		return
+	}
+
+	resp, err := h.services.CompensationSchemeService().Create(ctx.Request.Context(), &scheme)
+	if err != nil {
+		h.handlerError(ctx, "CompensationSchemeService().Create", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "create compensation_scheme response", http.StatusOK, resp)
+}
+
+// GetListCompensationScheme godoc
+// @Security ApiKeyAuth
+// @Router       /v1/compensation_scheme [get]
+// @Summary      GetAll CompensationScheme
+// @Description  get compensation_scheme
+// @Tags         compensation_scheme
+// @Accept       json
+// @Produce      json
+// @Param        limit    query     int  false  "limit for response"  Default(10)
+// @Param		 page     query     int  false  "page for response"   Default(1)
+// @Param        search     query     string false "search by name"
+// @Success      200  {array}   order_service.ListCompensationSchemeResponse
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetListCompensationScheme(ctx *gin.Context) {
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		h.handlerError(ctx, "error get page", err)
+		return
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	if err != nil {
+		h.handlerError(ctx, "error get limit", err)
+		return
+	}
+
+	resp, err := h.services.CompensationSchemeService().List(ctx.Request.Context(), &order_service.ListCompensationSchemeRequest{
+		Page:   int32(page),
+		Limit:  int32(limit),
+		Search: ctx.Query("search"),
+	})
+	if err != nil {
+		h.handlerError(ctx, "error GetListCompensationScheme", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get AllCompensationScheme response", http.StatusOK, resp)
+}
+
+// GetCompensationScheme godoc
+// @Security ApiKeyAuth
+// @Router       /v1/compensation_scheme/{id} [get]
+// @Summary      Get a compensation_scheme by ID
+// @Description  Retrieve a compensation_scheme by its unique identifier
+// @Tags         compensation_scheme
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "CompensationScheme ID to retrieve"
+// @Success      200  {object}  order_service.CompensationScheme
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetCompensationScheme(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error compensation_scheme parse id", err)
+		return
+	}
+
+	resp, err := h.services.CompensationSchemeService().Get(ctx.Request.Context(), &order_service.IdRequest{Id: int32(id)})
+	if err != nil {
+		h.handlerError(ctx, "error compensation_scheme GetById", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get compensation_scheme response", http.StatusOK, resp)
+}
+
+// UpdateCompensationScheme godoc
+// @Security ApiKeyAuth
+// @Router       /v1/compensation_scheme/{id} [put]
+// @Summary      Update an existing compensation_scheme
+// @Description  Update an existing compensation_scheme, peak hours are replaced with the given list
+// @Tags         compensation_scheme
+// @Accept       json
+// @Produce      json
+// @Param        id       path    int     true    "CompensationScheme ID to update"
+// @Param        compensation_scheme   body    order_service.UpdateCompensationSchemeRequest  true    "Updated data for the compensation_scheme"
+// @Success      200  {object}  order_service.Response
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) UpdateCompensationScheme(ctx *gin.Context) {
+	var scheme = order_service.UpdateCompensationSchemeRequest{}
+
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error compensation_scheme parse id", err)
+		return
+	}
+
+	err = ctx.ShouldBindJSON(&scheme)
+	if err != nil {
+		h.handlerError(ctx, "error while binding", err)
+		return
+	}
+
+	scheme.Id = int32(id)
+
+	resp, err := h.services.CompensationSchemeService().Update(ctx.Request.Context(), &scheme)
+	if err != nil {
+		h.handlerError(ctx, "error compensation_scheme Update", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "update compensation_scheme response", http.StatusOK, resp)
+}
+
+// DeleteCompensationScheme godoc
+// @Security ApiKeyAuth
+// @Router       /v1/compensation_scheme/{id} [delete]
+// @Summary      Delete a compensation_scheme
+// @Description  delete a compensation_scheme by its unique identifier
+// @Tags         compensation_scheme
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "CompensationScheme ID to delete"
+// @Success      200  {object}  order_service.Response
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) DeleteCompensationScheme(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error compensation_scheme parse id", err)
+		return
+	}
+
+	resp, err := h.services.CompensationSchemeService().Delete(ctx.Request.Context(), &order_service.IdRequest{Id: int32(id)})
+	if err != nil {
+		h.handlerError(ctx, "error compensation_scheme Delete", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "delete compensation_scheme response", http.StatusOK, resp)
+}
@@ -21,15 +21,15 @@ import (
 // @Produce      json
 // @Param        courier     body  user_service.CreateCouriersRequest true  "data of the courier"
 // @Success      201  {object}  user_service.Response
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) CreateCourier(ctx *gin.Context) {
 	var courier = user_service.CreateCouriersRequest{}
 
 	err := ctx.ShouldBindJSON(&courier)
 	if err != nil {
-		h.handlerResponse(ctx, "CreateCourier", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "CreateCourier", err)
 		return
 	}
 
@@ -41,7 +41,7 @@ func (h *Handler) CreateCourier(ctx *gin.Context) {
 	}
 	courier.Password = string(hashedPass)
 
-	resp, err := h.services.CourierService().Create(ctx, &user_service.CreateCouriersRequest{
+	resp, err := h.services.CourierService().Create(ctx.Request.Context(), &user_service.CreateCouriersRequest{
 		Firstname:     courier.Firstname,
 		Lastname:      courier.Lastname,
 		BranchId:      courier.BranchId,
@@ -52,7 +52,7 @@ func (h *Handler) CreateCourier(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		h.handlerResponse(ctx, "CatgeoryService().Create", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "CatgeoryService().Create", err)
 
 		return
 	}
@@ -74,19 +74,19 @@ func (h *Handler) CreateCourier(ctx *gin.Context) {
 // @Param        created_at_from     query     string false "search by created_at_from"
 // @Param        created_at_to     query     string false "search by created_at_to"
 // @Success      200  {array}   user_service.ListCouriersResponse
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) GetListCourier(ctx *gin.Context) {
 	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
 	if err != nil {
-		h.handlerResponse(ctx, "error get page", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error get page", err)
 		return
 	}
 
 	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
 	if err != nil {
-		h.handlerResponse(ctx, "error get limit", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error get limit", err)
 		return
 	}
 
@@ -99,7 +99,7 @@ func (h *Handler) GetListCourier(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		h.handlerResponse(ctx, "error GetListCourier", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error GetListCourier", err)
 		return
 	}
 
@@ -116,20 +116,20 @@ func (h *Handler) GetListCourier(ctx *gin.Context) {
 // @Produce      json
 // @Param        id   path    string     true    "Courier ID to retrieve"
 // @Success      200  {object}  user_service.Couriers
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) GetCourier(ctx *gin.Context) {
 	idStr := ctx.Param("id")
 
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
-		h.handlerResponse(ctx, "error branch GetById", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error branch GetById", err)
 		return
 	}
 	resp, err := h.services.CourierService().Get(ctx.Request.Context(), &user_service.IdRequest{Id: int32(id)})
 	if err != nil {
-		h.handlerResponse(ctx, "error courier GetById", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error courier GetById", err)
 		return
 	}
 
@@ -147,16 +147,16 @@ func (h *Handler) GetCourier(ctx *gin.Context) {
 // @Param        id       path    int     true    "Courier ID to update"
 // @Param        courier   body    user_service.UpdateCouriersRequest  true    "Updated data for the courier"
 // @Success      200  {object}  user_service.UpdateCouriersRequest
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) UpdateCourier(ctx *gin.Context) {
 	var courier = user_service.UpdateCouriersRequest{}
 	id, err := strconv.Atoi(ctx.Param("id"))
 
 	err = ctx.ShouldBind(&courier)
 	if err != nil {
-		h.handlerResponse(ctx, "error while binding", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error while binding", err)
 		return
 	}
 
@@ -165,7 +165,7 @@ func (h *Handler) UpdateCourier(ctx *gin.Context) {
 	resp, err := h.services.CourierService().Update(ctx.Request.Context(), &courier)
 	fmt.Println("before  send bind", resp)
 	if err != nil {
-		h.handlerResponse(ctx, "error courier Update", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error courier Update", err)
 		return
 	}
 
@@ -182,22 +182,90 @@ func (h *Handler) UpdateCourier(ctx *gin.Context) {
 // @Produce      json
 // @Param        id   path    string     true    "Catgeory ID to retrieve"
 // @Success      200  {object}  user_service.Response
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) DeleteCourier(ctx *gin.Context) {
 	idStr := ctx.Param("id")
 
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
-		h.handlerResponse(ctx, "error branch GetById", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error branch GetById", err)
 		return
 	}
 	resp, err := h.services.CourierService().Delete(ctx.Request.Context(), &user_service.IdRequest{Id: int32(id)})
 	if err != nil {
-		h.handlerResponse(ctx, "error courier Delete", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error courier Delete", err)
 		return
 	}
 
 	h.handlerResponse(ctx, "delete courier response", http.StatusOK, resp)
 }
+
+// RestoreCourier godoc
+// @Security ApiKeyAuth
+// @Router       /v1/courier/{id}/restore [post]
+// @Summary      Restore a deleted courier
+// @Description  Undoes the delete of a courier that is not purged yet
+// @Tags         courier
+// @Accept       json
+// @Produce      json
+// @Param        id   path    int     true    "ID of the deleted courier"
+// @Success      200  {object}  user_service.Response
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      409  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) RestoreCourier(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error courier Restore", err)
+		return
+	}
+
+	resp, err := h.services.CourierService().Restore(ctx.Request.Context(), &user_service.IdRequest{Id: int32(id)})
+	if err != nil {
+		h.handlerError(ctx, "error courier Restore", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "restore courier response", http.StatusOK, resp)
+}
+
+// GetListDeletedCourier godoc
+// @Security ApiKeyAuth
+// @Router       /v1/courier/deleted [get]
+// @Summary      GetAll deleted couriers
+// @Description  Deleted couriers that can still be restored, they are purged once the retention is over
+// @Tags         courier
+// @Accept       json
+// @Produce      json
+// @Param        limit    query     int  false  "limit for response"  Default(10)
+// @Param		 page     query     int  false  "page for response"   Default(1)
+// @Success      200  {object}  user_service.ListCouriersResponse
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetListDeletedCourier(ctx *gin.Context) {
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		h.handlerError(ctx, "error get page", err)
+		return
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	if err != nil {
+		h.handlerError(ctx, "error get limit", err)
+		return
+	}
+
+	resp, err := h.services.CourierService().ListDeleted(ctx.Request.Context(), &user_service.ListDeletedRequest{
+		Page:  int32(page),
+		Limit: int32(limit),
+	})
+	if err != nil {
+		h.handlerError(ctx, "error GetListDeletedCourier", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get deleted courier response", http.StatusOK, resp)
+}
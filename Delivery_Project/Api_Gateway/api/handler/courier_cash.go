@@ -0,0 +1,200 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	order_service "api-gateway-service/genproto/order_service"
+	user_service "api-gateway-service/genproto/user_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CourierCashHandover godoc
+// @Security ApiKeyAuth
+// @Router       /v1/courier/{id}/cash/handover [post]
+// @Summary      Record cash handed over by a courier
+// @Description  A branch user records the cash the courier brought back. The cash goes to the courier's branch and is received by the caller, branch_id and received_by of the body are ignored
+// @Tags         courier_cash
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "Courier ID"
+// @Param        handover     body  order_service.CashHandoverRequest true  "handover data"
+// @Success      200  {object}  order_service.CashTransaction
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      403  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) CourierCashHandover(ctx *gin.Context) {
+	var req = order_service.CashHandoverRequest{}
+
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error courier parse id", err)
+		return
+	}
+
+	err = ctx.ShouldBindJSON(&req)
+	if err != nil {
+		h.handlerError(ctx, "error while binding", err)
+		return
+	}
+	req.CourierId = int32(id)
+
+	// the cash goes to the courier's branch, order service sets received_by
+	// from the caller identity
+	respCourier, err := h.services.CourierService().Get(ctx.Request.Context(), &user_service.IdRequest{Id: req.CourierId})
+	if err != nil {
+		h.handlerError(ctx, "error courier GetById in cash handover", err)
+		return
+	}
+	req.BranchId = respCourier.BranchId
+
+	resp, err := h.services.CourierCashService().Handover(ctx.Request.Context(), &req)
+	if err != nil {
+		h.handlerError(ctx, "error courier cash Handover", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "courier cash handover response", http.StatusOK, resp)
+}
+
+// GetCourierCashBalance godoc
+// @Security ApiKeyAuth
+// @Router       /v1/courier/{id}/cash/balance [get]
+// @Summary      Get cash balance of a courier
+// @Description  Returns the cash the courier collected and has not handed over yet
+// @Tags         courier_cash
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "Courier ID"
+// @Success      200  {object}  order_service.CourierCashBalance
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetCourierCashBalance(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error courier parse id", err)
+		return
+	}
+
+	resp, err := h.services.CourierCashService().GetBalance(ctx.Request.Context(), &order_service.IdRequest{Id: int32(id)})
+	if err != nil {
+		h.handlerError(ctx, "error courier cash GetBalance", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get courier cash balance response", http.StatusOK, resp)
+}
+
+// GetCourierCashTransactions godoc
+// @Security ApiKeyAuth
+// @Router       /v1/courier/{id}/cash/transactions [get]
+// @Summary      Get cash ledger of a courier
+// @Description  Lists collected cash and hand-overs of the courier, newest first
+// @Tags         courier_cash
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "Courier ID"
+// @Param        limit    query     int  false  "limit for response"  Default(10)
+// @Param		 page     query     int  false  "page for response"   Default(1)
+// @Param        created_at_from     query     string false "search by created_at_from"
+// @Param        created_at_to     query     string false "search by created_at_to"
+// @Success      200  {object}  order_service.ListCashTransactionsResponse
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetCourierCashTransactions(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error courier parse id", err)
+		return
+	}
+
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		h.handlerError(ctx, "error get page", err)
+		return
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	if err != nil {
+		h.handlerError(ctx, "error get limit", err)
+		return
+	}
+
+	resp, err := h.services.CourierCashService().ListTransactions(ctx.Request.Context(), &order_service.ListCashTransactionsRequest{
+		CourierId:     int32(id),
+		Page:          int32(page),
+		Limit:         int32(limit),
+		CreatedAtFrom: ctx.Query("created_at_from"),
+		CreatedAtTo:   ctx.Query("created_at_to"),
+	})
+	if err != nil {
+		h.handlerError(ctx, "error courier cash ListTransactions", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get courier cash transactions response", http.StatusOK, resp)
+}
+
+// GetCourierCashReconciliation godoc
+// @Security ApiKeyAuth
+// @Router       /v1/courier/{id}/cash/reconciliation [get]
+// @Summary      Cash reconciliation of a courier
+// @Description  Compares finished cash orders with collected and handed over cash in the window and lists discrepancies. The window is the shift when shift_id is given, otherwise it defaults to the current day
+// @Tags         courier_cash
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "Courier ID"
+// @Param        from     query     string false "window start, e.g. 2024-01-01 09:00"
+// @Param        to       query     string false "window end"
+// @Param        shift_id query     int    false "courier shift to reconcile"
+// @Success      200  {object}  order_service.CashReconciliation
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetCourierCashReconciliation(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error courier parse id", err)
+		return
+	}
+
+	req := order_service.CashReconciliationRequest{
+		CourierId: int32(id),
+		From:      ctx.Query("from"),
+		To:        ctx.Query("to"),
+	}
+
+	// a shift gives the window, an open one keeps the default end of the day
+	if shiftId := ctx.Query("shift_id"); shiftId != "" {
+		sid, err := strconv.ParseInt(shiftId, 10, 32)
+		if err != nil {
+			h.handlerError(ctx, "error parse shift_id", err)
+			return
+		}
+
+		shift, err := h.services.CourierShiftService().GetShift(ctx.Request.Context(), &user_service.IdRequest{Id: int32(sid)})
+		if err != nil {
+			h.handlerError(ctx, "error courier shift GetShift", err)
+			return
+		}
+		if shift.CourierId != req.CourierId {
+			h.handlerResponse(ctx, "error shift of another courier", http.StatusBadRequest, "shift does not belong to the courier")
+			return
+		}
+
+		req.From = shift.StartedAt
+		req.To = shift.EndedAt
+	}
+
+	resp, err := h.services.CourierCashService().Reconcile(ctx.Request.Context(), &req)
+	if err != nil {
+		h.handlerError(ctx, "error courier cash Reconcile", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "courier cash reconciliation response", http.StatusOK, resp)
+}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	order_service "api-gateway-service/genproto/order_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetCourierPayout godoc
+// @Security ApiKeyAuth
+// @Router       /v1/courier/{id}/payout [get]
+// @Summary      Payout statement of a courier
+// @Description  Earnings of the courier's finished orders in the period with totals. The period defaults to the current month
+// @Tags         courier_payout
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "Courier ID"
+// @Param        from     query     string false "period start, e.g. 2024-01-01"
+// @Param        to       query     string false "period end"
+// @Success      200  {object}  order_service.PayoutStatement
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetCourierPayout(ctx *gin.Context) {
+	resp, err := h.getCourierPayout(ctx)
+	if err != nil {
+		h.handlerError(ctx, "error courier GetPayoutStatement", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get courier payout response", http.StatusOK, resp)
+}
+
+// ExportCourierPayout godoc
+// @Security ApiKeyAuth
+// @Router       /v1/courier/{id}/payout/csv [get]
+// @Summary      Export payout statement of a courier as CSV
+// @Description  One line per finished order and a total line. The period defaults to the current month
+// @Tags         courier_payout
+// @Produce      text/csv
+// @Param        id   path    string     true    "Courier ID"
+// @Param        from     query     string false "period start, e.g. 2024-01-01"
+// @Param        to       query     string false "period end"
+// @Success      200  {file}  file
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) ExportCourierPayout(ctx *gin.Context) {
+	resp, err := h.getCourierPayout(ctx)
+	if err != nil {
+		h.handlerError(ctx, "error courier GetPayoutStatement", err)
+		return
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	w.Write([]string{"order_id", "finished_at", "delivery_fee", "delivery_share", "distance_km", "distance_bonus", "multiplier", "amount"})
+	for _, e := range resp.Earnings {
+		w.Write([]string{
+			e.OrderId,
+			e.CreatedAt,
+			formatMoney(e.DeliveryFee),
+			formatMoney(e.DeliveryShare),
+			strconv.FormatFloat(e.DistanceKm, 'f', -1, 64),
+			formatMoney(e.DistanceBonus),
+			strconv.FormatFloat(e.Multiplier, 'f', -1, 64),
+			formatMoney(e.Amount),
+		})
+	}
+	w.Write([]string{
+		"total",
+		strconv.Itoa(int(resp.Deliveries)),
+		formatMoney(resp.DeliveryFee),
+		formatMoney(resp.DeliveryShare),
+		"",
+		formatMoney(resp.DistanceBonus),
+		"",
+		formatMoney(resp.Total),
+	})
+	w.Flush()
+
+	if err = w.Error(); err != nil {
+		h.handlerError(ctx, "error writing payout csv", err)
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=payout_%d.csv", resp.CourierId))
+	ctx.Data(http.StatusOK, "text/csv", buf.Bytes())
+}
+
+func (h *Handler) getCourierPayout(ctx *gin.Context) (*order_service.PayoutStatement, error) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.services.CourierEarningsService().GetPayoutStatement(ctx.Request.Context(), &order_service.PayoutStatementRequest{
+		CourierId: int32(id),
+		From:      ctx.Query("from"),
+		To:        ctx.Query("to"),
+	})
+}
+
+func formatMoney(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	user_service "api-gateway-service/genproto/user_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// StartCourierShift godoc
+// @Security ApiKeyAuth
+// @Router       /v1/courier/{id}/shift/start [post]
+// @Summary      Start a courier shift
+// @Description  Opens a shift for the courier and puts the courier online
+// @Tags         courier_shift
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "Courier ID"
+// @Success      200  {object}  user_service.CourierShift
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) StartCourierShift(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error courier parse id", err)
+		return
+	}
+
+	resp, err := h.services.CourierShiftService().StartShift(ctx.Request.Context(), &user_service.IdRequest{Id: int32(id)})
+	if err != nil {
+		h.handlerError(ctx, "error courier StartShift", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "start courier shift response", http.StatusOK, resp)
+}
+
+// EndCourierShift godoc
+// @Security ApiKeyAuth
+// @Router       /v1/courier/{id}/shift/end [post]
+// @Summary      End a courier shift
+// @Description  Closes the open shift of the courier, records its duration and puts the courier offline
+// @Tags         courier_shift
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "Courier ID"
+// @Success      200  {object}  user_service.CourierShift
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) EndCourierShift(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error courier parse id", err)
+		return
+	}
+
+	resp, err := h.services.CourierShiftService().EndShift(ctx.Request.Context(), &user_service.IdRequest{Id: int32(id)})
+	if err != nil {
+		h.handlerError(ctx, "error courier EndShift", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "end courier shift response", http.StatusOK, resp)
+}
+
+// SetCourierAvailability godoc
+// @Security ApiKeyAuth
+// @Router       /v1/courier/{id}/availability [put]
+// @Summary      Change courier availability
+// @Description  Switches the courier between online and break during a shift, offline ends the shift
+// @Tags         courier_shift
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "Courier ID"
+// @Param        availability     body  user_service.SetAvailabilityRequest true  "online, offline or break"
+// @Success      200  {object}  user_service.Couriers
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) SetCourierAvailability(ctx *gin.Context) {
+	var req = user_service.SetAvailabilityRequest{}
+
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error courier parse id", err)
+		return
+	}
+
+	err = ctx.ShouldBindJSON(&req)
+	if err != nil {
+		h.handlerError(ctx, "error while binding", err)
+		return
+	}
+	req.CourierId = int32(id)
+
+	resp, err := h.services.CourierShiftService().SetAvailability(ctx.Request.Context(), &req)
+	if err != nil {
+		h.handlerError(ctx, "error courier SetAvailability", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "set courier availability response", http.StatusOK, resp)
+}
+
+// GetListCourierShift godoc
+// @Security ApiKeyAuth
+// @Router       /v1/courier/{id}/shifts [get]
+// @Summary      Get shifts of a courier
+// @Description  Lists shifts of the courier with durations, newest first
+// @Tags         courier_shift
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "Courier ID"
+// @Param        limit    query     int  false  "limit for response"  Default(10)
+// @Param		 page     query     int  false  "page for response"   Default(1)
+// @Param        started_at_from     query     string false "search by started_at_from"
+// @Param        started_at_to     query     string false "search by started_at_to"
+// @Success      200  {object}  user_service.ListShiftsResponse
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetListCourierShift(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error courier parse id", err)
+		return
+	}
+
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		h.handlerError(ctx, "error get page", err)
+		return
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	if err != nil {
+		h.handlerError(ctx, "error get limit", err)
+		return
+	}
+
+	resp, err := h.services.CourierShiftService().ListShifts(ctx.Request.Context(), &user_service.ListShiftsRequest{
+		CourierId:     int32(id),
+		Page:          int32(page),
+		Limit:         int32(limit),
+		StartedAtFrom: ctx.Query("started_at_from"),
+		StartedAtTo:   ctx.Query("started_at_to"),
+	})
+	if err != nil {
+		h.handlerError(ctx, "error courier ListShifts", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get courier shifts response", http.StatusOK, resp)
+}
+
+// GetListOnlineCourier godoc
+// @Security ApiKeyAuth
+// @Router       /v1/branch/{id}/online_couriers [get]
+// @Summary      Get online couriers of a branch
+// @Description  Lists couriers of the branch that are online and can take orders
+// @Tags         courier_shift
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "Branch ID"
+// @Success      200  {object}  user_service.ListCouriersResponse
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetListOnlineCourier(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error branch parse id", err)
+		return
+	}
+
+	resp, err := h.services.CourierShiftService().ListOnlineCouriers(ctx.Request.Context(), &user_service.ListOnlineCouriersRequest{BranchId: int32(id)})
+	if err != nil {
+		h.handlerError(ctx, "error courier ListOnlineCouriers", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get online couriers response", http.StatusOK, resp)
+}
@@ -19,19 +19,19 @@ import (
 // @Produce      json
 // @Param        delivery_tariff     body  order_service.CreateDeliveryTariffRequest true  "data of the delivery_tariff"
 // @Success      201  {object}  order_service.Response
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) CreateDeliveryTariff(ctx *gin.Context) {
 	var delivery_tariff = order_service.CreateDeliveryTariffRequest{}
 
 	err := ctx.ShouldBindJSON(&delivery_tariff)
 	if err != nil {
-		h.handlerResponse(ctx, "CreateDeliveryTariff", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "CreateDeliveryTariff", err)
 		return
 	}
 
-	resp, err := h.services.DeliveryTariffService().Create(ctx, &order_service.CreateDeliveryTariffRequest{
+	resp, err := h.services.DeliveryTariffService().Create(ctx.Request.Context(), &order_service.CreateDeliveryTariffRequest{
 		Name:       delivery_tariff.Name,
 		TariffType: delivery_tariff.TariffType,
 		BasePrice:  delivery_tariff.BasePrice,
@@ -39,7 +39,7 @@ func (h *Handler) CreateDeliveryTariff(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		h.handlerResponse(ctx, "CatgeoryService().Create", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "CatgeoryService().Create", err)
 
 		return
 	}
@@ -60,19 +60,19 @@ func (h *Handler) CreateDeliveryTariff(ctx *gin.Context) {
 // @Param        name     query     string false "search by name"
 // @Param        tariff_type     query     string false "search by tariff_type"
 // @Success      200  {array}   order_service.ListDeliveryTariffResponse
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) GetListDeliveryTariff(ctx *gin.Context) {
 	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
 	if err != nil {
-		h.handlerResponse(ctx, "error get page", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error get page", err)
 		return
 	}
 
 	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
 	if err != nil {
-		h.handlerResponse(ctx, "error get limit", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error get limit", err)
 		return
 	}
 
@@ -84,7 +84,7 @@ func (h *Handler) GetListDeliveryTariff(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		h.handlerResponse(ctx, "error GetListDeliveryTariff", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error GetListDeliveryTariff", err)
 		return
 	}
 
@@ -101,20 +101,20 @@ func (h *Handler) GetListDeliveryTariff(ctx *gin.Context) {
 // @Produce      json
 // @Param        id   path    string     true    "DeliveryTariff ID to retrieve"
 // @Success      200  {object}  order_service.DeliveryTariff
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) GetDeliveryTariff(ctx *gin.Context) {
 	idStr := ctx.Param("id")
 
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
-		h.handlerResponse(ctx, "error branch GetById", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error branch GetById", err)
 		return
 	}
 	resp, err := h.services.DeliveryTariffService().Get(ctx.Request.Context(), &order_service.IdRequest{Id: int32(id)})
 	if err != nil {
-		h.handlerResponse(ctx, "error delivery_tariff GetById", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error delivery_tariff GetById", err)
 		return
 	}
 
@@ -132,16 +132,16 @@ func (h *Handler) GetDeliveryTariff(ctx *gin.Context) {
 // @Param        id       path    int     true    "DeliveryTariff ID to update"
 // @Param        delivery_tariff   body    order_service.UpdateDeliveryTariffRequest  true    "Updated data for the delivery_tariff"
 // @Success      200  {object}  order_service.UpdateDeliveryTariffRequest
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) UpdateDeliveryTariff(ctx *gin.Context) {
 	var delivery_tariff = order_service.UpdateDeliveryTariffRequest{}
 	id, err := strconv.Atoi(ctx.Param("id"))
 
 	err = ctx.ShouldBind(&delivery_tariff)
 	if err != nil {
-		h.handlerResponse(ctx, "error while binding", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error while binding", err)
 		return
 	}
 
@@ -150,7 +150,7 @@ func (h *Handler) UpdateDeliveryTariff(ctx *gin.Context) {
 	resp, err := h.services.DeliveryTariffService().Update(ctx.Request.Context(), &delivery_tariff)
 	fmt.Println("before  send bind", resp)
 	if err != nil {
-		h.handlerResponse(ctx, "error order Update", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error order Update", err)
 		return
 	}
 
@@ -167,22 +167,90 @@ func (h *Handler) UpdateDeliveryTariff(ctx *gin.Context) {
 // @Produce      json
 // @Param        id   path    string     true    "Catgeory ID to retrieve"
 // @Success      200  {object}  order_service.Response
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) DeleteDeliveryTariff(ctx *gin.Context) {
 	idStr := ctx.Param("id")
 
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
-		h.handlerResponse(ctx, "error branch GetById", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error branch GetById", err)
 		return
 	}
 	resp, err := h.services.DeliveryTariffService().Delete(ctx.Request.Context(), &order_service.IdRequest{Id: int32(id)})
 	if err != nil {
-		h.handlerResponse(ctx, "error delivery_tariff Delete", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error delivery_tariff Delete", err)
 		return
 	}
 
 	h.handlerResponse(ctx, "delete delivery_tariff response", http.StatusOK, resp)
 }
+
+// RestoreDeliveryTariff godoc
+// @Security ApiKeyAuth
+// @Router       /v1/delivery_tariff/{id}/restore [post]
+// @Summary      Restore a deleted delivery tariff
+// @Description  Undoes the delete of a delivery tariff that is not purged yet
+// @Tags         delivery_tariff
+// @Accept       json
+// @Produce      json
+// @Param        id   path    int     true    "ID of the deleted delivery tariff"
+// @Success      200  {object}  order_service.Response
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      409  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) RestoreDeliveryTariff(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error delivery_tariff Restore", err)
+		return
+	}
+
+	resp, err := h.services.DeliveryTariffService().Restore(ctx.Request.Context(), &order_service.IdRequest{Id: int32(id)})
+	if err != nil {
+		h.handlerError(ctx, "error delivery_tariff Restore", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "restore delivery_tariff response", http.StatusOK, resp)
+}
+
+// GetListDeletedDeliveryTariff godoc
+// @Security ApiKeyAuth
+// @Router       /v1/delivery_tariff/deleted [get]
+// @Summary      GetAll deleted delivery tariffs
+// @Description  Deleted delivery tariffs that can still be restored, they are purged once the retention is over
+// @Tags         delivery_tariff
+// @Accept       json
+// @Produce      json
+// @Param        limit    query     int  false  "limit for response"  Default(10)
+// @Param		 page     query     int  false  "page for response"   Default(1)
+// @Success      200  {object}  order_service.ListDeliveryTariffResponse
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetListDeletedDeliveryTariff(ctx *gin.Context) {
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		h.handlerError(ctx, "error get page", err)
+		return
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	if err != nil {
+		h.handlerError(ctx, "error get limit", err)
+		return
+	}
+
+	resp, err := h.services.DeliveryTariffService().ListDeleted(ctx.Request.Context(), &order_service.ListDeletedRequest{
+		Page:  int32(page),
+		Limit: int32(limit),
+	})
+	if err != nil {
+		h.handlerError(ctx, "error GetListDeletedDeliveryTariff", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get deleted delivery_tariff response", http.StatusOK, resp)
+}
@@ -0,0 +1,217 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	order_service "api-gateway-service/genproto/order_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreateOrderFeedback godoc
+// @Security ApiKeyAuth
+// @Router       /v1/order/{id}/feedback [post]
+// @Summary      Rate a finished order
+// @Description  The client rates the food and, optionally, the courier from 1 to 5, once per order. Pick-up orders have no courier to rate
+// @Tags         feedback
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "Order ID"
+// @Param        feedback     body  order_service.CreateFeedbackRequest true  "ratings, comment and tags"
+// @Success      201  {object}  order_service.Feedback
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) CreateOrderFeedback(ctx *gin.Context) {
+	var feedback = order_service.CreateFeedbackRequest{}
+
+	err := ctx.ShouldBindJSON(&feedback)
+	if err != nil {
+		h.handlerError(ctx, "CreateOrderFeedback", err)
+		return
+	}
+	feedback.OrderId = ctx.Param("id")
+
+	resp, err := h.services.FeedbackService().Create(ctx.Request.Context(), &feedback)
+	if err != nil {
+		h.handlerError(ctx, "FeedbackService().Create", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "create feedback response", http.StatusCreated, resp)
+}
+
+// GetOrderFeedback godoc
+// @Security ApiKeyAuth
+// @Router       /v1/order/{id}/feedback [get]
+// @Summary      Get feedback of an order
+// @Description  Returns the client's feedback for the order
+// @Tags         feedback
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "Order ID"
+// @Success      200  {object}  order_service.Feedback
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetOrderFeedback(ctx *gin.Context) {
+	resp, err := h.services.FeedbackService().Get(ctx.Request.Context(), &order_service.OrderIdRequest{OrderId: ctx.Param("id")})
+	if err != nil {
+		h.handlerError(ctx, "error feedback Get", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get feedback response", http.StatusOK, resp)
+}
+
+// GetListFeedback godoc
+// @Security ApiKeyAuth
+// @Router       /v1/feedback [get]
+// @Summary      GetAll Feedback
+// @Description  get feedback, newest first
+// @Tags         feedback
+// @Accept       json
+// @Produce      json
+// @Param        limit    query     int  false  "limit for response"  Default(10)
+// @Param		 page     query     int  false  "page for response"   Default(1)
+// @Param        courier_id    query     int  false  "filter by courier"
+// @Param        branch_id     query     int  false  "filter by branch"
+// @Param        client_id     query     int  false  "filter by client"
+// @Param        max_rating    query     int  false  "only feedback with a rating at or below it"
+// @Success      200  {object}  order_service.ListFeedbackResponse
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetListFeedback(ctx *gin.Context) {
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		h.handlerError(ctx, "error get page", err)
+		return
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	if err != nil {
+		h.handlerError(ctx, "error get limit", err)
+		return
+	}
+
+	courierId, err := strconv.Atoi(ctx.DefaultQuery("courier_id", "0"))
+	if err != nil {
+		h.handlerError(ctx, "error get courier_id", err)
+		return
+	}
+
+	branchId, err := strconv.Atoi(ctx.DefaultQuery("branch_id", "0"))
+	if err != nil {
+		h.handlerError(ctx, "error get branch_id", err)
+		return
+	}
+
+	clientId, err := strconv.Atoi(ctx.DefaultQuery("client_id", "0"))
+	if err != nil {
+		h.handlerError(ctx, "error get client_id", err)
+		return
+	}
+
+	maxRating, err := strconv.Atoi(ctx.DefaultQuery("max_rating", "0"))
+	if err != nil {
+		h.handlerError(ctx, "error get max_rating", err)
+		return
+	}
+
+	resp, err := h.services.FeedbackService().List(ctx.Request.Context(), &order_service.ListFeedbackRequest{
+		Page:      int32(page),
+		Limit:     int32(limit),
+		CourierId: int32(courierId),
+		BranchId:  int32(branchId),
+		ClientId:  int32(clientId),
+		MaxRating: int32(maxRating),
+	})
+	if err != nil {
+		h.handlerError(ctx, "error GetListFeedback", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get AllFeedback response", http.StatusOK, resp)
+}
+
+// GetListFeedbackAlert godoc
+// @Security ApiKeyAuth
+// @Router       /v1/feedback/alerts [get]
+// @Summary      GetAll low rating alerts
+// @Description  Alerts raised by low courier or food ratings, newest first
+// @Tags         feedback
+// @Accept       json
+// @Produce      json
+// @Param        limit    query     int  false  "limit for response"  Default(10)
+// @Param		 page     query     int  false  "page for response"   Default(1)
+// @Param        branch_id     query     int  false  "filter by branch"
+// @Success      200  {object}  order_service.ListFeedbackAlertsResponse
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetListFeedbackAlert(ctx *gin.Context) {
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		h.handlerError(ctx, "error get page", err)
+		return
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	if err != nil {
+		h.handlerError(ctx, "error get limit", err)
+		return
+	}
+
+	branchId, err := strconv.Atoi(ctx.DefaultQuery("branch_id", "0"))
+	if err != nil {
+		h.handlerError(ctx, "error get branch_id", err)
+		return
+	}
+
+	resp, err := h.services.FeedbackService().ListAlerts(ctx.Request.Context(), &order_service.ListFeedbackAlertsRequest{
+		Page:     int32(page),
+		Limit:    int32(limit),
+		BranchId: int32(branchId),
+	})
+	if err != nil {
+		h.handlerError(ctx, "error GetListFeedbackAlert", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get AllFeedbackAlert response", http.StatusOK, resp)
+}
+
+// GetRating godoc
+// @Security ApiKeyAuth
+// @Router       /v1/rating/{type}/{id} [get]
+// @Summary      Get average rating
+// @Description  Average rating of a courier, a branch or a product
+// @Tags         feedback
+// @Accept       json
+// @Produce      json
+// @Param        type   path    string     true    "courier, branch or product"
+// @Param        id     path    int        true    "ID of the courier, branch or product"
+// @Success      200  {object}  order_service.Rating
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetRating(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error rating parse id", err)
+		return
+	}
+
+	resp, err := h.services.FeedbackService().GetRating(ctx.Request.Context(), &order_service.RatingRequest{
+		SubjectType: ctx.Param("type"),
+		SubjectId:   int32(id),
+	})
+	if err != nil {
+		h.handlerError(ctx, "error feedback GetRating", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get rating response", http.StatusOK, resp)
+}
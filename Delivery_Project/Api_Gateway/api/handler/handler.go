@@ -1,11 +1,14 @@
 package handler
 
 import (
+	"api-gateway-service/api/response"
 	"api-gateway-service/config"
+	"api-gateway-service/pkg/identity"
 	// grpc_client "api-gateway-service/grpc"
 	"api-gateway-service/pkg/logger"
 	"api-gateway-service/services"
 
+	"fmt"
 	"net/http"
 	"strconv"
 
@@ -48,6 +51,48 @@ func (h *Handler) handlerResponse(c *gin.Context, path string, code int, message
 
 	c.JSON(code, response)
 }
+
+// handlerError answers with the HTTP status matching err, the full error is only logged
+func (h *Handler) handlerError(c *gin.Context, path string, err error) {
+	code, resp := response.FromError(err)
+	if code >= http.StatusInternalServerError {
+		h.log.Error(path, logger.Error(err))
+	}
+	if code == http.StatusServiceUnavailable {
+		c.Header("Retry-After", strconv.Itoa(int(h.cfg.BreakerOpenTimeout.Seconds())))
+	}
+
+	h.handlerResponse(c, path, code, resp)
+}
+
+// identity returns the caller authenticated by the identity middleware, false
+// for requests without a token
+func (h *Handler) identity(c *gin.Context) (identity.Identity, bool) {
+	value, ok := c.Get(identity.ContextKey)
+	if !ok {
+		return identity.Identity{}, false
+	}
+
+	id, ok := value.(identity.Identity)
+	return id, ok
+}
+
+// setNextLink points the Link header at the next page of a cursor list, the
+// query of the request is kept with the cursor replaced and page dropped
+func (h *Handler) setNextLink(c *gin.Context, cursor string) {
+	if cursor == "" {
+		return
+	}
+
+	next := *c.Request.URL
+	query := next.Query()
+	query.Set("cursor", cursor)
+	query.Del("page")
+	next.RawQuery = query.Encode()
+
+	c.Header("Link", fmt.Sprintf(`<%s>; rel="next"`, next.RequestURI()))
+}
+
 func (h *Handler) ParseQueryParam(c *gin.Context, key string, defaultValue string) (int, error) {
 	valueStr := c.DefaultQuery(key, defaultValue)
 
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"api-gateway-service/api/response"
+
+	"github.com/gin-gonic/gin"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// healthCheckTimeout bounds a probe, so a hanging service reads as not serving
+const healthCheckTimeout = 2 * time.Second
+
+// Healthz godoc
+// @Router       /healthz [get]
+// @Summary      Liveness probe
+// @Description  Answers 200 while the gateway is up, the serving status of the downstream services is reported for information
+// @Tags         health
+// @Produce      json
+// @Success      200  {object}  response.HealthResp
+func (h *Handler) Healthz(ctx *gin.Context) {
+	resp, _ := h.checkServices(ctx.Request.Context())
+	resp.Status = "ok"
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// Readyz godoc
+// @Router       /readyz [get]
+// @Summary      Readiness probe
+// @Description  Answers 200 only when every downstream service reports SERVING through the gRPC health protocol, 503 otherwise
+// @Tags         health
+// @Produce      json
+// @Success      200  {object}  response.HealthResp
+// @Failure      503  {object}  response.HealthResp
+func (h *Handler) Readyz(ctx *gin.Context) {
+	resp, ready := h.checkServices(ctx.Request.Context())
+	if !ready {
+		resp.Status = "unavailable"
+		ctx.JSON(http.StatusServiceUnavailable, resp)
+		return
+	}
+
+	resp.Status = "ok"
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// checkServices asks all services for their health at once
+func (h *Handler) checkServices(ctx context.Context) (response.HealthResp, bool) {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	var (
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+		ready = true
+		resp  = response.HealthResp{Services: make(map[string]string)}
+	)
+	for name, client := range h.services.HealthServices() {
+		wg.Add(1)
+		go func(name string, client healthpb.HealthClient) {
+			defer wg.Done()
+
+			servingStatus := healthpb.HealthCheckResponse_UNKNOWN.String()
+			res, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+			if err == nil {
+				servingStatus = res.Status.String()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			resp.Services[name] = servingStatus
+			if err != nil || res.Status != healthpb.HealthCheckResponse_SERVING {
+				ready = false
+			}
+		}(name, client)
+	}
+	wg.Wait()
+
+	return resp, ready
+}
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	order_service "api-gateway-service/genproto/order_service"
+	"api-gateway-service/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetKitchenQueue godoc
+// @Security ApiKeyAuth
+// @Router       /v1/branch/{id}/kitchen [get]
+// @Summary      Get kitchen queue of a branch
+// @Description  Orders the branch has to prepare with their line items, the most urgent promised time first. Unpaid card orders are not listed.
+// @Tags         kitchen
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "Branch ID"
+// @Success      200  {object}  order_service.KitchenQueue
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetKitchenQueue(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error branch parse id", err)
+		return
+	}
+
+	resp, err := h.services.KitchenService().GetQueue(ctx.Request.Context(), &order_service.KitchenQueueRequest{BranchId: int32(id)})
+	if err != nil {
+		h.handlerError(ctx, "error kitchen GetQueue", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get kitchen queue response", http.StatusOK, resp)
+}
+
+// WatchKitchenQueue godoc
+// @Security ApiKeyAuth
+// @Router       /v1/branch/{id}/kitchen/stream [get]
+// @Summary      Stream kitchen queue of a branch
+// @Description  Server-sent events: a "queue" event with the whole kitchen queue is sent on connect and after every change of the branch orders
+// @Tags         kitchen
+// @Produce      text/event-stream
+// @Param        id   path    string     true    "Branch ID"
+// @Success      200  {object}  order_service.KitchenQueue
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) WatchKitchenQueue(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error branch parse id", err)
+		return
+	}
+
+	// the stream is closed by order service once the screen disconnects
+	stream, err := h.services.KitchenService().WatchQueue(ctx.Request.Context(), &order_service.KitchenQueueRequest{BranchId: int32(id)})
+	if err != nil {
+		h.handlerError(ctx, "error kitchen WatchQueue", err)
+		return
+	}
+
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+
+	ctx.Stream(func(w io.Writer) bool {
+		queue, err := stream.Recv()
+		if err != nil {
+			if ctx.Request.Context().Err() == nil {
+				h.log.Error("error kitchen queue stream", logger.Error(err))
+			}
+			return false
+		}
+
+		ctx.SSEvent("queue", queue)
+		return true
+	})
+}
+
+// StartPreparingOrder godoc
+// @Security ApiKeyAuth
+// @Router       /v1/kitchen/{id}/preparing [put]
+// @Summary      Start preparing an order
+// @Description  Moves the order from accepted or courier_accepted to preparing
+// @Tags         kitchen
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "order_id of the order"
+// @Success      200  {object}  order_service.Response
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) StartPreparingOrder(ctx *gin.Context) {
+	resp, err := h.services.KitchenService().StartPreparing(ctx.Request.Context(), &order_service.OrderIdRequest{OrderId: ctx.Param("id")})
+	if err != nil {
+		h.handlerError(ctx, "error kitchen StartPreparing", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "start preparing order response", http.StatusOK, resp)
+}
+
+// MarkOrderReady godoc
+// @Security ApiKeyAuth
+// @Router       /v1/kitchen/{id}/ready [put]
+// @Summary      Mark an order as ready
+// @Description  Moves a prepared order to ready_in_branch, it leaves the kitchen queue
+// @Tags         kitchen
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "order_id of the order"
+// @Success      200  {object}  order_service.Response
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) MarkOrderReady(ctx *gin.Context) {
+	resp, err := h.services.KitchenService().MarkReady(ctx.Request.Context(), &order_service.OrderIdRequest{OrderId: ctx.Param("id")})
+	if err != nil {
+		h.handlerError(ctx, "error kitchen MarkReady", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "mark order ready response", http.StatusOK, resp)
+}
@@ -1,17 +1,16 @@
 package handler
 
 import (
-	"fmt"
 	"net/http"
 	"strconv"
 	"time"
 
-	"api-gateway-service/config"
 	"api-gateway-service/genproto/order_service"
 	user_service "api-gateway-service/genproto/user_service"
-	"api-gateway-service/pkg/helper"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Task3 branchlarni activeni hozirgi vaqtga nisbatlab olish
@@ -27,19 +26,19 @@ import (
 // @Param        limit    query     int  false  "limit for response"  Default(10)
 // @Param		 page     query     int  false  "page for response"   Default(1)
 // @Success      200  {array}   user_service.ListBranchResponse
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) GetListActiveBranch(ctx *gin.Context) {
 	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
 	if err != nil {
-		h.handlerResponse(ctx, "error get page", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error get page", err)
 		return
 	}
 
 	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
 	if err != nil {
-		h.handlerResponse(ctx, "error get limit", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error get limit", err)
 		return
 	}
 	timeNow := time.Now().Format("15:04:05")
@@ -51,7 +50,7 @@ func (h *Handler) GetListActiveBranch(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		h.handlerResponse(ctx, "error GetListActiveBranch", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error GetListActiveBranch", err)
 		return
 	}
 
@@ -64,31 +63,50 @@ func (h *Handler) GetListActiveBranch(ctx *gin.Context) {
 
 // Updated Order Status godoc
 // @Security ApiKeyAuth
-// @Router       /v1/logic [get]
+// @Router       /v1/logic/{id} [put]
 // @Summary      Update order status
-// @Description  Update order status
+// @Description  Moves the order to its next status: accepted -> courier_accepted -> ready_in_branch -> on_way -> finished, orders the kitchen is preparing move to ready_in_branch. Pick-up orders go accepted -> ready_in_branch and are finished through /v1/order/{id}/pickup
 // @Tags         logic
 // @Accept       json
 // @Produce      json
-// @Param        order_id    query     int  false  "order_id for response"
+// @Param        id    path     string  true  "order_id of the order"
 // @Success      200  {string}   string
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) UpdateOrderStatus(ctx *gin.Context) {
 	idStr := ctx.Param("id")
 
 	// Get the previous status
-	prevStatus, err := h.services.OrderService().GetOrderStatus(ctx.Request.Context(), &order_service.OrderIdRequest{
-		OrderId: idStr,
+	prevOrder, err := h.services.OrderService().Get(ctx.Request.Context(), &order_service.IdStrRequest{
+		Id: idStr,
 	})
 	if err != nil {
-		h.handlerResponse(ctx, "error get prevstatus", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error get prevstatus", err)
 		return
 	}
 
-	// Extract the status value from the previous status response
-	status := prevStatus.Status
+	nextStatus := map[string]string{
+		"accepted":         "courier_accepted",
+		"courier_accepted": "ready_in_branch",
+		"preparing":        "ready_in_branch",
+		"ready_in_branch":  "on_way",
+		"on_way":           "finished",
+	}
+
+	// pick-up orders skip the courier and are finished with their pick-up code
+	if prevOrder.Type == "pick_up" {
+		nextStatus = map[string]string{
+			"accepted":  "ready_in_branch",
+			"preparing": "ready_in_branch",
+		}
+	}
+
+	status, ok := nextStatus[prevOrder.Status]
+	if !ok {
+		h.handlerResponse(ctx, "error get next status", http.StatusBadRequest, "order status "+prevOrder.Status+" can not be changed")
+		return
+	}
 
 	// Update the status
 	response, err := h.services.OrderService().UpdateStatus(ctx.Request.Context(), &order_service.UpdateOrderStatusRequest{
@@ -98,35 +116,47 @@ func (h *Handler) UpdateOrderStatus(ctx *gin.Context) {
 
 	// Handle the update response and error (if any)
 	if err != nil {
-		h.handlerResponse(ctx, "failed to update order status", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "failed to update order status", err)
 		return
 	}
 
+	// 6. the client statistics and bonus points of a finished order are
+	// updated by order service, it retries them until user service takes them
+
 	// Return the response to the client
 	h.handlerResponse(ctx, "order status updated successfully", http.StatusOK, response)
+}
 
-	// 6. Zakaz zavershit bo'lganda clientni ma'lumotlari(last_ordered_date,total_orders_count,total_orders_sum)ni update qilish
-
-	if status == "finished" {
-		// Get Order using orderid
-		respOrder, err := h.services.OrderService().Get(ctx.Request.Context(), &order_service.IdStrRequest{
-			Id: idStr,
-		})
-		if err != nil {
-			h.handlerResponse(ctx, "error get order in updateOrderStatus", http.StatusBadRequest, err.Error())
-			return
-		}
+// CompleteOrderPickup godoc
+// @Security ApiKeyAuth
+// @Router       /v1/order/{id}/pickup [post]
+// @Summary      Complete a pick-up order
+// @Description  Branch staff hands a ready pick-up order over after checking the code the client shows
+// @Tags         logic
+// @Accept       json
+// @Produce      json
+// @Param        id     path    string  true  "order_id of the order"
+// @Param        pickup body    order_service.CompletePickupRequest  true  "pickup_code given to the client, order_id is taken from the path"
+// @Success      200  {object}  order_service.Response
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      403  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) CompleteOrderPickup(ctx *gin.Context) {
+	var req order_service.CompletePickupRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		h.handlerError(ctx, "error while binding pickup", err)
+		return
+	}
+	req.OrderId = ctx.Param("id")
 
-		_, err = h.services.ClientService().UpdateOrder(ctx.Request.Context(), &user_service.UpdateClientsOrderRequest{
-			Id:               respOrder.ClientId,
-			TotalOrdersCount: 1,
-			TotalOrdersSum:   respOrder.Price,
-		})
-		if err != nil {
-			h.handlerResponse(ctx, "error update client order in updateOrderStatus", http.StatusBadRequest, err.Error())
-			return
-		}
+	resp, err := h.services.OrderService().CompletePickup(ctx.Request.Context(), &req)
+	if err != nil {
+		h.handlerError(ctx, "error order CompletePickup", err)
+		return
 	}
+
+	h.handlerResponse(ctx, "complete order pickup response", http.StatusOK, resp)
 }
 
 //Task 7. Courier endpoints[get]:
@@ -142,27 +172,27 @@ func (h *Handler) UpdateOrderStatus(ctx *gin.Context) {
 // @Produce      json
 // @Param        id   path    string     true    "Courier ID to retrieve"
 // @Success      200  {object}  order_service.Order
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) GetCourierOrders(ctx *gin.Context) {
 	idStr := ctx.Param("id")
 
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
-		h.handlerResponse(ctx, "error courier GetById", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error courier GetById", err)
 		return
 	}
 	respAcceptedOrders, err := h.services.OrderService().GetAllAcceptedOrders(ctx.Request.Context(), &order_service.IdRequest{Id: int32(id)})
 	if err != nil {
-		h.handlerResponse(ctx, "error courier GetById", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error courier GetById", err)
 		return
 	}
 
 	h.handlerResponse(ctx, "getAll Accepted Orders of this courier response", http.StatusOK, respAcceptedOrders)
 	respAcceptableOrders, err := h.services.OrderService().GetAllAcceptableOrders(ctx.Request.Context(), &order_service.IdRequest{Id: int32(id)})
 	if err != nil {
-		h.handlerResponse(ctx, "error courier GetById", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error courier GetById", err)
 		return
 	}
 
@@ -188,13 +218,13 @@ func (h *Handler) DeleteCourierInOrder(c *gin.Context) {
 
 	ID, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
-		h.handlerResponse(c, "error order update order status parse id", http.StatusBadRequest, err.Error())
+		h.handlerError(c, "error order update order status parse id", err)
 		return
 	}
 
 	respOrder, err := h.services.OrderService().Get(c.Request.Context(), &order_service.IdStrRequest{Id: idStr})
 	if err != nil {
-		h.handlerResponse(c, "error courier GetById", http.StatusBadRequest, err.Error())
+		h.handlerError(c, "error courier GetById", err)
 		return
 	}
 
@@ -214,7 +244,7 @@ func (h *Handler) DeleteCourierInOrder(c *gin.Context) {
 		Status:        "accepted",
 	})
 	if err != nil {
-		h.handlerResponse(c, "error courier Update", http.StatusInternalServerError, err.Error())
+		h.handlerError(c, "error courier Update", err)
 		return
 	}
 	h.handlerResponse(c, "DeletedCourirInOrder", http.StatusOK, resp)
@@ -226,69 +256,64 @@ func (h *Handler) DeleteCourierInOrder(c *gin.Context) {
 //  - courierda max orders countga teng zakazlari bo'lsa error qaytarish
 //  - zakaz statusi 'Courier Accepted'ga o'zgaradi
 
+// @Security ApiKeyAuth
 // @Router       /v1/courier/delete_order/{id} [get]
 // @Summary      Update Order
-// @Description  courier get Order
+// @Description  courier get Order, the courier is taken from the token
 // @Tags         logic
 // @Accept       json
 // @Produce      json
 // @Param        id    path     string  true  "id of order"
 // @Success      200  {string}   string
 // @Failure      400  {object}  response.ErrorResp
+// @Failure      401  {object}  response.ErrorResp
+// @Failure      403  {object}  response.ErrorResp
 // @Failure      404  {object}  response.ErrorResp
 // @Failure      500  {object}  response.ErrorResp
 
 func (h *Handler) CourierGetOrder(c *gin.Context) {
-	tokenStr := c.Request.Header.Get("Authorization")
-
-	token, err := helper.ExtractToken(tokenStr)
-	if err != nil {
-		h.handlerResponse(c, "error get courier", http.StatusBadRequest, err.Error())
+	caller, ok := h.identity(c)
+	if !ok || caller.Role != "courier" {
+		h.handlerError(c, "error courier get order", status.Error(codes.PermissionDenied, "only couriers can take orders"))
 		return
 	}
 
-	claims, err := helper.ParseClaims(token, config.JWTSecretKey)
+	respCourier, err := h.services.CourierService().Get(c.Request.Context(), &user_service.IdRequest{Id: caller.UserID})
 	if err != nil {
-		h.handlerResponse(c, "error get claims", http.StatusBadRequest, err.Error())
+		h.handlerError(c, "error get Couriers", err)
 		return
 	}
 
-	respCourier, err := h.services.CourierService().Get(c.Request.Context(), &user_service.IdRequest{Id: claims.UserID})
-	if err != nil {
-		h.handlerResponse(c, "error get Couriers", http.StatusBadRequest, err.Error())
+	if respCourier.Availability != "online" {
+		h.handlerError(c, "error courier is not online", status.Error(codes.FailedPrecondition, "courier must be online to take orders"))
 		return
 	}
 	id := c.Param("id")
 
 	respOrder, err := h.services.OrderService().Get(c.Request.Context(), &order_service.IdStrRequest{Id: id})
-
-	if respOrder.CourierId != 0 {
-		c.JSON(http.StatusBadRequest, "order already received")
+	if err != nil {
+		h.handlerError(c, "error get order", err)
 		return
 	}
-	idstr := int32(respOrder.CourierId)
-	respCouriersOrder, err := h.services.OrderService().GetAllAcceptableOrders(c.Request.Context(), &order_service.IdRequest{
-		Id: idstr})
 
-	if err != nil {
-		fmt.Println("error Order Get:", err.Error())
-		c.JSON(http.StatusInternalServerError, "internal server error")
+	if respOrder.Type == "pick_up" {
+		h.handlerError(c, "error courier get pick-up order", status.Error(codes.FailedPrecondition, "pick-up orders are collected by the client"))
 		return
 	}
 
-	if respCouriersOrder.Count == respCourier.MaxOrderCount {
-		c.JSON(http.StatusBadRequest, "you are reached max order!")
+	if respOrder.CourierId != 0 {
+		h.handlerError(c, "error order already taken", status.Error(codes.FailedPrecondition, "order is already taken by a courier"))
 		return
 	}
-
 	resp, err := h.services.OrderService().UpdateStatus(c.Request.Context(), &order_service.UpdateOrderStatusRequest{
-		OrderId: respOrder.OrderId,
-		Status:  "courier_accepted",
+		OrderId:         respOrder.OrderId,
+		Status:          "courier_accepted",
+		CourierId:       respCourier.Id,
+		MaxActiveOrders: respCourier.MaxOrderCount,
 	})
 
 	if err != nil {
-		fmt.Println("error Order Update:", err.Error())
-		c.JSON(http.StatusInternalServerError, "internal server error")
+		h.handlerError(c, "error courier take order", err)
 		return
 	}
 
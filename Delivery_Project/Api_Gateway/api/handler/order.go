@@ -9,6 +9,14 @@ import (
 	"api-gateway-service/genproto/user_service"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	idempotencyKeyHeader = "Idempotency-Key"
+	// set by order service when Create returned the result of an earlier call
+	idempotentReplayedHeader = "idempotent-replayed"
 )
 
 // task 4 and 10 is here
@@ -21,23 +29,25 @@ import (
 // @Accept       json
 // @Produce      json
 // @Param        order     body  order_service.CreateOrderRequest true  "data of the order"
+// @Param        Idempotency-Key  header  string  false  "retries with the same key return the first result instead of a new order"
 // @Success      201  {object}  order_service.Response
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      409  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) CreateOrder(ctx *gin.Context) {
 	var order = order_service.CreateOrderRequest{}
 	var discountprice float32
 	var deliveryprice float32
 	err := ctx.ShouldBindJSON(&order)
 	if err != nil {
-		h.handlerResponse(ctx, "CreateOrder", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "CreateOrder", err)
 		return
 	}
 
 	respClient, err := h.services.ClientService().Get(ctx.Request.Context(), &user_service.IdRequest{Id: order.ClientId})
 	if err != nil {
-		h.handlerResponse(ctx, "error client GetById in create order", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error client GetById in create order", err)
 		return
 	}
 
@@ -51,54 +61,106 @@ func (h *Handler) CreateOrder(ctx *gin.Context) {
 	//task 10 delvery priceni hisoblash
 	respBranch, err := h.services.BranchService().Get(ctx.Request.Context(), &user_service.IdRequest{Id: order.BranchId})
 	if err != nil {
-		h.handlerResponse(ctx, "error branch GetById", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error branch GetById", err)
 		return
 	}
 
-	respDeliveryTariff, err := h.services.DeliveryTariffService().Get(ctx.Request.Context(), &order_service.IdRequest{Id: respBranch.DeliveryTarifId})
-	if err != nil {
-		h.handlerResponse(ctx, "error delivery_tariff GetById in order create", http.StatusBadRequest, err.Error())
-		return
-	}
+	// pick-up orders are collected in the branch and never charged for delivery
+	if order.Type != "pick_up" {
+		respDeliveryTariff, err := h.services.DeliveryTariffService().Get(ctx.Request.Context(), &order_service.IdRequest{Id: respBranch.DeliveryTarifId})
+		if err != nil {
+			h.handlerError(ctx, "error delivery_tariff GetById in order create", err)
+			return
+		}
+
+		if respDeliveryTariff.TariffType == "fixed" {
+			deliveryprice = float32(respDeliveryTariff.BasePrice)
+		} else if respDeliveryTariff.TariffType == "alternative" {
+			respTariff, err := h.services.DeliveryTariffService().List(ctx.Request.Context(), &order_service.ListDeliveryTariffRequest{
+				Page:      1,
+				Limit:     10,
+				TarifType: "alternative",
+			})
+
+			if err != nil {
+				h.handlerError(ctx, "error GetListDeliveryTariff in  create order", err)
+				return
+			}
+			for _, v := range respTariff.DeliveryTariffs {
+				if order.Price > v.Values.FromPrice && order.Price < v.Values.ToPrice {
+					deliveryprice = float32(v.Values.Price)
 
-	if respDeliveryTariff.TariffType == "fixed" {
-		deliveryprice = float32(respDeliveryTariff.BasePrice)
-	} else if respDeliveryTariff.TariffType == "alternative" {
-		respTariff, err := h.services.DeliveryTariffService().List(ctx.Request.Context(), &order_service.ListDeliveryTariffRequest{
-			Page:      1,
-			Limit:     10,
-			TarifType: "alternative",
-		})
+				}
+			}
+		}
+	}
 
+	// a saved address is copied into the order, so editing it later keeps the order unchanged
+	if order.AddressId != 0 && order.Type != "pick_up" {
+		respAddress, err := h.services.ClientAddressService().Get(ctx.Request.Context(), &user_service.IdRequest{Id: order.AddressId})
 		if err != nil {
-			h.handlerResponse(ctx, "error GetListDeliveryTariff in  create order", http.StatusBadRequest, err.Error())
+			h.handlerError(ctx, "error client address GetById in create order", err)
 			return
 		}
-		for _, v := range respTariff.DeliveryTariffs {
-			if order.Price > v.Values.FromPrice && order.Price < v.Values.ToPrice {
-				deliveryprice = float32(v.Values.Price)
 
-			}
+		if respAddress.ClientId != order.ClientId {
+			h.handlerResponse(ctx, "error client address in create order", http.StatusBadRequest, "address does not belong to the client")
+			return
+		}
+
+		order.Address = respAddress.Address
+		order.AddressDetails = &order_service.OrderAddress{
+			Label:          respAddress.Label,
+			Address:        respAddress.Address,
+			Latitude:       respAddress.Latitude,
+			Longitude:      respAddress.Longitude,
+			Entrance:       respAddress.Entrance,
+			Floor:          respAddress.Floor,
+			Apartment:      respAddress.Apartment,
+			CourierComment: respAddress.CourierComment,
 		}
 	}
 
-	resp, err := h.services.OrderService().Create(ctx, &order_service.CreateOrderRequest{
+	err = h.fillOrderProducts(ctx, order.Products)
+	if err != nil {
+		h.handlerError(ctx, "error product GetById in create order", err)
+		return
+	}
+
+	// promo code discount and bonus points are applied by order service together with the order creation
+	var header metadata.MD
+	resp, err := h.services.OrderService().Create(ctx.Request.Context(), &order_service.CreateOrderRequest{
 		ClientId:      order.ClientId,
 		BranchId:      order.BranchId,
 		Type:          order.Type,
+		Address:       order.Address,
 		CourierId:     order.CourierId,
 		DeliveryPrice: float64(deliveryprice),
 		Price:         order.Price - float64(discountprice),
 		Discount:      float64(discountprice),
 		PaymentType:   order.PaymentType,
-	})
+		PromoCode:     order.PromoCode,
+		Products:      order.Products,
+		BonusPoints:   order.BonusPoints,
+		ClientPhone:   respClient.Phone,
+		DistanceKm:    order.DistanceKm,
+		// promised delivery time is estimated by order service from this
+		PreparationMinutes: respBranch.PreparationMinutes,
+		AddressId:          order.AddressId,
+		AddressDetails:     order.AddressDetails,
+		IdempotencyKey:     ctx.GetHeader(idempotencyKeyHeader),
+	}, grpc.Header(&header))
 
 	if err != nil {
-		h.handlerResponse(ctx, "CatgeoryService().Create", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "CatgeoryService().Create", err)
 
 		return
 	}
 
+	if len(header.Get(idempotentReplayedHeader)) > 0 {
+		ctx.Header("Idempotent-Replayed", "true")
+	}
+
 	h.handlerResponse(ctx, "create order response", http.StatusOK, resp)
 }
 
@@ -122,20 +184,22 @@ func (h *Handler) CreateOrder(ctx *gin.Context) {
 // @Param price_from query integer false "price_from"
 // @Param price_to query integer false "price_to"
 // @Param payment_type query string false "payment_type"
+// @Param cursor query string false "next_cursor of the previous page, page is ignored with it"
+// @Param with_count query bool false "count the orders with a cursor too"
 // @Success      200  {array}   order_service.ListOrderResponse
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) GetListOrder(ctx *gin.Context) {
 	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
 	if err != nil {
-		h.handlerResponse(ctx, "error get page", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error get page", err)
 		return
 	}
 
 	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
 	if err != nil {
-		h.handlerResponse(ctx, "error get limit", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error get limit", err)
 		return
 	}
 	client_id, err := h.ParseQueryParam(ctx, "client_id", "0")
@@ -175,16 +239,50 @@ func (h *Handler) GetListOrder(ctx *gin.Context) {
 		PriceFrom:    float64(price_from),
 		PriceTo:      float64(price_to),
 		PaymentType:  ctx.Query("payment_type"),
+		Cursor:       ctx.Query("cursor"),
+		WithCount:    ctx.Query("with_count") == "true",
 	})
 
 	if err != nil {
-		h.handlerResponse(ctx, "error GetListOrder", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error GetListOrder", err)
 		return
 	}
 
+	h.setNextLink(ctx, resp.NextCursor)
 	h.handlerResponse(ctx, "get AllOrder response", http.StatusOK, resp)
 }
 
+// GetListLateOrder godoc
+// @Security ApiKeyAuth
+// @Router       /v1/order/late [get]
+// @Summary      Get late orders
+// @Description  get open orders whose promised delivery time has passed, oldest promise first
+// @Tags         order
+// @Accept       json
+// @Produce      json
+// @Param branch_id query integer false "branch_id"
+// @Success      200  {object}  order_service.ListOrderResponse
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetListLateOrder(ctx *gin.Context) {
+	branchId, err := strconv.Atoi(ctx.DefaultQuery("branch_id", "0"))
+	if err != nil {
+		h.handlerError(ctx, "error get branch_id", err)
+		return
+	}
+
+	resp, err := h.services.OrderService().ListLateOrders(ctx.Request.Context(), &order_service.ListLateOrdersRequest{
+		BranchId: int32(branchId),
+	})
+	if err != nil {
+		h.handlerError(ctx, "error GetListLateOrder", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get late orders response", http.StatusOK, resp)
+}
+
 // GetOrder godoc
 // @Security ApiKeyAuth
 // @Router       /v1/order/{id} [get]
@@ -195,15 +293,15 @@ func (h *Handler) GetListOrder(ctx *gin.Context) {
 // @Produce      json
 // @Param        id   path    string     true    "Order ID to retrieve"
 // @Success      200  {object}  order_service.Order
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) GetOrder(ctx *gin.Context) {
 	idStr := ctx.Param("id")
 
 	resp, err := h.services.OrderService().Get(ctx.Request.Context(), &order_service.IdStrRequest{Id: idStr})
 	if err != nil {
-		h.handlerResponse(ctx, "error order GetById", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error order GetById", err)
 		return
 	}
 
@@ -221,16 +319,16 @@ func (h *Handler) GetOrder(ctx *gin.Context) {
 // @Param        id       path    int     true    "Order ID to update"
 // @Param        order   body    order_service.UpdateOrderRequest  true    "Updated data for the order"
 // @Success      200  {object}  order_service.UpdateOrderRequest
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) UpdateOrder(ctx *gin.Context) {
 	var order = order_service.UpdateOrderRequest{}
 	id, err := strconv.Atoi(ctx.Param("id"))
 
 	err = ctx.ShouldBind(&order)
 	if err != nil {
-		h.handlerResponse(ctx, "error while binding", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error while binding", err)
 		return
 	}
 
@@ -239,7 +337,7 @@ func (h *Handler) UpdateOrder(ctx *gin.Context) {
 	resp, err := h.services.OrderService().Update(ctx.Request.Context(), &order)
 	fmt.Println("before  send bind", resp)
 	if err != nil {
-		h.handlerResponse(ctx, "error order Update", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error order Update", err)
 		return
 	}
 
@@ -256,22 +354,90 @@ func (h *Handler) UpdateOrder(ctx *gin.Context) {
 // @Produce      json
 // @Param        id   path    string     true    "Catgeory ID to retrieve"
 // @Success      200  {object}  order_service.Response
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) DeleteOrder(ctx *gin.Context) {
 	idStr := ctx.Param("id")
 
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
-		h.handlerResponse(ctx, "error branch GetById", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error branch GetById", err)
 		return
 	}
 	resp, err := h.services.OrderService().Delete(ctx.Request.Context(), &order_service.IdRequest{Id: int32(id)})
 	if err != nil {
-		h.handlerResponse(ctx, "error order Delete", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error order Delete", err)
 		return
 	}
 
 	h.handlerResponse(ctx, "delete order response", http.StatusOK, resp)
 }
+
+// RestoreOrder godoc
+// @Security ApiKeyAuth
+// @Router       /v1/order/{id}/restore [post]
+// @Summary      Restore a deleted order
+// @Description  Undoes the delete of a order that is not purged yet
+// @Tags         order
+// @Accept       json
+// @Produce      json
+// @Param        id   path    int     true    "ID of the deleted order"
+// @Success      200  {object}  order_service.Response
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      409  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) RestoreOrder(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error order Restore", err)
+		return
+	}
+
+	resp, err := h.services.OrderService().Restore(ctx.Request.Context(), &order_service.IdRequest{Id: int32(id)})
+	if err != nil {
+		h.handlerError(ctx, "error order Restore", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "restore order response", http.StatusOK, resp)
+}
+
+// GetListDeletedOrder godoc
+// @Security ApiKeyAuth
+// @Router       /v1/order/deleted [get]
+// @Summary      GetAll deleted orders
+// @Description  Deleted orders that can still be restored, they are purged once the retention is over
+// @Tags         order
+// @Accept       json
+// @Produce      json
+// @Param        limit    query     int  false  "limit for response"  Default(10)
+// @Param		 page     query     int  false  "page for response"   Default(1)
+// @Success      200  {object}  order_service.ListOrderResponse
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetListDeletedOrder(ctx *gin.Context) {
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		h.handlerError(ctx, "error get page", err)
+		return
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	if err != nil {
+		h.handlerError(ctx, "error get limit", err)
+		return
+	}
+
+	resp, err := h.services.OrderService().ListDeleted(ctx.Request.Context(), &order_service.ListDeletedRequest{
+		Page:  int32(page),
+		Limit: int32(limit),
+	})
+	if err != nil {
+		h.handlerError(ctx, "error GetListDeletedOrder", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get deleted order response", http.StatusOK, resp)
+}
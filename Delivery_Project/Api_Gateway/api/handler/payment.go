@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	order_service "api-gateway-service/genproto/order_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetOrderPayment godoc
+// @Security ApiKeyAuth
+// @Router       /v1/order/{id}/payment [get]
+// @Summary      Get payment of an order
+// @Description  Returns the card payment of the order with its status and payment url
+// @Tags         payment
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "Order ID"
+// @Success      200  {object}  order_service.Payment
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetOrderPayment(ctx *gin.Context) {
+	resp, err := h.services.PaymentService().Get(ctx.Request.Context(), &order_service.OrderIdRequest{OrderId: ctx.Param("id")})
+	if err != nil {
+		h.handlerError(ctx, "error payment Get", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get order payment response", http.StatusOK, resp)
+}
+
+// CreateOrderPayment godoc
+// @Security ApiKeyAuth
+// @Router       /v1/order/{id}/payment [post]
+// @Summary      Create a new payment intent for an order
+// @Description  Starts the card payment again, e.g. when the provider was not reachable while the order was created
+// @Tags         payment
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "Order ID"
+// @Success      200  {object}  order_service.Payment
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) CreateOrderPayment(ctx *gin.Context) {
+	resp, err := h.services.PaymentService().CreateIntent(ctx.Request.Context(), &order_service.OrderIdRequest{OrderId: ctx.Param("id")})
+	if err != nil {
+		h.handlerError(ctx, "error payment CreateIntent", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "create order payment response", http.StatusOK, resp)
+}
+
+// maxWebhookBody caps the callback body, provider notifications are well
+// below a kilobyte
+const maxWebhookBody = 64 << 10
+
+// PaymentWebhook godoc
+// @Router       /v1/payment/webhook/{provider} [post]
+// @Summary      Payment provider callback
+// @Description  Receives payment notifications, the raw body is verified by the provider adapter in order service. The reply body is in the provider's own format
+// @Tags         payment
+// @Accept       json
+// @Produce      json
+// @Param        provider     path    string  true   "payment provider name"
+// @Param        X-Signature  header  string  false  "signature of the body"
+// @Success      200
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      413  {object}  Response{data=string}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) PaymentWebhook(ctx *gin.Context) {
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxWebhookBody)
+	payload, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			h.handlerResponse(ctx, "error payment webhook body too large", http.StatusRequestEntityTooLarge, "payment webhook body is too large")
+			return
+		}
+		h.handlerError(ctx, "error reading payment webhook body", err)
+		return
+	}
+
+	resp, err := h.services.PaymentService().HandleWebhook(ctx.Request.Context(), &order_service.WebhookRequest{
+		Provider:  ctx.Param("provider"),
+		Payload:   payload,
+		Signature: ctx.GetHeader("X-Signature"),
+	})
+	if err != nil {
+		h.handlerError(ctx, "error payment HandleWebhook", err)
+		return
+	}
+
+	ctx.Data(http.StatusOK, resp.ContentType, resp.Body)
+}
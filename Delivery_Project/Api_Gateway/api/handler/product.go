@@ -20,19 +20,19 @@ import (
 // @Produce      json
 // @Param        product     body  product_service.CreateProductRequest true  "data of the product"
 // @Success      201  {object}  product_service.Response
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) CreateProduct(ctx *gin.Context) {
 	var product = product_service.CreateProductRequest{}
 
 	err := ctx.ShouldBindJSON(&product)
 	if err != nil {
-		h.handlerResponse(ctx, "CreateProduct", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "CreateProduct", err)
 		return
 	}
 
-	resp, err := h.services.ProductService().Create(ctx, &product_service.CreateProductRequest{
+	resp, err := h.services.ProductService().Create(ctx.Request.Context(), &product_service.CreateProductRequest{
 		Title:       product.Title,
 		Description: product.Description,
 		Photo:       product.Photo,
@@ -43,7 +43,7 @@ func (h *Handler) CreateProduct(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		h.handlerResponse(ctx, "CatgeoryService().Create", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "CatgeoryService().Create", err)
 
 		return
 	}
@@ -64,20 +64,22 @@ func (h *Handler) CreateProduct(ctx *gin.Context) {
 // @Param page query integer false "page"
 // @Param type query integer false "type"
 // @Param category_id query int false "category_id"
+// @Param cursor query string false "next_cursor of the previous page, page is ignored with it"
+// @Param with_count query bool false "count the products with a cursor too"
 // @Success      200  {array}   product_service.ListProductResponse
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) GetListProduct(ctx *gin.Context) {
 	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
 	if err != nil {
-		h.handlerResponse(ctx, "error get page", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error get page", err)
 		return
 	}
 
 	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
 	if err != nil {
-		h.handlerResponse(ctx, "error get limit", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error get limit", err)
 		return
 	}
 	category_id, err := h.ParseQueryParam(ctx, "category_id", "0")
@@ -86,18 +88,21 @@ func (h *Handler) GetListProduct(ctx *gin.Context) {
 		return
 	}
 	resp, err := h.services.ProductService().List(ctx.Request.Context(), &product_service.ListProductRequest{
-		Page:     int32(page),
-		Limit:    int32(limit),
-		Search:   ctx.Query("title"),
-		Type:     ctx.Query("type"),
-		Category: int32(category_id),
+		Page:      int32(page),
+		Limit:     int32(limit),
+		Search:    ctx.Query("title"),
+		Type:      ctx.Query("type"),
+		Category:  int32(category_id),
+		Cursor:    ctx.Query("cursor"),
+		WithCount: ctx.Query("with_count") == "true",
 	})
 
 	if err != nil {
-		h.handlerResponse(ctx, "error GetListProduct", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error GetListProduct", err)
 		return
 	}
 
+	h.setNextLink(ctx, resp.NextCursor)
 	h.handlerResponse(ctx, "get AllProduct response", http.StatusOK, resp)
 }
 
@@ -111,15 +116,15 @@ func (h *Handler) GetListProduct(ctx *gin.Context) {
 // @Produce      json
 // @Param        id   path    string     true    "Product ID to retrieve"
 // @Success      200  {object}  product_service.Product
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) GetProduct(ctx *gin.Context) {
 	id := ctx.Param("id")
 
 	resp, err := h.services.ProductService().Get(ctx.Request.Context(), &product_service.IdRequest{Id: id})
 	if err != nil {
-		h.handlerResponse(ctx, "error product GetById", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error product GetById", err)
 		return
 	}
 
@@ -137,16 +142,16 @@ func (h *Handler) GetProduct(ctx *gin.Context) {
 // @Param        id       path    int     true    "Product ID to update"
 // @Param        product   body    product_service.UpdateProductRequest  true    "Updated data for the product"
 // @Success      200  {object}  product_service.UpdateProductRequest
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) UpdateProduct(ctx *gin.Context) {
 	var product = product_service.UpdateProductRequest{}
 	id, err := strconv.Atoi(ctx.Param("id"))
 
 	err = ctx.ShouldBind(&product)
 	if err != nil {
-		h.handlerResponse(ctx, "error while binding", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error while binding", err)
 		return
 	}
 
@@ -155,7 +160,7 @@ func (h *Handler) UpdateProduct(ctx *gin.Context) {
 	resp, err := h.services.ProductService().Update(ctx.Request.Context(), &product)
 	fmt.Println("before  send bind", resp)
 	if err != nil {
-		h.handlerResponse(ctx, "error product Update", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error product Update", err)
 		return
 	}
 
@@ -172,17 +177,81 @@ func (h *Handler) UpdateProduct(ctx *gin.Context) {
 // @Produce      json
 // @Param        id   path    string     true    "Catgeory ID to retrieve"
 // @Success      200  {object}  product_service.Response
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) DeleteProduct(ctx *gin.Context) {
 	id := ctx.Param("id")
 
 	resp, err := h.services.ProductService().Delete(ctx.Request.Context(), &product_service.IdRequest{Id: id})
 	if err != nil {
-		h.handlerResponse(ctx, "error product Delete", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error product Delete", err)
 		return
 	}
 
 	h.handlerResponse(ctx, "delete product response", http.StatusOK, resp)
 }
+
+// RestoreProduct godoc
+// @Security ApiKeyAuth
+// @Router       /v1/product/{id}/restore [post]
+// @Summary      Restore a deleted product
+// @Description  Undoes the delete of a product that is not purged yet
+// @Tags         product
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "ID of the deleted product"
+// @Success      200  {object}  product_service.Response
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      409  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) RestoreProduct(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	resp, err := h.services.ProductService().Restore(ctx.Request.Context(), &product_service.IdRequest{Id: id})
+	if err != nil {
+		h.handlerError(ctx, "error product Restore", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "restore product response", http.StatusOK, resp)
+}
+
+// GetListDeletedProduct godoc
+// @Security ApiKeyAuth
+// @Router       /v1/product/deleted [get]
+// @Summary      GetAll deleted products
+// @Description  Deleted products that can still be restored, they are purged once the retention is over
+// @Tags         product
+// @Accept       json
+// @Produce      json
+// @Param        limit    query     int  false  "limit for response"  Default(10)
+// @Param		 page     query     int  false  "page for response"   Default(1)
+// @Success      200  {object}  product_service.ListProductResponse
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetListDeletedProduct(ctx *gin.Context) {
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		h.handlerError(ctx, "error get page", err)
+		return
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	if err != nil {
+		h.handlerError(ctx, "error get limit", err)
+		return
+	}
+
+	resp, err := h.services.ProductService().ListDeleted(ctx.Request.Context(), &product_service.ListDeletedRequest{
+		Page:  int32(page),
+		Limit: int32(limit),
+	})
+	if err != nil {
+		h.handlerError(ctx, "error GetListDeletedProduct", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get deleted product response", http.StatusOK, resp)
+}
@@ -0,0 +1,234 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	order_service "api-gateway-service/genproto/order_service"
+	product_service "api-gateway-service/genproto/product_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreatePromoCode godoc
+// @Security ApiKeyAuth
+// @Router       /v1/promo_code [post]
+// @Summary      Create a new promo_code
+// @Description  Create a new promo_code with the provided details
+// @Tags         promo_code
+// @Accept       json
+// @Produce      json
+// @Param        promo_code     body  order_service.CreatePromoCodeRequest true  "data of the promo_code"
+// @Success      201  {object}  order_service.Response
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) CreatePromoCode(ctx *gin.Context) {
+	var promoCode = order_service.CreatePromoCodeRequest{}
+
+	err := ctx.ShouldBindJSON(&promoCode)
+	if err != nil {
+		h.handlerError(ctx, "CreatePromoCode", err)
+		return
+	}
+
+	resp, err := h.services.PromoCodeService().Create(ctx.Request.Context(), &promoCode)
+	if err != nil {
+		h.handlerError(ctx, "PromoCodeService().Create", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "create promo_code response", http.StatusOK, resp)
+}
+
+// GetAllPromoCode godoc
+// @Security ApiKeyAuth
+// @Router       /v1/promo_code [get]
+// @Summary      GetAll PromoCode
+// @Description  get promo_code
+// @Tags         promo_code
+// @Accept       json
+// @Produce      json
+// @Param        limit    query     int  false  "limit for response"  Default(10)
+// @Param		 page     query     int  false  "page for response"   Default(1)
+// @Param        search     query     string false "search by code"
+// @Success      200  {array}   order_service.ListPromoCodeResponse
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetListPromoCode(ctx *gin.Context) {
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		h.handlerError(ctx, "error get page", err)
+		return
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	if err != nil {
+		h.handlerError(ctx, "error get limit", err)
+		return
+	}
+
+	resp, err := h.services.PromoCodeService().List(ctx.Request.Context(), &order_service.ListPromoCodeRequest{
+		Page:   int32(page),
+		Limit:  int32(limit),
+		Search: ctx.Query("search"),
+	})
+	if err != nil {
+		h.handlerError(ctx, "error GetListPromoCode", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get AllPromoCode response", http.StatusOK, resp)
+}
+
+// GetPromoCode godoc
+// @Security ApiKeyAuth
+// @Router       /v1/promo_code/{id} [get]
+// @Summary      Get a promo_code by ID
+// @Description  Retrieve a promo_code by its unique identifier
+// @Tags         promo_code
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "PromoCode ID to retrieve"
+// @Success      200  {object}  order_service.PromoCode
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetPromoCode(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error promo_code parse id", err)
+		return
+	}
+
+	resp, err := h.services.PromoCodeService().Get(ctx.Request.Context(), &order_service.IdRequest{Id: int32(id)})
+	if err != nil {
+		h.handlerError(ctx, "error promo_code GetById", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get promo_code response", http.StatusOK, resp)
+}
+
+// UpdatePromoCode godoc
+// @Security ApiKeyAuth
+// @Router       /v1/promo_code/{id} [put]
+// @Summary      Update an existing promo_code
+// @Description  Update an existing promo_code with the provided details
+// @Tags         promo_code
+// @Accept       json
+// @Produce      json
+// @Param        id       path    int     true    "PromoCode ID to update"
+// @Param        promo_code   body    order_service.UpdatePromoCodeRequest  true    "Updated data for the promo_code"
+// @Success      200  {object}  order_service.Response
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) UpdatePromoCode(ctx *gin.Context) {
+	var promoCode = order_service.UpdatePromoCodeRequest{}
+
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error promo_code parse id", err)
+		return
+	}
+
+	err = ctx.ShouldBindJSON(&promoCode)
+	if err != nil {
+		h.handlerError(ctx, "error while binding", err)
+		return
+	}
+
+	promoCode.Id = int32(id)
+
+	resp, err := h.services.PromoCodeService().Update(ctx.Request.Context(), &promoCode)
+	if err != nil {
+		h.handlerError(ctx, "error promo_code Update", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "update promo_code response", http.StatusOK, resp)
+}
+
+// DeletePromoCode godoc
+// @Security ApiKeyAuth
+// @Router       /v1/promo_code/{id} [delete]
+// @Summary      Delete a promo_code
+// @Description  delete a promo_code by its unique identifier
+// @Tags         promo_code
+// @Accept       json
+// @Produce      json
+// @Param        id   path    string     true    "PromoCode ID to delete"
+// @Success      200  {object}  order_service.Response
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) DeletePromoCode(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error promo_code parse id", err)
+		return
+	}
+
+	resp, err := h.services.PromoCodeService().Delete(ctx.Request.Context(), &order_service.IdRequest{Id: int32(id)})
+	if err != nil {
+		h.handlerError(ctx, "error promo_code Delete", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "delete promo_code response", http.StatusOK, resp)
+}
+
+// ApplyPromoCode godoc
+// @Security ApiKeyAuth
+// @Router       /v1/promo_code/apply [post]
+// @Summary      Check a promo_code against an order
+// @Description  Validates the promo_code for the client, branch and products and returns the discount it gives
+// @Tags         promo_code
+// @Accept       json
+// @Produce      json
+// @Param        promo_code     body  order_service.ApplyPromoRequest true  "order data to apply the promo_code to"
+// @Success      200  {object}  order_service.ApplyPromoResponse
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) ApplyPromoCode(ctx *gin.Context) {
+	var req = order_service.ApplyPromoRequest{}
+
+	err := ctx.ShouldBindJSON(&req)
+	if err != nil {
+		h.handlerError(ctx, "ApplyPromoCode", err)
+		return
+	}
+
+	err = h.fillOrderProducts(ctx, req.Products)
+	if err != nil {
+		h.handlerError(ctx, "error product GetById in apply promo_code", err)
+		return
+	}
+
+	resp, err := h.services.PromoCodeService().ApplyPromo(ctx.Request.Context(), &req)
+	if err != nil {
+		h.handlerError(ctx, "PromoCodeService().ApplyPromo", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "apply promo_code response", http.StatusOK, resp)
+}
+
+// fillOrderProducts takes price and category of every product from product service,
+// so the promo restrictions do not rely on what the client sent
+func (h *Handler) fillOrderProducts(ctx *gin.Context, products []*order_service.OrderProducts) error {
+	for _, p := range products {
+		product, err := h.services.ProductService().Get(ctx.Request.Context(), &product_service.IdRequest{Id: strconv.Itoa(int(p.ProductId))})
+		if err != nil {
+			return err
+		}
+
+		p.Price = product.Price
+		p.CategoryId = product.CategoryId
+	}
+
+	return nil
+}
@@ -21,15 +21,15 @@ import (
 // @Produce      json
 // @Param        user     body  user_service.CreateUsersRequest true  "data of the user"
 // @Success      201  {object}  user_service.Response
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) CreateUser(ctx *gin.Context) {
 	var user = user_service.CreateUsersRequest{}
 
 	err := ctx.ShouldBindJSON(&user)
 	if err != nil {
-		h.handlerResponse(ctx, "CreateUser", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "CreateUser", err)
 		return
 	}
 	hashedPass, err := helper.GeneratePasswordHash(user.Password)
@@ -40,7 +40,7 @@ func (h *Handler) CreateUser(ctx *gin.Context) {
 	}
 	user.Password = string(hashedPass)
 
-	resp, err := h.services.UserService().Create(ctx, &user_service.CreateUsersRequest{
+	resp, err := h.services.UserService().Create(ctx.Request.Context(), &user_service.CreateUsersRequest{
 		Firstname: user.Firstname,
 		Lastname:  user.Lastname,
 		BranchId:  user.BranchId,
@@ -50,7 +50,7 @@ func (h *Handler) CreateUser(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		h.handlerResponse(ctx, "CatgeoryService().Create", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "CatgeoryService().Create", err)
 
 		return
 	}
@@ -72,19 +72,19 @@ func (h *Handler) CreateUser(ctx *gin.Context) {
 // @Param        created_at_from     query     string false "search by created_at_from"
 // @Param        created_at_to     query     string false "search by created_at_to"
 // @Success      200  {array}   user_service.ListUsersResponse
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) GetListUser(ctx *gin.Context) {
 	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
 	if err != nil {
-		h.handlerResponse(ctx, "error get page", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error get page", err)
 		return
 	}
 
 	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
 	if err != nil {
-		h.handlerResponse(ctx, "error get limit", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error get limit", err)
 		return
 	}
 
@@ -97,7 +97,7 @@ func (h *Handler) GetListUser(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		h.handlerResponse(ctx, "error GetListUser", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error GetListUser", err)
 		return
 	}
 
@@ -114,20 +114,20 @@ func (h *Handler) GetListUser(ctx *gin.Context) {
 // @Produce      json
 // @Param        id   path    string     true    "User ID to retrieve"
 // @Success      200  {object}  user_service.Users
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) GetUser(ctx *gin.Context) {
 	idStr := ctx.Param("id")
 
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
-		h.handlerResponse(ctx, "error branch GetById", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error branch GetById", err)
 		return
 	}
 	resp, err := h.services.UserService().Get(ctx.Request.Context(), &user_service.IdRequest{Id: int32(id)})
 	if err != nil {
-		h.handlerResponse(ctx, "error user GetById", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error user GetById", err)
 		return
 	}
 
@@ -145,16 +145,16 @@ func (h *Handler) GetUser(ctx *gin.Context) {
 // @Param        id       path    int     true    "User ID to update"
 // @Param        user   body    user_service.UpdateUsersRequest  true    "Updated data for the user"
 // @Success      200  {object}  user_service.UpdateUsersRequest
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) UpdateUser(ctx *gin.Context) {
 	var user = user_service.UpdateUsersRequest{}
 	id, err := strconv.Atoi(ctx.Param("id"))
 
 	err = ctx.ShouldBind(&user)
 	if err != nil {
-		h.handlerResponse(ctx, "error while binding", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error while binding", err)
 		return
 	}
 
@@ -163,7 +163,7 @@ func (h *Handler) UpdateUser(ctx *gin.Context) {
 	resp, err := h.services.UserService().Update(ctx.Request.Context(), &user)
 	fmt.Println("before  send bind", resp)
 	if err != nil {
-		h.handlerResponse(ctx, "error user Update", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error user Update", err)
 		return
 	}
 
@@ -180,22 +180,90 @@ func (h *Handler) UpdateUser(ctx *gin.Context) {
 // @Produce      json
 // @Param        id   path    string     true    "Catgeory ID to retrieve"
 // @Success      200  {object}  user_service.Response
-// @Failure      400  {object}  Response{data=string}
-// @Failure      404  {object}  Response{data=string}
-// @Failure      500  {object}  Response{data=string}
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
 func (h *Handler) DeleteUser(ctx *gin.Context) {
 	idStr := ctx.Param("id")
 
 	id, err := strconv.ParseInt(idStr, 10, 32)
 	if err != nil {
-		h.handlerResponse(ctx, "error branch GetById", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error branch GetById", err)
 		return
 	}
 	resp, err := h.services.UserService().Delete(ctx.Request.Context(), &user_service.IdRequest{Id: int32(id)})
 	if err != nil {
-		h.handlerResponse(ctx, "error user Delete", http.StatusBadRequest, err.Error())
+		h.handlerError(ctx, "error user Delete", err)
 		return
 	}
 
 	h.handlerResponse(ctx, "delete user response", http.StatusOK, resp)
 }
+
+// RestoreUser godoc
+// @Security ApiKeyAuth
+// @Router       /v1/user/{id}/restore [post]
+// @Summary      Restore a deleted user
+// @Description  Undoes the delete of a user that is not purged yet
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        id   path    int     true    "ID of the deleted user"
+// @Success      200  {object}  user_service.Response
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      404  {object}  Response{data=response.ErrorResp}
+// @Failure      409  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) RestoreUser(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		h.handlerError(ctx, "error user Restore", err)
+		return
+	}
+
+	resp, err := h.services.UserService().Restore(ctx.Request.Context(), &user_service.IdRequest{Id: int32(id)})
+	if err != nil {
+		h.handlerError(ctx, "error user Restore", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "restore user response", http.StatusOK, resp)
+}
+
+// GetListDeletedUser godoc
+// @Security ApiKeyAuth
+// @Router       /v1/user/deleted [get]
+// @Summary      GetAll deleted users
+// @Description  Deleted users that can still be restored, they are purged once the retention is over
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        limit    query     int  false  "limit for response"  Default(10)
+// @Param		 page     query     int  false  "page for response"   Default(1)
+// @Success      200  {object}  user_service.ListUsersResponse
+// @Failure      400  {object}  Response{data=response.ErrorResp}
+// @Failure      500  {object}  Response{data=response.ErrorResp}
+func (h *Handler) GetListDeletedUser(ctx *gin.Context) {
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		h.handlerError(ctx, "error get page", err)
+		return
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	if err != nil {
+		h.handlerError(ctx, "error get limit", err)
+		return
+	}
+
+	resp, err := h.services.UserService().ListDeleted(ctx.Request.Context(), &user_service.ListDeletedRequest{
+		Page:  int32(page),
+		Limit: int32(limit),
+	})
+	if err != nil {
+		h.handlerError(ctx, "error GetListDeletedUser", err)
+		return
+	}
+
+	h.handlerResponse(ctx, "get deleted user response", http.StatusOK, resp)
+}
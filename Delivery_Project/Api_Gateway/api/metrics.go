@@ -0,0 +1,49 @@
+package api
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// metricsMiddleware counts every request and its latency by route, and
+// returns the handler serving them in Prometheus format
+func metricsMiddleware() (gin.HandlerFunc, gin.HandlerFunc) {
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "HTTP requests completed by method, route and status code.",
+	}, []string{"method", "route", "code"})
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "Time spent serving HTTP requests by method and route.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		requests,
+		duration,
+	)
+
+	middleware := func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		// unmatched paths share one label, so random URLs do not grow the series
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+
+		requests.WithLabelValues(c.Request.Method, route, strconv.Itoa(c.Writer.Status())).Inc()
+		duration.WithLabelValues(c.Request.Method, route).Observe(time.Since(start).Seconds())
+	}
+
+	return middleware, gin.WrapH(promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+}
@@ -0,0 +1,72 @@
+package response
+
+import (
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// httpStatuses follows the mapping of google.rpc.Code to HTTP
+var httpStatuses = map[codes.Code]int{
+	codes.OK:                 http.StatusOK,
+	codes.Canceled:           499,
+	codes.Unknown:            http.StatusInternalServerError,
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.FailedPrecondition: http.StatusBadRequest,
+	codes.Aborted:            http.StatusConflict,
+	codes.OutOfRange:         http.StatusBadRequest,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Internal:           http.StatusInternalServerError,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+	codes.DataLoss:           http.StatusInternalServerError,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+}
+
+// FromError builds the HTTP status and body for an error. gRPC errors keep
+// the code the service gave them, other errors come from parsing the request
+// and are reported as INVALID_ARGUMENT. Validation failures carry their field
+// violations, details of server side failures are not sent to the client. An
+// unavailable service keeps its message, the gateway writes it itself.
+func FromError(err error) (int, ErrorResp) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return http.StatusBadRequest, ErrorResp{Code: code.Code_INVALID_ARGUMENT.String(), Message: err.Error()}
+	}
+
+	httpStatus, ok := httpStatuses[st.Code()]
+	if !ok {
+		httpStatus = http.StatusInternalServerError
+	}
+
+	resp := ErrorResp{
+		Code:    code.Code(st.Code()).String(),
+		Message: st.Message(),
+	}
+
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			if d.Reason != "" {
+				resp.Code = d.Reason
+			}
+		case *errdetails.BadRequest:
+			for _, v := range d.FieldViolations {
+				resp.Violations = append(resp.Violations, FieldViolation{Field: v.Field, Description: v.Description})
+			}
+		}
+	}
+
+	if httpStatus >= http.StatusInternalServerError && st.Code() != codes.Unavailable {
+		resp.Message = http.StatusText(httpStatus)
+	}
+
+	return httpStatus, resp
+}
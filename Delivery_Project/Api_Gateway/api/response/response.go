@@ -1,10 +1,58 @@
 package response
 
+import (
+	"encoding/json"
+
+	order_service "api-gateway-service/genproto/order_service"
+	user_service "api-gateway-service/genproto/user_service"
+)
+
 type ErrorResp struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+	Code       string           `json:"code"`
+	Message    string           `json:"message"`
+	Violations []FieldViolation `json:"violations,omitempty"`
+}
+
+// FieldViolation is one failed validation rule of the request
+type FieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
 }
 
 type CreateResponse struct {
 	Id string `json:"id"`
 }
+
+// HealthResp is the body of the health probes, services maps every
+// downstream service to its gRPC serving status
+type HealthResp struct {
+	Status   string            `json:"status"`
+	Services map[string]string `json:"services"`
+}
+
+// AuditEntry is one change from the audit log of a service, before and after
+// hold the changed columns
+type AuditEntry struct {
+	Service   string          `json:"service"`
+	Id        int64           `json:"id"`
+	Entity    string          `json:"entity"`
+	EntityId  string          `json:"entity_id"`
+	Action    string          `json:"action"`
+	Actor     string          `json:"actor"`
+	RequestId string          `json:"request_id"`
+	Before    json.RawMessage `json:"before" swaggertype:"object"`
+	After     json.RawMessage `json:"after" swaggertype:"object"`
+	CreatedAt string          `json:"created_at"`
+}
+
+type AuditListResp struct {
+	Entries []AuditEntry `json:"entries"`
+	Count   int32        `json:"count"`
+}
+
+// ClientDataExport is everything the services keep about a client, user holds
+// the personal data and orders the orders with their payments and feedback
+type ClientDataExport struct {
+	User   *user_service.ClientDataExport    `json:"user"`
+	Orders *order_service.ClientOrdersExport `json:"orders"`
+}
@@ -0,0 +1,157 @@
+// Command certgen writes a local CA and the certificates of the gateway and
+// the services for running them with mutual TLS in development. Nothing is
+// downloaded, the files are written to -out:
+//
+//	ca.crt                              the CA every side trusts
+//	api-gateway.crt, api-gateway.key    client certificate of the gateway
+//	<service>.crt, <service>.key        server certificates of the services,
+//	                                    order-service dials user-service with its own
+//
+// go run ./cmd/certgen -out ./certs
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// services are dialed by the gateway with these names, see services.dial
+var services = []string{"user-service", "product-service", "order-service"}
+
+// serviceClients also dial another service, so their certificate is a client one too
+var serviceClients = map[string]bool{"order-service": true}
+
+func main() {
+	out := flag.String("out", "certs", "directory the certificates are written to")
+	days := flag.Int("days", 365, "validity of the certificates in days")
+	hosts := flag.String("hosts", "localhost,127.0.0.1", "comma separated extra DNS names and IPs of the service certificates")
+	flag.Parse()
+
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		log.Fatalf("Failed to create %s: %v", *out, err)
+	}
+
+	validity := time.Duration(*days) * 24 * time.Hour
+
+	ca, caKey, err := newCertificate(&x509.Certificate{
+		Subject:               pkix.Name{CommonName: "delivery-dev-ca"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}, validity, nil, nil)
+	if err != nil {
+		log.Fatalf("Failed to create CA: %v", err)
+	}
+	if err := write(*out, "ca", ca, nil); err != nil {
+		log.Fatalf("Failed to write CA: %v", err)
+	}
+	// the CA key is only needed to sign the certificates below, so it is not kept
+
+	gateway, gatewayKey, err := newCertificate(&x509.Certificate{
+		Subject:     pkix.Name{CommonName: "api-gateway"},
+		DNSNames:    []string{"api-gateway"},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, validity, ca, caKey)
+	if err != nil {
+		log.Fatalf("Failed to create gateway certificate: %v", err)
+	}
+	if err := write(*out, "api-gateway", gateway, gatewayKey); err != nil {
+		log.Fatalf("Failed to write gateway certificate: %v", err)
+	}
+
+	for _, name := range services {
+		template := &x509.Certificate{
+			Subject:     pkix.Name{CommonName: name},
+			DNSNames:    []string{name},
+			KeyUsage:    x509.KeyUsageDigitalSignature,
+			ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		}
+		if serviceClients[name] {
+			template.ExtKeyUsage = append(template.ExtKeyUsage, x509.ExtKeyUsageClientAuth)
+		}
+		for _, host := range strings.Split(*hosts, ",") {
+			host = strings.TrimSpace(host)
+			if ip := net.ParseIP(host); ip != nil {
+				template.IPAddresses = append(template.IPAddresses, ip)
+			} else if host != "" {
+				template.DNSNames = append(template.DNSNames, host)
+			}
+		}
+
+		cert, key, err := newCertificate(template, validity, ca, caKey)
+		if err != nil {
+			log.Fatalf("Failed to create %s certificate: %v", name, err)
+		}
+		if err := write(*out, name, cert, key); err != nil {
+			log.Fatalf("Failed to write %s certificate: %v", name, err)
+		}
+	}
+
+	fmt.Printf("certificates written to %s\n", *out)
+}
+
+// newCertificate signs template with parent, a nil parent makes it self-signed
+func newCertificate(template *x509.Certificate, validity time.Duration, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template.SerialNumber = serial
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(validity)
+
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cert, key, nil
+}
+
+// write saves <name>.crt and, when key is set, <name>.key readable only by the owner
+func write(dir, name string, cert *x509.Certificate, key *ecdsa.PrivateKey) error {
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	if err := os.WriteFile(filepath.Join(dir, name+".crt"), certPEM, 0o644); err != nil {
+		return err
+	}
+
+	if key == nil {
+		return nil
+	}
+
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+	return os.WriteFile(filepath.Join(dir, name+".key"), keyPEM, 0o600)
+}
@@ -6,15 +6,21 @@ import (
 	"api-gateway-service/config"
 	"api-gateway-service/pkg/logger"
 	"api-gateway-service/services"
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"os/signal"
+	"syscall"
 
 	"github.com/gin-gonic/gin"
 )
 
 func main() {
 	cfg := config.Load()
-
-	fmt.Printf("config: %+v/n", cfg)
+	if err := cfg.Validate(); err != nil {
+		panic(err)
+	}
 
 	// Setup Logger
 	loggerLevel := logger.LevelDebug
@@ -30,10 +36,15 @@ func main() {
 	log := logger.NewLogger(cfg.ServiceName, loggerLevel)
 	defer logger.Cleanup(log)
 
-	grpcSrvc, err := services.NewGrpcClients(cfg)
+	// SIGTERM and SIGINT start the shutdown
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	grpcSrvc, err := services.NewGrpcClients(cfg, log)
 	if err != nil {
 		panic(err)
 	}
+	defer grpcSrvc.Close()
 
 	r := gin.New()
 
@@ -41,10 +52,39 @@ func main() {
 
 	api.SetUpApi(r, h, cfg)
 
+	srv := &http.Server{
+		Addr:         cfg.HTTPPort,
+		Handler:      r,
+		ReadTimeout:  cfg.ReadTimeout,
+		WriteTimeout: cfg.WriteTimeout,
+		IdleTimeout:  cfg.IdleTimeout,
+	}
+
 	fmt.Println("Start api gateway...")
 
-	err = r.Run(cfg.HTTPPort)
-	if err != nil {
-		return
+	serveErr := make(chan error, 1)
+	go func() {
+		if cfg.TLSCertFile != "" || cfg.TLSKeyFile != "" {
+			serveErr <- srv.ListenAndServeTLS(cfg.TLSCertFile, cfg.TLSKeyFile)
+			return
+		}
+		serveErr <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err = <-serveErr:
+		if !errors.Is(err, http.ErrServerClosed) {
+			log.Error("error while serving", logger.Error(err))
+		}
+	case <-ctx.Done():
+		log.Info("shutting down", logger.Any("timeout", cfg.ShutdownTimeout))
+
+		// requests still running after the timeout, like event streams, are cut off
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+		defer cancel()
+		if err = srv.Shutdown(shutdownCtx); err != nil {
+			log.Error("error while shutting down", logger.Error(err))
+			_ = srv.Close()
+		}
 	}
 }
@@ -30,6 +30,16 @@ type Config struct {
 	HTTPScheme  string
 	Domain      string
 
+	// HTTPS is served when both files are set
+	TLSCertFile string
+	TLSKeyFile  string
+
+	// WriteTimeout is off by default, kitchen event streams stay open for hours
+	ReadTimeout     time.Duration
+	WriteTimeout    time.Duration
+	IdleTimeout     time.Duration
+	ShutdownTimeout time.Duration
+
 	DefaultOffset  string
 	DefaultLimit   string
 	DefaultBarCode string
@@ -44,9 +54,34 @@ type Config struct {
 	OrderServiceHost string
 	OrderGRPCPort    string
 
+	// the services are dialed over TLS when a CA is set, the certificate makes
+	// it mutual. Services are expected to present certificates issued to their
+	// name with dashes, e.g. order-service, see cmd/certgen.
+	GRPCTLSCAFile   string
+	GRPCTLSCertFile string
+	GRPCTLSKeyFile  string
+
+	// keepalive pings on the connections to the services
+	GRPCKeepaliveTime    time.Duration
+	GRPCKeepaliveTimeout time.Duration
+
+	// deadlines of calls to the services, reads are tried up to GRPCMaxAttempts
+	// times while the service is unavailable
+	GRPCReadTimeout  time.Duration
+	GRPCWriteTimeout time.Duration
+	GRPCMaxAttempts  int
+
+	// a service failing BreakerFailureThreshold calls in a row is not called
+	// for BreakerOpenTimeout
+	BreakerFailureThreshold int
+	BreakerOpenTimeout      time.Duration
+
 	PostgresMaxConnections int32
 
 	SecretKey string
+
+	// the identity of the caller is signed with it for the services
+	IdentitySecret string
 }
 
 const (
@@ -71,11 +106,20 @@ func Load() Config {
 	config.HTTPScheme = cast.ToString(getOrReturnDefaultValue("HTTP_Scheme", "http"))
 	config.Domain = cast.ToString(getOrReturnDefaultValue("DOMAIN", "localhost:8001"))
 
+	config.TLSCertFile = cast.ToString(getOrReturnDefaultValue("TLS_CERT_FILE", ""))
+	config.TLSKeyFile = cast.ToString(getOrReturnDefaultValue("TLS_KEY_FILE", ""))
+
+	config.ReadTimeout = cast.ToDuration(getOrReturnDefaultValue("HTTP_READ_TIMEOUT", "15s"))
+	config.WriteTimeout = cast.ToDuration(getOrReturnDefaultValue("HTTP_WRITE_TIMEOUT", "0s"))
+	config.IdleTimeout = cast.ToDuration(getOrReturnDefaultValue("HTTP_IDLE_TIMEOUT", "60s"))
+	config.ShutdownTimeout = cast.ToDuration(getOrReturnDefaultValue("SHUTDOWN_TIMEOUT", "15s"))
+
 	config.DefaultOffset = cast.ToString(getOrReturnDefaultValue("OFFSET", "0"))
 	config.DefaultLimit = cast.ToString(getOrReturnDefaultValue("LIMIT", "10"))
 	config.DefaultBarCode = cast.ToString(getOrReturnDefaultValue("BAR_CODE", ""))
 	config.DefaultSaleId = cast.ToString(getOrReturnDefaultValue("SALE_ID", ""))
 
+	// a service running on several hosts has them comma separated, e.g. order-1,order-2
 	config.ProductServiceHost = cast.ToString(getOrReturnDefaultValue("PRODUCT_SERVICE_HOST", "localhost"))
 	config.ProductGRPCPort = cast.ToString(getOrReturnDefaultValue("PRODUCT_GRPC_PORT", ":50052"))
 
@@ -85,13 +129,41 @@ func Load() Config {
 	config.UserServiceHost = cast.ToString(getOrReturnDefaultValue("USER_SERVICE_HOST", "localhost"))
 	config.UserGRPCPort = cast.ToString(getOrReturnDefaultValue("USER_GRPC_PORT", ":50051"))
 
+	config.GRPCTLSCAFile = cast.ToString(getOrReturnDefaultValue("GRPC_TLS_CA_FILE", ""))
+	config.GRPCTLSCertFile = cast.ToString(getOrReturnDefaultValue("GRPC_TLS_CERT_FILE", ""))
+	config.GRPCTLSKeyFile = cast.ToString(getOrReturnDefaultValue("GRPC_TLS_KEY_FILE", ""))
+
+	config.GRPCKeepaliveTime = cast.ToDuration(getOrReturnDefaultValue("GRPC_KEEPALIVE_TIME", "30s"))
+	config.GRPCKeepaliveTimeout = cast.ToDuration(getOrReturnDefaultValue("GRPC_KEEPALIVE_TIMEOUT", "10s"))
+
+	config.GRPCReadTimeout = cast.ToDuration(getOrReturnDefaultValue("GRPC_READ_TIMEOUT", "5s"))
+	config.GRPCWriteTimeout = cast.ToDuration(getOrReturnDefaultValue("GRPC_WRITE_TIMEOUT", "10s"))
+	config.GRPCMaxAttempts = cast.ToInt(getOrReturnDefaultValue("GRPC_MAX_ATTEMPTS", 3))
+
+	config.BreakerFailureThreshold = cast.ToInt(getOrReturnDefaultValue("BREAKER_FAILURE_THRESHOLD", 5))
+	config.BreakerOpenTimeout = cast.ToDuration(getOrReturnDefaultValue("BREAKER_OPEN_TIMEOUT", "30s"))
+
 	config.PostgresMaxConnections = cast.ToInt32(getOrReturnDefaultValue("POSTGRES_MAX_CONNECTIONS", 30))
 
 	config.SecretKey = cast.ToString(getOrReturnDefaultValue("SECRET_KEY", "final"))
+	config.IdentitySecret = cast.ToString(getOrReturnDefaultValue("IDENTITY_SECRET", devIdentitySecret))
 
 	return config
 }
 
+// devIdentitySecret is the default IDENTITY_SECRET. It is public, so Validate
+// only accepts it in debug and test mode.
+const devIdentitySecret = "MyIdentitySecret"
+
+// Validate rejects settings that are only safe in development
+func (c Config) Validate() error {
+	if c.IdentitySecret == devIdentitySecret && c.Environment != DebugMode && c.Environment != TestMode {
+		return fmt.Errorf("IDENTITY_SECRET must be set in %s mode", c.Environment)
+	}
+
+	return nil
+}
+
 func getOrReturnDefaultValue(key string, defaultValue interface{}) interface{} {
 	val, exists := os.LookupEnv(key)
 
@@ -28,9 +28,13 @@ type OrderServiceClient interface {
 	Update(ctx context.Context, in *UpdateOrderRequest, opts ...grpc.CallOption) (*Response, error)
 	UpdateStatus(ctx context.Context, in *UpdateOrderStatusRequest, opts ...grpc.CallOption) (*Response, error)
 	Delete(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Response, error)
+	Restore(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Response, error)
+	ListDeleted(ctx context.Context, in *ListDeletedRequest, opts ...grpc.CallOption) (*ListOrderResponse, error)
 	GetOrderStatus(ctx context.Context, in *OrderIdRequest, opts ...grpc.CallOption) (*OrderStatusResponse, error)
 	GetAllAcceptedOrders(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Order, error)
 	GetAllAcceptableOrders(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Order, error)
+	ListLateOrders(ctx context.Context, in *ListLateOrdersRequest, opts ...grpc.CallOption) (*ListOrderResponse, error)
+	CompletePickup(ctx context.Context, in *CompletePickupRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
 type orderServiceClient struct {
@@ -95,6 +99,24 @@ func (c *orderServiceClient) Delete(ctx context.Context, in *IdRequest, opts ...
 	return out, nil
 }
 
+func (c *orderServiceClient) Restore(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/order_service.OrderService/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListDeleted(ctx context.Context, in *ListDeletedRequest, opts ...grpc.CallOption) (*ListOrderResponse, error) {
+	out := new(ListOrderResponse)
+	err := c.cc.Invoke(ctx, "/order_service.OrderService/ListDeleted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderServiceClient) GetOrderStatus(ctx context.Context, in *OrderIdRequest, opts ...grpc.CallOption) (*OrderStatusResponse, error) {
 	out := new(OrderStatusResponse)
 	err := c.cc.Invoke(ctx, "/order_service.OrderService/GetOrderStatus", in, out, opts...)
@@ -122,6 +144,24 @@ func (c *orderServiceClient) GetAllAcceptableOrders(ctx context.Context, in *IdR
 	return out, nil
 }
 
+func (c *orderServiceClient) ListLateOrders(ctx context.Context, in *ListLateOrdersRequest, opts ...grpc.CallOption) (*ListOrderResponse, error) {
+	out := new(ListOrderResponse)
+	err := c.cc.Invoke(ctx, "/order_service.OrderService/ListLateOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CompletePickup(ctx context.Context, in *CompletePickupRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/order_service.OrderService/CompletePickup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility
@@ -132,9 +172,13 @@ type OrderServiceServer interface {
 	Update(context.Context, *UpdateOrderRequest) (*Response, error)
 	UpdateStatus(context.Context, *UpdateOrderStatusRequest) (*Response, error)
 	Delete(context.Context, *IdRequest) (*Response, error)
+	Restore(context.Context, *IdRequest) (*Response, error)
+	ListDeleted(context.Context, *ListDeletedRequest) (*ListOrderResponse, error)
 	GetOrderStatus(context.Context, *OrderIdRequest) (*OrderStatusResponse, error)
 	GetAllAcceptedOrders(context.Context, *IdRequest) (*Order, error)
 	GetAllAcceptableOrders(context.Context, *IdRequest) (*Order, error)
+	ListLateOrders(context.Context, *ListLateOrdersRequest) (*ListOrderResponse, error)
+	CompletePickup(context.Context, *CompletePickupRequest) (*Response, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -160,6 +204,12 @@ func (UnimplementedOrderServiceServer) UpdateStatus(context.Context, *UpdateOrde
 func (UnimplementedOrderServiceServer) Delete(context.Context, *IdRequest) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedOrderServiceServer) Restore(context.Context, *IdRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedOrderServiceServer) ListDeleted(context.Context, *ListDeletedRequest) (*ListOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeleted not implemented")
+}
 func (UnimplementedOrderServiceServer) GetOrderStatus(context.Context, *OrderIdRequest) (*OrderStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrderStatus not implemented")
 }
@@ -169,6 +219,12 @@ func (UnimplementedOrderServiceServer) GetAllAcceptedOrders(context.Context, *Id
 func (UnimplementedOrderServiceServer) GetAllAcceptableOrders(context.Context, *IdRequest) (*Order, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllAcceptableOrders not implemented")
 }
+func (UnimplementedOrderServiceServer) ListLateOrders(context.Context, *ListLateOrdersRequest) (*ListOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLateOrders not implemented")
+}
+func (UnimplementedOrderServiceServer) CompletePickup(context.Context, *CompletePickupRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompletePickup not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 
 // UnsafeOrderServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -290,6 +346,42 @@ func _OrderService_Delete_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.OrderService/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).Restore(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListDeleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListDeleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.OrderService/ListDeleted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListDeleted(ctx, req.(*ListDeletedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_GetOrderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OrderIdRequest)
 	if err := dec(in); err != nil {
@@ -344,6 +436,42 @@ func _OrderService_GetAllAcceptableOrders_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_ListLateOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLateOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListLateOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.OrderService/ListLateOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListLateOrders(ctx, req.(*ListLateOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CompletePickup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompletePickupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CompletePickup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.OrderService/CompletePickup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CompletePickup(ctx, req.(*CompletePickupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -375,6 +503,14 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _OrderService_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _OrderService_Restore_Handler,
+		},
+		{
+			MethodName: "ListDeleted",
+			Handler:    _OrderService_ListDeleted_Handler,
+		},
 		{
 			MethodName: "GetOrderStatus",
 			Handler:    _OrderService_GetOrderStatus_Handler,
@@ -387,6 +523,14 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllAcceptableOrders",
 			Handler:    _OrderService_GetAllAcceptableOrders_Handler,
 		},
+		{
+			MethodName: "ListLateOrders",
+			Handler:    _OrderService_ListLateOrders_Handler,
+		},
+		{
+			MethodName: "CompletePickup",
+			Handler:    _OrderService_CompletePickup_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "order.proto",
@@ -27,6 +27,8 @@ type BranchServiceClient interface {
 	List(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (*ListBranchResponse, error)
 	Update(ctx context.Context, in *UpdateBranchRequest, opts ...grpc.CallOption) (*Response, error)
 	Delete(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Response, error)
+	Restore(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Response, error)
+	ListDeleted(ctx context.Context, in *ListDeletedRequest, opts ...grpc.CallOption) (*ListBranchResponse, error)
 	ListActive(ctx context.Context, in *ListActiveBranchRequest, opts ...grpc.CallOption) (*ListBranchResponse, error)
 }
 
@@ -83,6 +85,24 @@ func (c *branchServiceClient) Delete(ctx context.Context, in *IdRequest, opts ..
 	return out, nil
 }
 
+func (c *branchServiceClient) Restore(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/user_service.BranchService/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *branchServiceClient) ListDeleted(ctx context.Context, in *ListDeletedRequest, opts ...grpc.CallOption) (*ListBranchResponse, error) {
+	out := new(ListBranchResponse)
+	err := c.cc.Invoke(ctx, "/user_service.BranchService/ListDeleted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *branchServiceClient) ListActive(ctx context.Context, in *ListActiveBranchRequest, opts ...grpc.CallOption) (*ListBranchResponse, error) {
 	out := new(ListBranchResponse)
 	err := c.cc.Invoke(ctx, "/user_service.BranchService/ListActive", in, out, opts...)
@@ -101,6 +121,8 @@ type BranchServiceServer interface {
 	List(context.Context, *ListBranchRequest) (*ListBranchResponse, error)
 	Update(context.Context, *UpdateBranchRequest) (*Response, error)
 	Delete(context.Context, *IdRequest) (*Response, error)
+	Restore(context.Context, *IdRequest) (*Response, error)
+	ListDeleted(context.Context, *ListDeletedRequest) (*ListBranchResponse, error)
 	ListActive(context.Context, *ListActiveBranchRequest) (*ListBranchResponse, error)
 	mustEmbedUnimplementedBranchServiceServer()
 }
@@ -124,6 +146,12 @@ func (UnimplementedBranchServiceServer) Update(context.Context, *UpdateBranchReq
 func (UnimplementedBranchServiceServer) Delete(context.Context, *IdRequest) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedBranchServiceServer) Restore(context.Context, *IdRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedBranchServiceServer) ListDeleted(context.Context, *ListDeletedRequest) (*ListBranchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeleted not implemented")
+}
 func (UnimplementedBranchServiceServer) ListActive(context.Context, *ListActiveBranchRequest) (*ListBranchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListActive not implemented")
 }
@@ -230,6 +258,42 @@ func _BranchService_Delete_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BranchService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BranchServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.BranchService/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BranchServiceServer).Restore(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BranchService_ListDeleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BranchServiceServer).ListDeleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.BranchService/ListDeleted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BranchServiceServer).ListDeleted(ctx, req.(*ListDeletedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BranchService_ListActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListActiveBranchRequest)
 	if err := dec(in); err != nil {
@@ -275,6 +339,14 @@ var BranchService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _BranchService_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _BranchService_Restore_Handler,
+		},
+		{
+			MethodName: "ListDeleted",
+			Handler:    _BranchService_ListDeleted_Handler,
+		},
 		{
 			MethodName: "ListActive",
 			Handler:    _BranchService_ListActive_Handler,
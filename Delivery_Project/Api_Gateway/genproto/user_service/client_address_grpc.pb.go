@@ -0,0 +1,249 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: client_address.proto
+
+package user_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ClientAddressServiceClient is the client API for ClientAddressService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ClientAddressServiceClient interface {
+	Create(ctx context.Context, in *CreateClientAddressRequest, opts ...grpc.CallOption) (*ClientAddress, error)
+	Get(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*ClientAddress, error)
+	List(ctx context.Context, in *ListClientAddressRequest, opts ...grpc.CallOption) (*ListClientAddressResponse, error)
+	Update(ctx context.Context, in *UpdateClientAddressRequest, opts ...grpc.CallOption) (*ClientAddress, error)
+	Delete(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Response, error)
+}
+
+type clientAddressServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClientAddressServiceClient(cc grpc.ClientConnInterface) ClientAddressServiceClient {
+	return &clientAddressServiceClient{cc}
+}
+
+func (c *clientAddressServiceClient) Create(ctx context.Context, in *CreateClientAddressRequest, opts ...grpc.CallOption) (*ClientAddress, error) {
+	out := new(ClientAddress)
+	err := c.cc.Invoke(ctx, "/user_service.ClientAddressService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientAddressServiceClient) Get(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*ClientAddress, error) {
+	out := new(ClientAddress)
+	err := c.cc.Invoke(ctx, "/user_service.ClientAddressService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientAddressServiceClient) List(ctx context.Context, in *ListClientAddressRequest, opts ...grpc.CallOption) (*ListClientAddressResponse, error) {
+	out := new(ListClientAddressResponse)
+	err := c.cc.Invoke(ctx, "/user_service.ClientAddressService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientAddressServiceClient) Update(ctx context.Context, in *UpdateClientAddressRequest, opts ...grpc.CallOption) (*ClientAddress, error) {
+	out := new(ClientAddress)
+	err := c.cc.Invoke(ctx, "/user_service.ClientAddressService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientAddressServiceClient) Delete(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/user_service.ClientAddressService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClientAddressServiceServer is the server API for ClientAddressService service.
+// All implementations must embed UnimplementedClientAddressServiceServer
+// for forward compatibility
+type ClientAddressServiceServer interface {
+	Create(context.Context, *CreateClientAddressRequest) (*ClientAddress, error)
+	Get(context.Context, *IdRequest) (*ClientAddress, error)
+	List(context.Context, *ListClientAddressRequest) (*ListClientAddressResponse, error)
+	Update(context.Context, *UpdateClientAddressRequest) (*ClientAddress, error)
+	Delete(context.Context, *IdRequest) (*Response, error)
+	mustEmbedUnimplementedClientAddressServiceServer()
+}
+
+// UnimplementedClientAddressServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedClientAddressServiceServer struct {
+}
+
+func (UnimplementedClientAddressServiceServer) Create(context.Context, *CreateClientAddressRequest) (*ClientAddress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedClientAddressServiceServer) Get(context.Context, *IdRequest) (*ClientAddress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedClientAddressServiceServer) List(context.Context, *ListClientAddressRequest) (*ListClientAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedClientAddressServiceServer) Update(context.Context, *UpdateClientAddressRequest) (*ClientAddress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedClientAddressServiceServer) Delete(context.Context, *IdRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedClientAddressServiceServer) mustEmbedUnimplementedClientAddressServiceServer() {}
+
+// UnsafeClientAddressServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClientAddressServiceServer will
+// result in compilation errors.
+type UnsafeClientAddressServiceServer interface {
+	mustEmbedUnimplementedClientAddressServiceServer()
+}
+
+func RegisterClientAddressServiceServer(s grpc.ServiceRegistrar, srv ClientAddressServiceServer) {
+	s.RegisterService(&ClientAddressService_ServiceDesc, srv)
+}
+
+func _ClientAddressService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClientAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientAddressServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.ClientAddressService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientAddressServiceServer).Create(ctx, req.(*CreateClientAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientAddressService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientAddressServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.ClientAddressService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientAddressServiceServer).Get(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientAddressService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientAddressServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.ClientAddressService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientAddressServiceServer).List(ctx, req.(*ListClientAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientAddressService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClientAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientAddressServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.ClientAddressService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientAddressServiceServer).Update(ctx, req.(*UpdateClientAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientAddressService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientAddressServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.ClientAddressService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientAddressServiceServer).Delete(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClientAddressService_ServiceDesc is the grpc.ServiceDesc for ClientAddressService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClientAddressService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user_service.ClientAddressService",
+	HandlerType: (*ClientAddressServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ClientAddressService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ClientAddressService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _ClientAddressService_List_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ClientAddressService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ClientAddressService_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "client_address.proto",
+}
@@ -27,6 +27,8 @@ type ClientServiceClient interface {
 	List(ctx context.Context, in *ListClientsRequest, opts ...grpc.CallOption) (*ListClientsResponse, error)
 	Update(ctx context.Context, in *UpdateClientsRequest, opts ...grpc.CallOption) (*Response, error)
 	Delete(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Response, error)
+	Restore(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Response, error)
+	ListDeleted(ctx context.Context, in *ListDeletedRequest, opts ...grpc.CallOption) (*ListClientsResponse, error)
 	UpdateOrder(ctx context.Context, in *UpdateClientsOrderRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
@@ -83,6 +85,24 @@ func (c *clientServiceClient) Delete(ctx context.Context, in *IdRequest, opts ..
 	return out, nil
 }
 
+func (c *clientServiceClient) Restore(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/user_service.ClientService/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServiceClient) ListDeleted(ctx context.Context, in *ListDeletedRequest, opts ...grpc.CallOption) (*ListClientsResponse, error) {
+	out := new(ListClientsResponse)
+	err := c.cc.Invoke(ctx, "/user_service.ClientService/ListDeleted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientServiceClient) UpdateOrder(ctx context.Context, in *UpdateClientsOrderRequest, opts ...grpc.CallOption) (*Response, error) {
 	out := new(Response)
 	err := c.cc.Invoke(ctx, "/user_service.ClientService/UpdateOrder", in, out, opts...)
@@ -101,6 +121,8 @@ type ClientServiceServer interface {
 	List(context.Context, *ListClientsRequest) (*ListClientsResponse, error)
 	Update(context.Context, *UpdateClientsRequest) (*Response, error)
 	Delete(context.Context, *IdRequest) (*Response, error)
+	Restore(context.Context, *IdRequest) (*Response, error)
+	ListDeleted(context.Context, *ListDeletedRequest) (*ListClientsResponse, error)
 	UpdateOrder(context.Context, *UpdateClientsOrderRequest) (*Response, error)
 	mustEmbedUnimplementedClientServiceServer()
 }
@@ -124,6 +146,12 @@ func (UnimplementedClientServiceServer) Update(context.Context, *UpdateClientsRe
 func (UnimplementedClientServiceServer) Delete(context.Context, *IdRequest) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedClientServiceServer) Restore(context.Context, *IdRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedClientServiceServer) ListDeleted(context.Context, *ListDeletedRequest) (*ListClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeleted not implemented")
+}
 func (UnimplementedClientServiceServer) UpdateOrder(context.Context, *UpdateClientsOrderRequest) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrder not implemented")
 }
@@ -230,6 +258,42 @@ func _ClientService_Delete_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.ClientService/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).Restore(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientService_ListDeleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).ListDeleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.ClientService/ListDeleted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).ListDeleted(ctx, req.(*ListDeletedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientService_UpdateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateClientsOrderRequest)
 	if err := dec(in); err != nil {
@@ -275,6 +339,14 @@ var ClientService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ClientService_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _ClientService_Restore_Handler,
+		},
+		{
+			MethodName: "ListDeleted",
+			Handler:    _ClientService_ListDeleted_Handler,
+		},
 		{
 			MethodName: "UpdateOrder",
 			Handler:    _ClientService_UpdateOrder_Handler,
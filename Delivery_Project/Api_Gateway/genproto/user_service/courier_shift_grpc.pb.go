@@ -0,0 +1,285 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: courier_shift.proto
+
+package user_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// CourierShiftServiceClient is the client API for CourierShiftService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CourierShiftServiceClient interface {
+	StartShift(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*CourierShift, error)
+	EndShift(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*CourierShift, error)
+	SetAvailability(ctx context.Context, in *SetAvailabilityRequest, opts ...grpc.CallOption) (*Couriers, error)
+	GetShift(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*CourierShift, error)
+	ListShifts(ctx context.Context, in *ListShiftsRequest, opts ...grpc.CallOption) (*ListShiftsResponse, error)
+	ListOnlineCouriers(ctx context.Context, in *ListOnlineCouriersRequest, opts ...grpc.CallOption) (*ListCouriersResponse, error)
+}
+
+type courierShiftServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCourierShiftServiceClient(cc grpc.ClientConnInterface) CourierShiftServiceClient {
+	return &courierShiftServiceClient{cc}
+}
+
+func (c *courierShiftServiceClient) StartShift(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*CourierShift, error) {
+	out := new(CourierShift)
+	err := c.cc.Invoke(ctx, "/user_service.CourierShiftService/StartShift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courierShiftServiceClient) EndShift(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*CourierShift, error) {
+	out := new(CourierShift)
+	err := c.cc.Invoke(ctx, "/user_service.CourierShiftService/EndShift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courierShiftServiceClient) SetAvailability(ctx context.Context, in *SetAvailabilityRequest, opts ...grpc.CallOption) (*Couriers, error) {
+	out := new(Couriers)
+	err := c.cc.Invoke(ctx, "/user_service.CourierShiftService/SetAvailability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courierShiftServiceClient) GetShift(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*CourierShift, error) {
+	out := new(CourierShift)
+	err := c.cc.Invoke(ctx, "/user_service.CourierShiftService/GetShift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courierShiftServiceClient) ListShifts(ctx context.Context, in *ListShiftsRequest, opts ...grpc.CallOption) (*ListShiftsResponse, error) {
+	out := new(ListShiftsResponse)
+	err := c.cc.Invoke(ctx, "/user_service.CourierShiftService/ListShifts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courierShiftServiceClient) ListOnlineCouriers(ctx context.Context, in *ListOnlineCouriersRequest, opts ...grpc.CallOption) (*ListCouriersResponse, error) {
+	out := new(ListCouriersResponse)
+	err := c.cc.Invoke(ctx, "/user_service.CourierShiftService/ListOnlineCouriers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CourierShiftServiceServer is the server API for CourierShiftService service.
+// All implementations must embed UnimplementedCourierShiftServiceServer
+// for forward compatibility
+type CourierShiftServiceServer interface {
+	StartShift(context.Context, *IdRequest) (*CourierShift, error)
+	EndShift(context.Context, *IdRequest) (*CourierShift, error)
+	SetAvailability(context.Context, *SetAvailabilityRequest) (*Couriers, error)
+	GetShift(context.Context, *IdRequest) (*CourierShift, error)
+	ListShifts(context.Context, *ListShiftsRequest) (*ListShiftsResponse, error)
+	ListOnlineCouriers(context.Context, *ListOnlineCouriersRequest) (*ListCouriersResponse, error)
+	mustEmbedUnimplementedCourierShiftServiceServer()
+}
+
+// UnimplementedCourierShiftServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCourierShiftServiceServer struct {
+}
+
+func (UnimplementedCourierShiftServiceServer) StartShift(context.Context, *IdRequest) (*CourierShift, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartShift not implemented")
+}
+func (UnimplementedCourierShiftServiceServer) EndShift(context.Context, *IdRequest) (*CourierShift, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndShift not implemented")
+}
+func (UnimplementedCourierShiftServiceServer) SetAvailability(context.Context, *SetAvailabilityRequest) (*Couriers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAvailability not implemented")
+}
+func (UnimplementedCourierShiftServiceServer) GetShift(context.Context, *IdRequest) (*CourierShift, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShift not implemented")
+}
+func (UnimplementedCourierShiftServiceServer) ListShifts(context.Context, *ListShiftsRequest) (*ListShiftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShifts not implemented")
+}
+func (UnimplementedCourierShiftServiceServer) ListOnlineCouriers(context.Context, *ListOnlineCouriersRequest) (*ListCouriersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOnlineCouriers not implemented")
+}
+func (UnimplementedCourierShiftServiceServer) mustEmbedUnimplementedCourierShiftServiceServer() {}
+
+// UnsafeCourierShiftServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CourierShiftServiceServer will
+// result in compilation errors.
+type UnsafeCourierShiftServiceServer interface {
+	mustEmbedUnimplementedCourierShiftServiceServer()
+}
+
+func RegisterCourierShiftServiceServer(s grpc.ServiceRegistrar, srv CourierShiftServiceServer) {
+	s.RegisterService(&CourierShiftService_ServiceDesc, srv)
+}
+
+func _CourierShiftService_StartShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierShiftServiceServer).StartShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.CourierShiftService/StartShift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierShiftServiceServer).StartShift(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourierShiftService_EndShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierShiftServiceServer).EndShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.CourierShiftService/EndShift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierShiftServiceServer).EndShift(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourierShiftService_SetAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierShiftServiceServer).SetAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.CourierShiftService/SetAvailability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierShiftServiceServer).SetAvailability(ctx, req.(*SetAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourierShiftService_GetShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierShiftServiceServer).GetShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.CourierShiftService/GetShift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierShiftServiceServer).GetShift(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourierShiftService_ListShifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShiftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierShiftServiceServer).ListShifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.CourierShiftService/ListShifts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierShiftServiceServer).ListShifts(ctx, req.(*ListShiftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourierShiftService_ListOnlineCouriers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOnlineCouriersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierShiftServiceServer).ListOnlineCouriers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.CourierShiftService/ListOnlineCouriers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierShiftServiceServer).ListOnlineCouriers(ctx, req.(*ListOnlineCouriersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CourierShiftService_ServiceDesc is the grpc.ServiceDesc for CourierShiftService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CourierShiftService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user_service.CourierShiftService",
+	HandlerType: (*CourierShiftServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartShift",
+			Handler:    _CourierShiftService_StartShift_Handler,
+		},
+		{
+			MethodName: "EndShift",
+			Handler:    _CourierShiftService_EndShift_Handler,
+		},
+		{
+			MethodName: "SetAvailability",
+			Handler:    _CourierShiftService_SetAvailability_Handler,
+		},
+		{
+			MethodName: "GetShift",
+			Handler:    _CourierShiftService_GetShift_Handler,
+		},
+		{
+			MethodName: "ListShifts",
+			Handler:    _CourierShiftService_ListShifts_Handler,
+		},
+		{
+			MethodName: "ListOnlineCouriers",
+			Handler:    _CourierShiftService_ListOnlineCouriers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "courier_shift.proto",
+}
@@ -27,6 +27,8 @@ type CourierServiceClient interface {
 	List(ctx context.Context, in *ListCouriersRequest, opts ...grpc.CallOption) (*ListCouriersResponse, error)
 	Update(ctx context.Context, in *UpdateCouriersRequest, opts ...grpc.CallOption) (*Response, error)
 	Delete(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Response, error)
+	Restore(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Response, error)
+	ListDeleted(ctx context.Context, in *ListDeletedRequest, opts ...grpc.CallOption) (*ListCouriersResponse, error)
 	GetCourierByUserName(ctx context.Context, in *GetByUserName, opts ...grpc.CallOption) (*Couriers, error)
 }
 
@@ -83,6 +85,24 @@ func (c *courierServiceClient) Delete(ctx context.Context, in *IdRequest, opts .
 	return out, nil
 }
 
+func (c *courierServiceClient) Restore(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/user_service.CourierService/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courierServiceClient) ListDeleted(ctx context.Context, in *ListDeletedRequest, opts ...grpc.CallOption) (*ListCouriersResponse, error) {
+	out := new(ListCouriersResponse)
+	err := c.cc.Invoke(ctx, "/user_service.CourierService/ListDeleted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *courierServiceClient) GetCourierByUserName(ctx context.Context, in *GetByUserName, opts ...grpc.CallOption) (*Couriers, error) {
 	out := new(Couriers)
 	err := c.cc.Invoke(ctx, "/user_service.CourierService/GetCourierByUserName", in, out, opts...)
@@ -101,6 +121,8 @@ type CourierServiceServer interface {
 	List(context.Context, *ListCouriersRequest) (*ListCouriersResponse, error)
 	Update(context.Context, *UpdateCouriersRequest) (*Response, error)
 	Delete(context.Context, *IdRequest) (*Response, error)
+	Restore(context.Context, *IdRequest) (*Response, error)
+	ListDeleted(context.Context, *ListDeletedRequest) (*ListCouriersResponse, error)
 	GetCourierByUserName(context.Context, *GetByUserName) (*Couriers, error)
 	mustEmbedUnimplementedCourierServiceServer()
 }
@@ -124,6 +146,12 @@ func (UnimplementedCourierServiceServer) Update(context.Context, *UpdateCouriers
 func (UnimplementedCourierServiceServer) Delete(context.Context, *IdRequest) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedCourierServiceServer) Restore(context.Context, *IdRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedCourierServiceServer) ListDeleted(context.Context, *ListDeletedRequest) (*ListCouriersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeleted not implemented")
+}
 func (UnimplementedCourierServiceServer) GetCourierByUserName(context.Context, *GetByUserName) (*Couriers, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCourierByUserName not implemented")
 }
@@ -230,6 +258,42 @@ func _CourierService_Delete_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CourierService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.CourierService/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierServiceServer).Restore(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourierService_ListDeleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierServiceServer).ListDeleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.CourierService/ListDeleted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierServiceServer).ListDeleted(ctx, req.(*ListDeletedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CourierService_GetCourierByUserName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetByUserName)
 	if err := dec(in); err != nil {
@@ -275,6 +339,14 @@ var CourierService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _CourierService_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _CourierService_Restore_Handler,
+		},
+		{
+			MethodName: "ListDeleted",
+			Handler:    _CourierService_ListDeleted_Handler,
+		},
 		{
 			MethodName: "GetCourierByUserName",
 			Handler:    _CourierService_GetCourierByUserName_Handler,
@@ -11,6 +11,8 @@ import (
 
 type TokenInfo struct {
 	UserID     string `json:"user_id"`
+	Role       string `json:"role"`
+	BranchID   int32  `json:"branch_id"`
 	ClientType string `json:"client_type"`
 	PlatformID string `json:"platform_id"`
 }
@@ -47,6 +49,8 @@ func ParseClaims(token string, secretKey string) (result TokenInfo, err error) {
 	}
 
 	result.UserID = cast.ToString(claims["user_id"])
+	result.Role = cast.ToString(claims["role"])
+	result.BranchID = cast.ToInt32(claims["branch_id"])
 	result.ClientType = cast.ToString(claims["client_type"])
 	result.PlatformID = cast.ToString(claims["platform_id"])
 	if len(result.UserID) <= 0 {
@@ -0,0 +1,37 @@
+package identity
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+)
+
+// MetadataKey carries the signed identity of the caller to the services, they
+// verify it with the same secret, see pkg/identity of every service
+const MetadataKey = "x-identity"
+
+// ContextKey keeps the identity of the request in the gin context
+const ContextKey = "identity"
+
+// Identity is the authenticated caller of a request
+type Identity struct {
+	UserID    int32  `json:"user_id"`
+	Role      string `json:"role"`
+	BranchID  int32  `json:"branch_id"`
+	RequestID string `json:"request_id"`
+	IssuedAt  int64  `json:"iat"`
+}
+
+// Sign encodes id as base64url(json) "." base64url(hmac-sha256 of the json)
+func Sign(id Identity, secret string) (string, error) {
+	data, err := json.Marshal(id)
+	if err != nil {
+		return "", err
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(data)
+
+	return base64.RawURLEncoding.EncodeToString(data) + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
@@ -0,0 +1,64 @@
+package identity
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestSign(t *testing.T) {
+	tests := []struct {
+		name string
+		id   Identity
+	}{
+		{name: "user", id: Identity{UserID: 7, Role: "user", BranchID: 3, RequestID: "req-1", IssuedAt: 1700000000}},
+		{name: "courier", id: Identity{UserID: 12, Role: "courier", RequestID: "req-2", IssuedAt: 1700000060}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := Sign(tt.id, "secret")
+			if err != nil {
+				t.Fatalf("Sign: %v", err)
+			}
+
+			payload, signature, ok := strings.Cut(token, ".")
+			if !ok {
+				t.Fatalf("Sign() = %q, want payload.signature", token)
+			}
+
+			data, err := base64.RawURLEncoding.DecodeString(payload)
+			if err != nil {
+				t.Fatalf("payload is not base64url: %v", err)
+			}
+			var got Identity
+			if err = json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("payload is not an identity: %v", err)
+			}
+			if got != tt.id {
+				t.Errorf("payload = %+v, want %+v", got, tt.id)
+			}
+
+			sum, err := base64.RawURLEncoding.DecodeString(signature)
+			if err != nil {
+				t.Fatalf("signature is not base64url: %v", err)
+			}
+			mac := hmac.New(sha256.New, []byte("secret"))
+			mac.Write(data)
+			if !hmac.Equal(sum, mac.Sum(nil)) {
+				t.Error("signature is not the hmac-sha256 of the payload")
+			}
+
+			other, err := Sign(tt.id, "other-secret")
+			if err != nil {
+				t.Fatalf("Sign: %v", err)
+			}
+			if other == token {
+				t.Error("Sign() gives the same token for another secret")
+			}
+		})
+	}
+}
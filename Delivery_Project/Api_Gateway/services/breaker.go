@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"api-gateway-service/pkg/logger"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// breaker is the circuit breaker of one downstream service. After threshold
+// failed calls in a row the circuit opens and calls fail fast for
+// openTimeout, then a single call is let through and its result closes or
+// opens the circuit again.
+type breaker struct {
+	name        string
+	threshold   int
+	openTimeout time.Duration
+	log         logger.LoggerI
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time // zero while the circuit is closed
+	probing  bool
+}
+
+func newBreaker(name string, threshold int, openTimeout time.Duration, log logger.LoggerI) *breaker {
+	return &breaker{
+		name:        name,
+		threshold:   threshold,
+		openTimeout: openTimeout,
+		log:         log,
+	}
+}
+
+// allow reports whether a call may go to the service
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.openedAt.IsZero() {
+		return true
+	}
+	if b.probing || time.Since(b.openedAt) < b.openTimeout {
+		return false
+	}
+
+	b.probing = true
+	return true
+}
+
+// record counts the result of a call. Only an unavailable or too slow service
+// is a failure, any other answer shows that the service is up.
+func (b *breaker) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch status.Code(err) {
+	case codes.Canceled:
+		// the client went away, this tells nothing about the service
+		b.probing = false
+	case codes.Unavailable, codes.DeadlineExceeded:
+		b.failures++
+		if b.probing || (b.openedAt.IsZero() && b.failures >= b.threshold) {
+			b.openedAt = time.Now()
+			b.probing = false
+			b.log.Warn("circuit opened", logger.String("service", b.name), logger.Int("failures", b.failures))
+		}
+	default:
+		if !b.openedAt.IsZero() {
+			b.log.Info("circuit closed", logger.String("service", b.name))
+		}
+		b.failures = 0
+		b.openedAt = time.Time{}
+		b.probing = false
+	}
+}
+
+// unavailable replaces the transport error of an unreachable service, it
+// would tell the client about addresses of the internal network
+func (b *breaker) unavailable(err error) error {
+	if status.Code(err) != codes.Unavailable {
+		return err
+	}
+
+	b.log.Warn("service unavailable", logger.String("service", b.name), logger.Error(err))
+	return status.Errorf(codes.Unavailable, "%s is temporarily unavailable, try again later", b.name)
+}
+
+// health checks report the real state of the service, so they skip the breaker
+func isHealthCheck(method string) bool {
+	return strings.HasPrefix(method, "/grpc.health.v1.Health/")
+}
+
+func (b *breaker) unaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if isHealthCheck(method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		if !b.allow() {
+			return b.unavailable(status.Error(codes.Unavailable, "circuit is open"))
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		b.record(err)
+
+		return b.unavailable(err)
+	}
+}
+
+// streamInterceptor only sees whether the stream could be opened
+func (b *breaker) streamInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if isHealthCheck(method) {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+		if !b.allow() {
+			return nil, b.unavailable(status.Error(codes.Unavailable, "circuit is open"))
+		}
+
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		b.record(err)
+
+		return cs, b.unavailable(err)
+	}
+}
@@ -0,0 +1,122 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"api-gateway-service/pkg/logger"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// breakerStep is one thing that happens to the breaker: a call asks to go
+// through, a call finishes with code, or the open timeout passes
+type breakerStep struct {
+	allow  *bool
+	record *codes.Code
+	elapse bool
+}
+
+func allow(want bool) breakerStep        { return breakerStep{allow: &want} }
+func record(code codes.Code) breakerStep { return breakerStep{record: &code} }
+
+var elapse = breakerStep{elapse: true}
+
+func TestBreaker(t *testing.T) {
+	const threshold = 3
+	opened := []breakerStep{record(codes.Unavailable), record(codes.Unavailable), record(codes.Unavailable)}
+
+	tests := []struct {
+		name  string
+		steps []breakerStep
+	}{
+		{
+			name:  "closed below the threshold",
+			steps: []breakerStep{record(codes.Unavailable), record(codes.Unavailable), allow(true)},
+		},
+		{
+			name:  "opens at the threshold",
+			steps: append(opened, allow(false)),
+		},
+		{
+			name:  "slow calls are failures",
+			steps: []breakerStep{record(codes.DeadlineExceeded), record(codes.DeadlineExceeded), record(codes.DeadlineExceeded), allow(false)},
+		},
+		{
+			name: "an answer resets the failures",
+			steps: []breakerStep{
+				record(codes.Unavailable), record(codes.Unavailable), record(codes.NotFound),
+				record(codes.Unavailable), record(codes.Unavailable), allow(true),
+			},
+		},
+		{
+			name:  "canceled calls are not failures",
+			steps: []breakerStep{record(codes.Canceled), record(codes.Canceled), record(codes.Canceled), allow(true)},
+		},
+		{
+			name:  "one probe after the open timeout",
+			steps: append(opened, elapse, allow(true), allow(false)),
+		},
+		{
+			name:  "successful probe closes",
+			steps: append(opened, elapse, allow(true), record(codes.OK), allow(true), allow(true)),
+		},
+		{
+			name:  "failed probe opens again",
+			steps: append(opened, elapse, allow(true), record(codes.Unavailable), allow(false), elapse, allow(true)),
+		},
+		{
+			name:  "canceled probe lets the next call probe",
+			steps: append(opened, elapse, allow(true), record(codes.Canceled), allow(true)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newBreaker("test_service", threshold, time.Minute, logger.NewLogger("test", logger.LevelError))
+
+			for i, step := range tt.steps {
+				switch {
+				case step.elapse:
+					b.openedAt = b.openedAt.Add(-b.openTimeout)
+				case step.record != nil:
+					b.record(status.Error(*step.record, "test"))
+				case step.allow != nil:
+					if got := b.allow(); got != *step.allow {
+						t.Fatalf("step %d: allow() = %v, want %v", i, got, *step.allow)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestBreakerUnavailable(t *testing.T) {
+	b := newBreaker("user_service", 5, time.Minute, logger.NewLogger("test", logger.LevelError))
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+		wantMsg  string
+	}{
+		{name: "no error", err: nil, wantCode: codes.OK},
+		{name: "other code kept", err: status.Error(codes.NotFound, "client not found"), wantCode: codes.NotFound, wantMsg: "client not found"},
+		{
+			name:     "transport error replaced",
+			err:      status.Error(codes.Unavailable, "dial tcp 10.0.0.5:50051: connection refused"),
+			wantCode: codes.Unavailable,
+			wantMsg:  "user_service is temporarily unavailable, try again later",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := status.Convert(b.unavailable(tt.err))
+			if st.Code() != tt.wantCode || st.Message() != tt.wantMsg {
+				t.Errorf("unavailable() = %s %q, want %s %q", st.Code(), st.Message(), tt.wantCode, tt.wantMsg)
+			}
+		})
+	}
+}
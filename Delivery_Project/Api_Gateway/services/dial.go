@@ -0,0 +1,189 @@
+package services
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"api-gateway-service/config"
+	"api-gateway-service/pkg/logger"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// dial connects to a service running on one or more comma separated hosts.
+// Calls are balanced round robin between the hosts, get the timeout and retry
+// policy of their method and go through the circuit breaker of the service.
+func dial(cfg config.Config, log logger.LoggerI, name, hosts, port string) (*grpc.ClientConn, error) {
+	serviceConfig, err := newServiceConfig(cfg, name)
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := transportCredentials(cfg, name)
+	if err != nil {
+		return nil, err
+	}
+
+	b := newBreaker(name, cfg.BreakerFailureThreshold, cfg.BreakerOpenTimeout, log)
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		// the services allow a ping every 10s, see their KEEPALIVE_MIN_TIME
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                cfg.GRPCKeepaliveTime,
+			Timeout:             cfg.GRPCKeepaliveTimeout,
+			PermitWithoutStream: true,
+		}),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		grpc.WithChainUnaryInterceptor(b.unaryInterceptor()),
+		grpc.WithChainStreamInterceptor(b.streamInterceptor()),
+	}
+
+	// a single host is resolved through DNS, so every address it has is used
+	target := "dns:///" + hosts + port
+	if addrs := strings.Split(hosts, ","); len(addrs) > 1 {
+		var state resolver.State
+		for _, addr := range addrs {
+			state.Addresses = append(state.Addresses, resolver.Address{Addr: strings.TrimSpace(addr) + port})
+		}
+
+		r := manual.NewBuilderWithScheme(strings.ReplaceAll(name, "_", "-"))
+		r.InitialState(state)
+
+		opts = append(opts, grpc.WithResolvers(r))
+		target = r.Scheme() + ":///" + name
+	}
+
+	return grpc.Dial(target, opts...)
+}
+
+// transportCredentials is TLS when a CA is configured. The server name is
+// fixed, so a certificate of one service can not stand in for another one
+// whatever host it is reached at.
+func transportCredentials(cfg config.Config, name string) (credentials.TransportCredentials, error) {
+	if cfg.GRPCTLSCAFile == "" {
+		return insecure.NewCredentials(), nil
+	}
+
+	ca, err := os.ReadFile(cfg.GRPCTLSCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read TLS CA: %w", err)
+	}
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("no certificates found in %s", cfg.GRPCTLSCAFile)
+	}
+
+	tlsConfig := &tls.Config{
+		RootCAs:    rootCAs,
+		ServerName: strings.ReplaceAll(name, "_", "-"),
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if cfg.GRPCTLSCertFile != "" || cfg.GRPCTLSKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.GRPCTLSCertFile, cfg.GRPCTLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+type serviceConfig struct {
+	LoadBalancingConfig []map[string]struct{} `json:"loadBalancingConfig"`
+	MethodConfig        []methodConfig        `json:"methodConfig"`
+}
+
+type methodConfig struct {
+	Name        []methodName `json:"name"`
+	Timeout     string       `json:"timeout,omitempty"`
+	RetryPolicy *retryPolicy `json:"retryPolicy,omitempty"`
+}
+
+type methodName struct {
+	Service string `json:"service"`
+	Method  string `json:"method"`
+}
+
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+// newServiceConfig builds the service config of the proto package pkg. Reads
+// (Get* and List* methods) are idempotent, so they are retried when the
+// service is unavailable; writes are never retried. Streams keep the deadline
+// of their caller.
+func newServiceConfig(cfg config.Config, pkg string) (string, error) {
+	var reads, writes []methodName
+	protoregistry.GlobalFiles.RangeFilesByPackage(protoreflect.FullName(pkg), func(fd protoreflect.FileDescriptor) bool {
+		for i := 0; i < fd.Services().Len(); i++ {
+			sd := fd.Services().Get(i)
+			for j := 0; j < sd.Methods().Len(); j++ {
+				md := sd.Methods().Get(j)
+				if md.IsStreamingClient() || md.IsStreamingServer() {
+					continue
+				}
+
+				name := methodName{Service: string(sd.FullName()), Method: string(md.Name())}
+				if strings.HasPrefix(name.Method, "Get") || strings.HasPrefix(name.Method, "List") {
+					reads = append(reads, name)
+				} else {
+					writes = append(writes, name)
+				}
+			}
+		}
+		return true
+	})
+	if len(reads)+len(writes) == 0 {
+		return "", fmt.Errorf("no services of package %s are registered", pkg)
+	}
+
+	read := methodConfig{Name: reads, Timeout: seconds(cfg.GRPCReadTimeout)}
+	if cfg.GRPCMaxAttempts > 1 {
+		read.RetryPolicy = &retryPolicy{
+			MaxAttempts:          cfg.GRPCMaxAttempts,
+			InitialBackoff:       "0.1s",
+			MaxBackoff:           "1s",
+			BackoffMultiplier:    2,
+			RetryableStatusCodes: []string{"UNAVAILABLE"},
+		}
+	}
+
+	sc := serviceConfig{
+		LoadBalancingConfig: []map[string]struct{}{{"round_robin": {}}},
+		MethodConfig: []methodConfig{
+			read,
+			{Name: writes, Timeout: seconds(cfg.GRPCWriteTimeout)},
+		},
+	}
+
+	data, err := json.Marshal(sc)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// seconds formats d the way the service config expects durations
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%gs", d.Seconds())
+}
@@ -5,9 +5,10 @@ import (
 	order_service "api-gateway-service/genproto/order_service"
 	product_service "api-gateway-service/genproto/product_service"
 	user_service "api-gateway-service/genproto/user_service"
+	"api-gateway-service/pkg/logger"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 type ServiceManagerI interface {
@@ -20,10 +21,34 @@ type ServiceManagerI interface {
 	UserService() user_service.UserServiceClient
 	CourierService() user_service.CourierServiceClient
 	ClientService() user_service.ClientServiceClient
+	BonusService() user_service.BonusServiceClient
+	CourierShiftService() user_service.CourierShiftServiceClient
+	ClientAddressService() user_service.ClientAddressServiceClient
 
 	//  Order Service
 	DeliveryTariffService() order_service.DeliveryTariffServiceClient
 	OrderService() order_service.OrderServiceClient
+	PromoCodeService() order_service.PromoCodeServiceClient
+	PaymentService() order_service.PaymentServiceClient
+	CourierCashService() order_service.CourierCashServiceClient
+	CompensationSchemeService() order_service.CompensationSchemeServiceClient
+	CourierEarningsService() order_service.CourierEarningsServiceClient
+	FeedbackService() order_service.FeedbackServiceClient
+	KitchenService() order_service.KitchenServiceClient
+
+	// audit log of every service
+	ProductAuditService() product_service.AuditServiceClient
+	UserAuditService() user_service.AuditServiceClient
+	OrderAuditService() order_service.AuditServiceClient
+
+	ClientDataService() user_service.ClientDataServiceClient
+	ClientOrdersService() order_service.ClientOrdersServiceClient
+
+	// gRPC health of every service, keyed by service name
+	HealthServices() map[string]healthpb.HealthClient
+
+	// Close closes the connections to the services
+	Close() error
 }
 
 type grpcClients struct {
@@ -36,36 +61,48 @@ type grpcClients struct {
 	userService    user_service.UserServiceClient
 	courierService user_service.CourierServiceClient
 	clientService  user_service.ClientServiceClient
+	bonusService   user_service.BonusServiceClient
+	shiftService   user_service.CourierShiftServiceClient
+	addressService user_service.ClientAddressServiceClient
 
 	// // Order Service
 	deliveryTariffService order_service.DeliveryTariffServiceClient
 	orderService          order_service.OrderServiceClient
+	promoCodeService      order_service.PromoCodeServiceClient
+	paymentService        order_service.PaymentServiceClient
+	courierCashService    order_service.CourierCashServiceClient
+	schemeService         order_service.CompensationSchemeServiceClient
+	earningsService       order_service.CourierEarningsServiceClient
+	feedbackService       order_service.FeedbackServiceClient
+	kitchenService        order_service.KitchenServiceClient
+
+	productAuditService product_service.AuditServiceClient
+	userAuditService    user_service.AuditServiceClient
+	orderAuditService   order_service.AuditServiceClient
+
+	clientDataService   user_service.ClientDataServiceClient
+	clientOrdersService order_service.ClientOrdersServiceClient
+
+	healthServices map[string]healthpb.HealthClient
+
+	conns []*grpc.ClientConn
 }
 
-func NewGrpcClients(cfg config.Config) (ServiceManagerI, error) {
+func NewGrpcClients(cfg config.Config, log logger.LoggerI) (ServiceManagerI, error) {
 	// // Product Microservice
-	connProductService, err := grpc.Dial(
-		cfg.ProductServiceHost+cfg.ProductGRPCPort,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	connProductService, err := dial(cfg, log, "product_service", cfg.ProductServiceHost, cfg.ProductGRPCPort)
 	if err != nil {
 		return nil, err
 	}
 
 	// User Microservice
-	connUserService, err := grpc.Dial(
-		cfg.UserServiceHost+cfg.UserGRPCPort,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	connUserService, err := dial(cfg, log, "user_service", cfg.UserServiceHost, cfg.UserGRPCPort)
 	if err != nil {
 		return nil, err
 	}
 
 	// // Order Microservice
-	connOrderService, err := grpc.Dial(
-		cfg.OrderServiceHost+cfg.OrderGRPCPort,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	connOrderService, err := dial(cfg, log, "order_service", cfg.OrderServiceHost, cfg.OrderGRPCPort)
 	if err != nil {
 		return nil, err
 	}
@@ -79,10 +116,34 @@ func NewGrpcClients(cfg config.Config) (ServiceManagerI, error) {
 		userService:    user_service.NewUserServiceClient(connUserService),
 		clientService:  user_service.NewClientServiceClient(connUserService),
 		courierService: user_service.NewCourierServiceClient(connUserService),
+		bonusService:   user_service.NewBonusServiceClient(connUserService),
+		shiftService:   user_service.NewCourierShiftServiceClient(connUserService),
+		addressService: user_service.NewClientAddressServiceClient(connUserService),
 
 		// // Order Service
 		deliveryTariffService: order_service.NewDeliveryTariffServiceClient(connOrderService),
 		orderService:          order_service.NewOrderServiceClient(connOrderService),
+		promoCodeService:      order_service.NewPromoCodeServiceClient(connOrderService),
+		paymentService:        order_service.NewPaymentServiceClient(connOrderService),
+		courierCashService:    order_service.NewCourierCashServiceClient(connOrderService),
+		schemeService:         order_service.NewCompensationSchemeServiceClient(connOrderService),
+		earningsService:       order_service.NewCourierEarningsServiceClient(connOrderService),
+		feedbackService:       order_service.NewFeedbackServiceClient(connOrderService),
+		kitchenService:        order_service.NewKitchenServiceClient(connOrderService),
+
+		productAuditService: product_service.NewAuditServiceClient(connProductService),
+		userAuditService:    user_service.NewAuditServiceClient(connUserService),
+		orderAuditService:   order_service.NewAuditServiceClient(connOrderService),
+
+		clientDataService:   user_service.NewClientDataServiceClient(connUserService),
+		clientOrdersService: order_service.NewClientOrdersServiceClient(connOrderService),
+
+		healthServices: map[string]healthpb.HealthClient{
+			"product_service": healthpb.NewHealthClient(connProductService),
+			"user_service":    healthpb.NewHealthClient(connUserService),
+			"order_service":   healthpb.NewHealthClient(connOrderService),
+		},
+		conns: []*grpc.ClientConn{connProductService, connUserService, connOrderService},
 	}, nil
 }
 
@@ -111,6 +172,14 @@ func (g *grpcClients) ClientService() user_service.ClientServiceClient {
 	return g.clientService
 }
 
+func (g *grpcClients) BonusService() user_service.BonusServiceClient {
+	return g.bonusService
+}
+
+func (g *grpcClients) CourierShiftService() user_service.CourierShiftServiceClient {
+	return g.shiftService
+}
+
 // // Order Service
 func (g *grpcClients) DeliveryTariffService() order_service.DeliveryTariffServiceClient {
 	return g.deliveryTariffService
@@ -119,3 +188,69 @@ func (g *grpcClients) DeliveryTariffService() order_service.DeliveryTariffServic
 func (g *grpcClients) OrderService() order_service.OrderServiceClient {
 	return g.orderService
 }
+
+func (g *grpcClients) PromoCodeService() order_service.PromoCodeServiceClient {
+	return g.promoCodeService
+}
+
+func (g *grpcClients) PaymentService() order_service.PaymentServiceClient {
+	return g.paymentService
+}
+
+func (g *grpcClients) CourierCashService() order_service.CourierCashServiceClient {
+	return g.courierCashService
+}
+
+func (g *grpcClients) CompensationSchemeService() order_service.CompensationSchemeServiceClient {
+	return g.schemeService
+}
+
+func (g *grpcClients) CourierEarningsService() order_service.CourierEarningsServiceClient {
+	return g.earningsService
+}
+
+func (g *grpcClients) FeedbackService() order_service.FeedbackServiceClient {
+	return g.feedbackService
+}
+
+func (g *grpcClients) KitchenService() order_service.KitchenServiceClient {
+	return g.kitchenService
+}
+
+func (g *grpcClients) ClientAddressService() user_service.ClientAddressServiceClient {
+	return g.addressService
+}
+
+func (g *grpcClients) ProductAuditService() product_service.AuditServiceClient {
+	return g.productAuditService
+}
+
+func (g *grpcClients) UserAuditService() user_service.AuditServiceClient {
+	return g.userAuditService
+}
+
+func (g *grpcClients) OrderAuditService() order_service.AuditServiceClient {
+	return g.orderAuditService
+}
+
+func (g *grpcClients) ClientDataService() user_service.ClientDataServiceClient {
+	return g.clientDataService
+}
+
+func (g *grpcClients) ClientOrdersService() order_service.ClientOrdersServiceClient {
+	return g.clientOrdersService
+}
+
+func (g *grpcClients) HealthServices() map[string]healthpb.HealthClient {
+	return g.healthServices
+}
+
+func (g *grpcClients) Close() error {
+	var err error
+	for _, conn := range g.conns {
+		if closeErr := conn.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
@@ -2,32 +2,90 @@ package main
 
 import (
 	"order_service/config"
+	user_service "order_service/genproto/user_service"
 	"order_service/grpc"
+	"order_service/grpc/service"
 
 	"context"
-	"fmt"
 	"log"
 	"net"
 	"order_service/pkg/logger"
+	"order_service/pkg/metrics"
+	"order_service/pkg/payment"
 	"order_service/storage/postgres"
+	"os/signal"
+	"syscall"
 )
 
 func main() {
 	cfg := config.Load()
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid config: %v", err)
+	}
 	lg := logger.NewLogger(cfg.Environment, "debug")
-	strg, err := postgres.NewStorage(context.Background(), cfg)
+	defer logger.Cleanup(lg)
+
+	// SIGTERM and SIGINT stop the background jobs and start the shutdown
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	strg, err := postgres.NewStorage(ctx, cfg)
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
+	defer strg.CloseDB()
+
+	provider, err := payment.NewProvider(payment.Config{
+		Provider:            cfg.PaymentProvider,
+		WebhookSecret:       cfg.PaymentWebhookSecret,
+		ClickBaseURL:        cfg.ClickBaseURL,
+		ClickServiceID:      cfg.ClickServiceID,
+		ClickMerchantID:     cfg.ClickMerchantID,
+		ClickMerchantUserID: cfg.ClickMerchantUserID,
+		ClickSecretKey:      cfg.ClickSecretKey,
+	})
+	if err != nil {
+		log.Fatalf("Failed to set up payment provider: %v", err)
+	}
+
+	m := metrics.New()
+	m.Register(metrics.NewPoolCollector(strg.Stat))
+	m.Register(metrics.NewCountCollector("orders_by_status", "Orders in each status.", "status", strg.Order().CountByStatus, lg))
+	go m.Serve(ctx, cfg.MetricsPort, lg)
+
+	go service.RunIdempotencyPurge(ctx, cfg, lg, strg)
+	go service.RunTrashPurge(ctx, cfg, lg, strg)
+
+	userConn, err := grpc.DialUserService(cfg)
+	if err != nil {
+		log.Fatalf("Failed to dial user service: %v", err)
+	}
+	defer userConn.Close()
+
+	bonus := user_service.NewBonusServiceClient(userConn)
+	go service.RunOutbox(ctx, cfg, lg, strg, bonus, user_service.NewClientServiceClient(userConn))
+
+	s, err := grpc.SetUpServer(ctx, cfg, lg, strg, provider, bonus, m)
+	if err != nil {
+		log.Fatalf("Failed to set up server: %v", err)
+	}
 
-	s := grpc.SetUpServer(cfg, lg, strg)
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", 50053))
+	lis, err := net.Listen("tcp", cfg.Port)
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	log.Printf("Server listening at %v", lis.Addr())
-	if err := s.Serve(lis); err != nil {
-		log.Fatalf("Failed to serve: %v", err)
+	serveErr := make(chan error, 1)
+	go func() {
+		log.Printf("Server listening at %v", lis.Addr())
+		serveErr <- s.Serve(lis)
+	}()
+
+	select {
+	case err := <-serveErr:
+		lg.Error("error while serving", logger.Error(err))
+	case <-ctx.Done():
+		lg.Info("shutting down", logger.Duration("timeout", cfg.ShutdownTimeout))
+		grpc.GracefulStop(s, cfg.ShutdownTimeout)
 	}
 }
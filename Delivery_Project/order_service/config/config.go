@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -21,7 +22,42 @@ type Config struct {
 	PostgresPassword string
 	PostgresDatabase string
 
-	Port string
+	// gRPC listen address, TLS is on when both files are set. With a CA file
+	// callers must present a certificate signed by it, issued to one of
+	// TLSAllowedClients.
+	Port              string
+	TLSCertFile       string
+	TLSKeyFile        string
+	TLSCAFile         string
+	TLSAllowedClients []string
+
+	// keepalive of client connections, see keepalive.ServerParameters; clients
+	// pinging more often than KeepaliveMinTime are disconnected
+	KeepaliveTime     time.Duration
+	KeepaliveTimeout  time.Duration
+	KeepaliveMinTime  time.Duration
+	MaxConnectionIdle time.Duration
+
+	// identities signed by the gateway with IdentitySecret are trusted for
+	// IdentityMaxAge, see pkg/identity
+	IdentitySecret string
+	IdentityMaxAge time.Duration
+
+	// rows soft deleted longer than TrashRetention ago are purged for good
+	// every TrashPurgeInterval, see service.RunTrashPurge. Purging is off
+	// until TRASH_RETENTION is set, e.g. to 720h
+	TrashRetention     time.Duration
+	TrashPurgeInterval time.Duration
+
+	// in-flight RPCs get this long to finish after SIGTERM or SIGINT
+	ShutdownTimeout time.Duration
+
+	// deadline of RPCs whose caller did not set a shorter one
+	RPCTimeout time.Duration
+
+	// /metrics listens here, the database is pinged for the health service every HealthCheckInterval
+	MetricsPort         string
+	HealthCheckInterval time.Duration
 
 	PostgresMaxConnections int32
 
@@ -31,6 +67,41 @@ type Config struct {
 	// catalog configuration
 	CatalogServiceHost string
 	CatalogServicePort string
+
+	// user service takes the bonus points spent on orders, it is dialed with
+	// the TLS files of the server, see certgen
+	UserServiceHost string
+	UserGRPCPort    string
+
+	// payment configuration
+	PaymentProvider      string
+	PaymentWebhookSecret string
+
+	ClickBaseURL        string
+	ClickServiceID      int
+	ClickMerchantID     int
+	ClickMerchantUserID int
+	ClickSecretKey      string
+
+	// ratings at or below this raise a feedback alert
+	LowRatingThreshold int32
+
+	// promised delivery time estimation, all values are in minutes
+	EtaDefaultPreparationMinutes int32
+	EtaMinutesPerQueuedOrder     int32
+	EtaMinutesPerKm              float64
+
+	// distance_km of an order comes from the client and pays its courier, so
+	// a delivery farther than this is refused
+	MaxDeliveryDistanceKm float64
+
+	// how long a create order idempotency key is remembered, and how often old keys are purged
+	IdempotencyKeyTTL        time.Duration
+	IdempotencyPurgeInterval time.Duration
+
+	// the user service calls orders owe are tried every OutboxInterval, a
+	// failed call waits longer after each attempt, see service.RunOutbox
+	OutboxInterval time.Duration
 }
 
 const (
@@ -58,7 +129,26 @@ func Load() Config {
 	config := Config{}
 
 	config.Environment = cast.ToString(getOrReturnDefaultValue("ENVIRONMENT", DebugMode))
-	config.Port = cast.ToString(getOrReturnDefaultValue("PORT", 8000))
+	config.Port = cast.ToString(getOrReturnDefaultValue("PORT", ":50053"))
+	config.TLSCertFile = cast.ToString(getOrReturnDefaultValue("TLS_CERT_FILE", ""))
+	config.TLSKeyFile = cast.ToString(getOrReturnDefaultValue("TLS_KEY_FILE", ""))
+	config.TLSCAFile = cast.ToString(getOrReturnDefaultValue("TLS_CA_FILE", ""))
+	config.TLSAllowedClients = splitList(cast.ToString(getOrReturnDefaultValue("TLS_ALLOWED_CLIENTS", "api-gateway")))
+	config.KeepaliveTime = cast.ToDuration(getOrReturnDefaultValue("KEEPALIVE_TIME", "2h"))
+	config.KeepaliveTimeout = cast.ToDuration(getOrReturnDefaultValue("KEEPALIVE_TIMEOUT", "20s"))
+	config.KeepaliveMinTime = cast.ToDuration(getOrReturnDefaultValue("KEEPALIVE_MIN_TIME", "10s"))
+	config.MaxConnectionIdle = cast.ToDuration(getOrReturnDefaultValue("MAX_CONNECTION_IDLE", "0s"))
+	config.IdentitySecret = cast.ToString(getOrReturnDefaultValue("IDENTITY_SECRET", devIdentitySecret))
+	config.IdentityMaxAge = cast.ToDuration(getOrReturnDefaultValue("IDENTITY_MAX_AGE", "1m"))
+	config.TrashRetention = cast.ToDuration(getOrReturnDefaultValue("TRASH_RETENTION", "0s"))
+	config.TrashPurgeInterval = cast.ToDuration(getOrReturnDefaultValue("TRASH_PURGE_INTERVAL", "1h"))
+	config.ShutdownTimeout = cast.ToDuration(getOrReturnDefaultValue("SHUTDOWN_TIMEOUT", "15s"))
+	config.RPCTimeout = cast.ToDuration(getOrReturnDefaultValue("RPC_TIMEOUT", "10s"))
+	config.MetricsPort = cast.ToString(getOrReturnDefaultValue("METRICS_PORT", ":9103"))
+	config.HealthCheckInterval = cast.ToDuration(getOrReturnDefaultValue("HEALTH_CHECK_INTERVAL", "10s"))
+
+	config.UserServiceHost = cast.ToString(getOrReturnDefaultValue("USER_SERVICE_HOST", "localhost"))
+	config.UserGRPCPort = cast.ToString(getOrReturnDefaultValue("USER_GRPC_PORT", ":50051"))
 
 	config.PostgresHost = cast.ToString(getOrReturnDefaultValue("POSTGRES_HOST", "localhost"))
 	config.PostgresPort = cast.ToInt(getOrReturnDefaultValue("POSTGRES_PORT", 5432))
@@ -68,10 +158,59 @@ func Load() Config {
 
 	config.PostgresMaxConnections = cast.ToInt32(getOrReturnDefaultValue("POSTGRES_MAX_CONNECTIONS", 30))
 
+	config.PaymentProvider = cast.ToString(getOrReturnDefaultValue("PAYMENT_PROVIDER", "fake"))
+	config.PaymentWebhookSecret = cast.ToString(getOrReturnDefaultValue("PAYMENT_WEBHOOK_SECRET", devWebhookSecret))
+
+	config.ClickBaseURL = cast.ToString(getOrReturnDefaultValue("CLICK_BASE_URL", "https://api.click.uz"))
+	config.ClickServiceID = cast.ToInt(getOrReturnDefaultValue("CLICK_SERVICE_ID", 0))
+	config.ClickMerchantID = cast.ToInt(getOrReturnDefaultValue("CLICK_MERCHANT_ID", 0))
+	config.ClickMerchantUserID = cast.ToInt(getOrReturnDefaultValue("CLICK_MERCHANT_USER_ID", 0))
+	config.ClickSecretKey = cast.ToString(getOrReturnDefaultValue("CLICK_SECRET_KEY", ""))
+
+	config.LowRatingThreshold = cast.ToInt32(getOrReturnDefaultValue("LOW_RATING_THRESHOLD", 2))
+
+	config.EtaDefaultPreparationMinutes = cast.ToInt32(getOrReturnDefaultValue("ETA_DEFAULT_PREPARATION_MINUTES", 15))
+	config.EtaMinutesPerQueuedOrder = cast.ToInt32(getOrReturnDefaultValue("ETA_MINUTES_PER_QUEUED_ORDER", 2))
+	config.EtaMinutesPerKm = cast.ToFloat64(getOrReturnDefaultValue("ETA_MINUTES_PER_KM", 3))
+	config.MaxDeliveryDistanceKm = cast.ToFloat64(getOrReturnDefaultValue("MAX_DELIVERY_DISTANCE_KM", 50))
+
+	config.IdempotencyKeyTTL = cast.ToDuration(getOrReturnDefaultValue("IDEMPOTENCY_KEY_TTL", "24h"))
+	config.IdempotencyPurgeInterval = cast.ToDuration(getOrReturnDefaultValue("IDEMPOTENCY_PURGE_INTERVAL", "1h"))
+
+	config.OutboxInterval = cast.ToDuration(getOrReturnDefaultValue("OUTBOX_INTERVAL", "10s"))
+
 	return config
 
 }
 
+// devIdentitySecret and devWebhookSecret are the defaults of IDENTITY_SECRET and
+// PAYMENT_WEBHOOK_SECRET. They are public, so Validate only accepts them in
+// debug and test mode.
+const (
+	devIdentitySecret = "MyIdentitySecret"
+	devWebhookSecret  = "MyWebhookSecret"
+)
+
+// Validate rejects settings that are only safe in development
+func (c Config) Validate() error {
+	if c.Environment == DebugMode || c.Environment == TestMode {
+		return nil
+	}
+
+	if c.IdentitySecret == devIdentitySecret {
+		return fmt.Errorf("IDENTITY_SECRET must be set in %s mode", c.Environment)
+	}
+	// the fake provider marks orders paid on any event signed with the webhook secret
+	if c.PaymentProvider == "" || c.PaymentProvider == "fake" {
+		return fmt.Errorf("PAYMENT_PROVIDER must be a real provider in %s mode", c.Environment)
+	}
+	if c.PaymentWebhookSecret == devWebhookSecret {
+		return fmt.Errorf("PAYMENT_WEBHOOK_SECRET must be set in %s mode", c.Environment)
+	}
+
+	return nil
+}
+
 func getOrReturnDefaultValue(key string, defaultValue interface{}) interface{} {
 	val, exists := os.LookupEnv(key)
 	if exists {
@@ -79,3 +218,14 @@ func getOrReturnDefaultValue(key string, defaultValue interface{}) interface{} {
 	}
 	return defaultValue
 }
+
+// splitList splits a comma separated value, blank items are dropped
+func splitList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
@@ -0,0 +1,59 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	release := Config{
+		Environment:          ReleaseMode,
+		IdentitySecret:       "identity-secret",
+		PaymentProvider:      "click",
+		PaymentWebhookSecret: "webhook-secret",
+	}
+
+	tests := []struct {
+		name    string
+		change  func(c *Config)
+		wantErr string
+	}{
+		{name: "release"},
+		{name: "default identity secret", change: func(c *Config) { c.IdentitySecret = devIdentitySecret }, wantErr: "IDENTITY_SECRET"},
+		{name: "fake provider", change: func(c *Config) { c.PaymentProvider = "fake" }, wantErr: "PAYMENT_PROVIDER"},
+		{name: "no provider", change: func(c *Config) { c.PaymentProvider = "" }, wantErr: "PAYMENT_PROVIDER"},
+		{name: "default webhook secret", change: func(c *Config) { c.PaymentWebhookSecret = devWebhookSecret }, wantErr: "PAYMENT_WEBHOOK_SECRET"},
+		{
+			name: "debug defaults",
+			change: func(c *Config) {
+				c.Environment, c.IdentitySecret, c.PaymentProvider, c.PaymentWebhookSecret = DebugMode, devIdentitySecret, "fake", devWebhookSecret
+			},
+		},
+		{
+			name: "test defaults",
+			change: func(c *Config) {
+				c.Environment, c.IdentitySecret, c.PaymentProvider, c.PaymentWebhookSecret = TestMode, devIdentitySecret, "fake", devWebhookSecret
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := release
+			if tt.change != nil {
+				tt.change(&cfg)
+			}
+
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() = %v, want an error about %s", err, tt.wantErr)
+			}
+		})
+	}
+}
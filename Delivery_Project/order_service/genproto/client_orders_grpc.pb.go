@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: client_orders.proto
+
+package order_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ClientOrdersServiceClient is the client API for ClientOrdersService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ClientOrdersServiceClient interface {
+	Export(ctx context.Context, in *ClientIdRequest, opts ...grpc.CallOption) (*ClientOrdersExport, error)
+	Anonymize(ctx context.Context, in *ClientIdRequest, opts ...grpc.CallOption) (*Response, error)
+}
+
+type clientOrdersServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClientOrdersServiceClient(cc grpc.ClientConnInterface) ClientOrdersServiceClient {
+	return &clientOrdersServiceClient{cc}
+}
+
+func (c *clientOrdersServiceClient) Export(ctx context.Context, in *ClientIdRequest, opts ...grpc.CallOption) (*ClientOrdersExport, error) {
+	out := new(ClientOrdersExport)
+	err := c.cc.Invoke(ctx, "/order_service.ClientOrdersService/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientOrdersServiceClient) Anonymize(ctx context.Context, in *ClientIdRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/order_service.ClientOrdersService/Anonymize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClientOrdersServiceServer is the server API for ClientOrdersService service.
+// All implementations must embed UnimplementedClientOrdersServiceServer
+// for forward compatibility
+type ClientOrdersServiceServer interface {
+	Export(context.Context, *ClientIdRequest) (*ClientOrdersExport, error)
+	Anonymize(context.Context, *ClientIdRequest) (*Response, error)
+	mustEmbedUnimplementedClientOrdersServiceServer()
+}
+
+// UnimplementedClientOrdersServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedClientOrdersServiceServer struct {
+}
+
+func (UnimplementedClientOrdersServiceServer) Export(context.Context, *ClientIdRequest) (*ClientOrdersExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedClientOrdersServiceServer) Anonymize(context.Context, *ClientIdRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Anonymize not implemented")
+}
+func (UnimplementedClientOrdersServiceServer) mustEmbedUnimplementedClientOrdersServiceServer() {}
+
+// UnsafeClientOrdersServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClientOrdersServiceServer will
+// result in compilation errors.
+type UnsafeClientOrdersServiceServer interface {
+	mustEmbedUnimplementedClientOrdersServiceServer()
+}
+
+func RegisterClientOrdersServiceServer(s grpc.ServiceRegistrar, srv ClientOrdersServiceServer) {
+	s.RegisterService(&ClientOrdersService_ServiceDesc, srv)
+}
+
+func _ClientOrdersService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientOrdersServiceServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.ClientOrdersService/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientOrdersServiceServer).Export(ctx, req.(*ClientIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientOrdersService_Anonymize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientOrdersServiceServer).Anonymize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.ClientOrdersService/Anonymize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientOrdersServiceServer).Anonymize(ctx, req.(*ClientIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClientOrdersService_ServiceDesc is the grpc.ServiceDesc for ClientOrdersService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClientOrdersService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "order_service.ClientOrdersService",
+	HandlerType: (*ClientOrdersServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Export",
+			Handler:    _ClientOrdersService_Export_Handler,
+		},
+		{
+			MethodName: "Anonymize",
+			Handler:    _ClientOrdersService_Anonymize_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "client_orders.proto",
+}
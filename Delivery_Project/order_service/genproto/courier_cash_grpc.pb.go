@@ -0,0 +1,213 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: courier_cash.proto
+
+package order_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// CourierCashServiceClient is the client API for CourierCashService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CourierCashServiceClient interface {
+	Handover(ctx context.Context, in *CashHandoverRequest, opts ...grpc.CallOption) (*CashTransaction, error)
+	GetBalance(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*CourierCashBalance, error)
+	ListTransactions(ctx context.Context, in *ListCashTransactionsRequest, opts ...grpc.CallOption) (*ListCashTransactionsResponse, error)
+	Reconcile(ctx context.Context, in *CashReconciliationRequest, opts ...grpc.CallOption) (*CashReconciliation, error)
+}
+
+type courierCashServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCourierCashServiceClient(cc grpc.ClientConnInterface) CourierCashServiceClient {
+	return &courierCashServiceClient{cc}
+}
+
+func (c *courierCashServiceClient) Handover(ctx context.Context, in *CashHandoverRequest, opts ...grpc.CallOption) (*CashTransaction, error) {
+	out := new(CashTransaction)
+	err := c.cc.Invoke(ctx, "/order_service.CourierCashService/Handover", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courierCashServiceClient) GetBalance(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*CourierCashBalance, error) {
+	out := new(CourierCashBalance)
+	err := c.cc.Invoke(ctx, "/order_service.CourierCashService/GetBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courierCashServiceClient) ListTransactions(ctx context.Context, in *ListCashTransactionsRequest, opts ...grpc.CallOption) (*ListCashTransactionsResponse, error) {
+	out := new(ListCashTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/order_service.CourierCashService/ListTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courierCashServiceClient) Reconcile(ctx context.Context, in *CashReconciliationRequest, opts ...grpc.CallOption) (*CashReconciliation, error) {
+	out := new(CashReconciliation)
+	err := c.cc.Invoke(ctx, "/order_service.CourierCashService/Reconcile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CourierCashServiceServer is the server API for CourierCashService service.
+// All implementations must embed UnimplementedCourierCashServiceServer
+// for forward compatibility
+type CourierCashServiceServer interface {
+	Handover(context.Context, *CashHandoverRequest) (*CashTransaction, error)
+	GetBalance(context.Context, *IdRequest) (*CourierCashBalance, error)
+	ListTransactions(context.Context, *ListCashTransactionsRequest) (*ListCashTransactionsResponse, error)
+	Reconcile(context.Context, *CashReconciliationRequest) (*CashReconciliation, error)
+	mustEmbedUnimplementedCourierCashServiceServer()
+}
+
+// UnimplementedCourierCashServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCourierCashServiceServer struct {
+}
+
+func (UnimplementedCourierCashServiceServer) Handover(context.Context, *CashHandoverRequest) (*CashTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Handover not implemented")
+}
+func (UnimplementedCourierCashServiceServer) GetBalance(context.Context, *IdRequest) (*CourierCashBalance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
+}
+func (UnimplementedCourierCashServiceServer) ListTransactions(context.Context, *ListCashTransactionsRequest) (*ListCashTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactions not implemented")
+}
+func (UnimplementedCourierCashServiceServer) Reconcile(context.Context, *CashReconciliationRequest) (*CashReconciliation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reconcile not implemented")
+}
+func (UnimplementedCourierCashServiceServer) mustEmbedUnimplementedCourierCashServiceServer() {}
+
+// UnsafeCourierCashServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CourierCashServiceServer will
+// result in compilation errors.
+type UnsafeCourierCashServiceServer interface {
+	mustEmbedUnimplementedCourierCashServiceServer()
+}
+
+func RegisterCourierCashServiceServer(s grpc.ServiceRegistrar, srv CourierCashServiceServer) {
+	s.RegisterService(&CourierCashService_ServiceDesc, srv)
+}
+
+func _CourierCashService_Handover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CashHandoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierCashServiceServer).Handover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.CourierCashService/Handover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierCashServiceServer).Handover(ctx, req.(*CashHandoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourierCashService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierCashServiceServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.CourierCashService/GetBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierCashServiceServer).GetBalance(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourierCashService_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCashTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierCashServiceServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.CourierCashService/ListTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierCashServiceServer).ListTransactions(ctx, req.(*ListCashTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourierCashService_Reconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CashReconciliationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierCashServiceServer).Reconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.CourierCashService/Reconcile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierCashServiceServer).Reconcile(ctx, req.(*CashReconciliationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CourierCashService_ServiceDesc is the grpc.ServiceDesc for CourierCashService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CourierCashService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "order_service.CourierCashService",
+	HandlerType: (*CourierCashServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Handover",
+			Handler:    _CourierCashService_Handover_Handler,
+		},
+		{
+			MethodName: "GetBalance",
+			Handler:    _CourierCashService_GetBalance_Handler,
+		},
+		{
+			MethodName: "ListTransactions",
+			Handler:    _CourierCashService_ListTransactions_Handler,
+		},
+		{
+			MethodName: "Reconcile",
+			Handler:    _CourierCashService_Reconcile_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "courier_cash.proto",
+}
@@ -0,0 +1,1223 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: courier_earnings.proto
+
+package order_service
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// from and to are "HH:MM", the window may wrap midnight
+type PeakHour struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From       string  `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To         string  `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Multiplier float64 `protobuf:"fixed64,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+}
+
+func (x *PeakHour) Reset() {
+	*x = PeakHour{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_courier_earnings_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeakHour) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeakHour) ProtoMessage() {}
+
+func (x *PeakHour) ProtoReflect() protoreflect.Message {
+	mi := &file_courier_earnings_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeakHour.ProtoReflect.Descriptor instead.
+func (*PeakHour) Descriptor() ([]byte, []int) {
+	return file_courier_earnings_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PeakHour) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PeakHour) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *PeakHour) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+// branch_id 0 is the default scheme for branches without their own
+// earning = (per_delivery_fee + delivery_price * delivery_price_percent / 100
+//   - (distance_km - distance_bonus_from_km) * distance_bonus_per_km) * peak multiplier
+type CreateCompensationSchemeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                 string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BranchId             int32       `protobuf:"varint,2,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"`
+	PerDeliveryFee       float64     `protobuf:"fixed64,3,opt,name=per_delivery_fee,json=perDeliveryFee,proto3" json:"per_delivery_fee,omitempty"`
+	DeliveryPricePercent float64     `protobuf:"fixed64,4,opt,name=delivery_price_percent,json=deliveryPricePercent,proto3" json:"delivery_price_percent,omitempty"`
+	DistanceBonusFromKm  float64     `protobuf:"fixed64,5,opt,name=distance_bonus_from_km,json=distanceBonusFromKm,proto3" json:"distance_bonus_from_km,omitempty"`
+	DistanceBonusPerKm   float64     `protobuf:"fixed64,6,opt,name=distance_bonus_per_km,json=distanceBonusPerKm,proto3" json:"distance_bonus_per_km,omitempty"`
+	PeakHours            []*PeakHour `protobuf:"bytes,7,rep,name=peak_hours,json=peakHours,proto3" json:"peak_hours,omitempty"`
+}
+
+func (x *CreateCompensationSchemeRequest) Reset() {
+	*x = CreateCompensationSchemeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_courier_earnings_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCompensationSchemeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompensationSchemeRequest) ProtoMessage() {}
+
+func (x *CreateCompensationSchemeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courier_earnings_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompensationSchemeRequest.ProtoReflect.Descriptor instead.
+func (*CreateCompensationSchemeRequest) Descriptor() ([]byte, []int) {
+	return file_courier_earnings_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateCompensationSchemeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCompensationSchemeRequest) GetBranchId() int32 {
+	if x != nil {
+		return x.BranchId
+	}
+	return 0
+}
+
+func (x *CreateCompensationSchemeRequest) GetPerDeliveryFee() float64 {
+	if x != nil {
+		return x.PerDeliveryFee
+	}
+	return 0
+}
+
+func (x *CreateCompensationSchemeRequest) GetDeliveryPricePercent() float64 {
+	if x != nil {
+		return x.DeliveryPricePercent
+	}
+	return 0
+}
+
+func (x *CreateCompensationSchemeRequest) GetDistanceBonusFromKm() float64 {
+	if x != nil {
+		return x.DistanceBonusFromKm
+	}
+	return 0
+}
+
+func (x *CreateCompensationSchemeRequest) GetDistanceBonusPerKm() float64 {
+	if x != nil {
+		return x.DistanceBonusPerKm
+	}
+	return 0
+}
+
+func (x *CreateCompensationSchemeRequest) GetPeakHours() []*PeakHour {
+	if x != nil {
+		return x.PeakHours
+	}
+	return nil
+}
+
+type CompensationScheme struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                   int32       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BranchId             int32       `protobuf:"varint,3,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"`
+	PerDeliveryFee       float64     `protobuf:"fixed64,4,opt,name=per_delivery_fee,json=perDeliveryFee,proto3" json:"per_delivery_fee,omitempty"`
+	DeliveryPricePercent float64     `protobuf:"fixed64,5,opt,name=delivery_price_percent,json=deliveryPricePercent,proto3" json:"delivery_price_percent,omitempty"`
+	DistanceBonusFromKm  float64     `protobuf:"fixed64,6,opt,name=distance_bonus_from_km,json=distanceBonusFromKm,proto3" json:"distance_bonus_from_km,omitempty"`
+	DistanceBonusPerKm   float64     `protobuf:"fixed64,7,opt,name=distance_bonus_per_km,json=distanceBonusPerKm,proto3" json:"distance_bonus_per_km,omitempty"`
+	PeakHours            []*PeakHour `protobuf:"bytes,8,rep,name=peak_hours,json=peakHours,proto3" json:"peak_hours,omitempty"`
+	Active               bool        `protobuf:"varint,9,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt            string      `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            string      `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *CompensationScheme) Reset() {
+	*x = CompensationScheme{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_courier_earnings_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompensationScheme) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompensationScheme) ProtoMessage() {}
+
+func (x *CompensationScheme) ProtoReflect() protoreflect.Message {
+	mi := &file_courier_earnings_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompensationScheme.ProtoReflect.Descriptor instead.
+func (*CompensationScheme) Descriptor() ([]byte, []int) {
+	return file_courier_earnings_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CompensationScheme) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CompensationScheme) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CompensationScheme) GetBranchId() int32 {
+	if x != nil {
+		return x.BranchId
+	}
+	return 0
+}
+
+func (x *CompensationScheme) GetPerDeliveryFee() float64 {
+	if x != nil {
+		return x.PerDeliveryFee
+	}
+	return 0
+}
+
+func (x *CompensationScheme) GetDeliveryPricePercent() float64 {
+	if x != nil {
+		return x.DeliveryPricePercent
+	}
+	return 0
+}
+
+func (x *CompensationScheme) GetDistanceBonusFromKm() float64 {
+	if x != nil {
+		return x.DistanceBonusFromKm
+	}
+	return 0
+}
+
+func (x *CompensationScheme) GetDistanceBonusPerKm() float64 {
+	if x != nil {
+		return x.DistanceBonusPerKm
+	}
+	return 0
+}
+
+func (x *CompensationScheme) GetPeakHours() []*PeakHour {
+	if x != nil {
+		return x.PeakHours
+	}
+	return nil
+}
+
+func (x *CompensationScheme) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *CompensationScheme) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *CompensationScheme) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type UpdateCompensationSchemeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                   int32       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BranchId             int32       `protobuf:"varint,3,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"`
+	PerDeliveryFee       float64     `protobuf:"fixed64,4,opt,name=per_delivery_fee,json=perDeliveryFee,proto3" json:"per_delivery_fee,omitempty"`
+	DeliveryPricePercent float64     `protobuf:"fixed64,5,opt,name=delivery_price_percent,json=deliveryPricePercent,proto3" json:"delivery_price_percent,omitempty"`
+	DistanceBonusFromKm  float64     `protobuf:"fixed64,6,opt,name=distance_bonus_from_km,json=distanceBonusFromKm,proto3" json:"distance_bonus_from_km,omitempty"`
+	DistanceBonusPerKm   float64     `protobuf:"fixed64,7,opt,name=distance_bonus_per_km,json=distanceBonusPerKm,proto3" json:"distance_bonus_per_km,omitempty"`
+	PeakHours            []*PeakHour `protobuf:"bytes,8,rep,name=peak_hours,json=peakHours,proto3" json:"peak_hours,omitempty"`
+	Active               bool        `protobuf:"varint,9,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *UpdateCompensationSchemeRequest) Reset() {
+	*x = UpdateCompensationSchemeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_courier_earnings_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCompensationSchemeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompensationSchemeRequest) ProtoMessage() {}
+
+func (x *UpdateCompensationSchemeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courier_earnings_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompensationSchemeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCompensationSchemeRequest) Descriptor() ([]byte, []int) {
+	return file_courier_earnings_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateCompensationSchemeRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCompensationSchemeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCompensationSchemeRequest) GetBranchId() int32 {
+	if x != nil {
+		return x.BranchId
+	}
+	return 0
+}
+
+func (x *UpdateCompensationSchemeRequest) GetPerDeliveryFee() float64 {
+	if x != nil {
+		return x.PerDeliveryFee
+	}
+	return 0
+}
+
+func (x *UpdateCompensationSchemeRequest) GetDeliveryPricePercent() float64 {
+	if x != nil {
+		return x.DeliveryPricePercent
+	}
+	return 0
+}
+
+func (x *UpdateCompensationSchemeRequest) GetDistanceBonusFromKm() float64 {
+	if x != nil {
+		return x.DistanceBonusFromKm
+	}
+	return 0
+}
+
+func (x *UpdateCompensationSchemeRequest) GetDistanceBonusPerKm() float64 {
+	if x != nil {
+		return x.DistanceBonusPerKm
+	}
+	return 0
+}
+
+func (x *UpdateCompensationSchemeRequest) GetPeakHours() []*PeakHour {
+	if x != nil {
+		return x.PeakHours
+	}
+	return nil
+}
+
+func (x *UpdateCompensationSchemeRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type ListCompensationSchemeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit  int32  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page   int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Search string `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+}
+
+func (x *ListCompensationSchemeRequest) Reset() {
+	*x = ListCompensationSchemeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_courier_earnings_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCompensationSchemeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompensationSchemeRequest) ProtoMessage() {}
+
+func (x *ListCompensationSchemeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courier_earnings_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompensationSchemeRequest.ProtoReflect.Descriptor instead.
+func (*ListCompensationSchemeRequest) Descriptor() ([]byte, []int) {
+	return file_courier_earnings_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListCompensationSchemeRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCompensationSchemeRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCompensationSchemeRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+type ListCompensationSchemeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompensationSchemes []*CompensationScheme `protobuf:"bytes,1,rep,name=compensation_schemes,json=compensationSchemes,proto3" json:"compensation_schemes,omitempty"`
+	Count               int32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ListCompensationSchemeResponse) Reset() {
+	*x = ListCompensationSchemeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_courier_earnings_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCompensationSchemeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompensationSchemeResponse) ProtoMessage() {}
+
+func (x *ListCompensationSchemeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courier_earnings_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompensationSchemeResponse.ProtoReflect.Descriptor instead.
+func (*ListCompensationSchemeResponse) Descriptor() ([]byte, []int) {
+	return file_courier_earnings_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListCompensationSchemeResponse) GetCompensationSchemes() []*CompensationScheme {
+	if x != nil {
+		return x.CompensationSchemes
+	}
+	return nil
+}
+
+func (x *ListCompensationSchemeResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type CourierEarning struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            int32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       string  `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CourierId     int32   `protobuf:"varint,3,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	BranchId      int32   `protobuf:"varint,4,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"`
+	SchemeId      int32   `protobuf:"varint,5,opt,name=scheme_id,json=schemeId,proto3" json:"scheme_id,omitempty"`
+	DeliveryFee   float64 `protobuf:"fixed64,6,opt,name=delivery_fee,json=deliveryFee,proto3" json:"delivery_fee,omitempty"`
+	DeliveryShare float64 `protobuf:"fixed64,7,opt,name=delivery_share,json=deliveryShare,proto3" json:"delivery_share,omitempty"`
+	DistanceKm    float64 `protobuf:"fixed64,8,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	DistanceBonus float64 `protobuf:"fixed64,9,opt,name=distance_bonus,json=distanceBonus,proto3" json:"distance_bonus,omitempty"`
+	Multiplier    float64 `protobuf:"fixed64,10,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	Amount        float64 `protobuf:"fixed64,11,opt,name=amount,proto3" json:"amount,omitempty"`
+	CreatedAt     string  `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *CourierEarning) Reset() {
+	*x = CourierEarning{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_courier_earnings_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CourierEarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourierEarning) ProtoMessage() {}
+
+func (x *CourierEarning) ProtoReflect() protoreflect.Message {
+	mi := &file_courier_earnings_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourierEarning.ProtoReflect.Descriptor instead.
+func (*CourierEarning) Descriptor() ([]byte, []int) {
+	return file_courier_earnings_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CourierEarning) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CourierEarning) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CourierEarning) GetCourierId() int32 {
+	if x != nil {
+		return x.CourierId
+	}
+	return 0
+}
+
+func (x *CourierEarning) GetBranchId() int32 {
+	if x != nil {
+		return x.BranchId
+	}
+	return 0
+}
+
+func (x *CourierEarning) GetSchemeId() int32 {
+	if x != nil {
+		return x.SchemeId
+	}
+	return 0
+}
+
+func (x *CourierEarning) GetDeliveryFee() float64 {
+	if x != nil {
+		return x.DeliveryFee
+	}
+	return 0
+}
+
+func (x *CourierEarning) GetDeliveryShare() float64 {
+	if x != nil {
+		return x.DeliveryShare
+	}
+	return 0
+}
+
+func (x *CourierEarning) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *CourierEarning) GetDistanceBonus() float64 {
+	if x != nil {
+		return x.DistanceBonus
+	}
+	return 0
+}
+
+func (x *CourierEarning) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *CourierEarning) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CourierEarning) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// from and to default to the current month
+type PayoutStatementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourierId int32  `protobuf:"varint,1,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	From      string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To        string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *PayoutStatementRequest) Reset() {
+	*x = PayoutStatementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_courier_earnings_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayoutStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutStatementRequest) ProtoMessage() {}
+
+func (x *PayoutStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courier_earnings_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoutStatementRequest.ProtoReflect.Descriptor instead.
+func (*PayoutStatementRequest) Descriptor() ([]byte, []int) {
+	return file_courier_earnings_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PayoutStatementRequest) GetCourierId() int32 {
+	if x != nil {
+		return x.CourierId
+	}
+	return 0
+}
+
+func (x *PayoutStatementRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PayoutStatementRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type PayoutStatement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourierId     int32             `protobuf:"varint,1,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	From          string            `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string            `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Deliveries    int32             `protobuf:"varint,4,opt,name=deliveries,proto3" json:"deliveries,omitempty"`
+	DeliveryFee   float64           `protobuf:"fixed64,5,opt,name=delivery_fee,json=deliveryFee,proto3" json:"delivery_fee,omitempty"`
+	DeliveryShare float64           `protobuf:"fixed64,6,opt,name=delivery_share,json=deliveryShare,proto3" json:"delivery_share,omitempty"`
+	DistanceBonus float64           `protobuf:"fixed64,7,opt,name=distance_bonus,json=distanceBonus,proto3" json:"distance_bonus,omitempty"`
+	Total         float64           `protobuf:"fixed64,8,opt,name=total,proto3" json:"total,omitempty"`
+	Earnings      []*CourierEarning `protobuf:"bytes,9,rep,name=earnings,proto3" json:"earnings,omitempty"`
+}
+
+func (x *PayoutStatement) Reset() {
+	*x = PayoutStatement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_courier_earnings_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayoutStatement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutStatement) ProtoMessage() {}
+
+func (x *PayoutStatement) ProtoReflect() protoreflect.Message {
+	mi := &file_courier_earnings_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoutStatement.ProtoReflect.Descriptor instead.
+func (*PayoutStatement) Descriptor() ([]byte, []int) {
+	return file_courier_earnings_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PayoutStatement) GetCourierId() int32 {
+	if x != nil {
+		return x.CourierId
+	}
+	return 0
+}
+
+func (x *PayoutStatement) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PayoutStatement) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *PayoutStatement) GetDeliveries() int32 {
+	if x != nil {
+		return x.Deliveries
+	}
+	return 0
+}
+
+func (x *PayoutStatement) GetDeliveryFee() float64 {
+	if x != nil {
+		return x.DeliveryFee
+	}
+	return 0
+}
+
+func (x *PayoutStatement) GetDeliveryShare() float64 {
+	if x != nil {
+		return x.DeliveryShare
+	}
+	return 0
+}
+
+func (x *PayoutStatement) GetDistanceBonus() float64 {
+	if x != nil {
+		return x.DistanceBonus
+	}
+	return 0
+}
+
+func (x *PayoutStatement) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PayoutStatement) GetEarnings() []*CourierEarning {
+	if x != nil {
+		return x.Earnings
+	}
+	return nil
+}
+
+var File_courier_earnings_proto protoreflect.FileDescriptor
+
+var file_courier_earnings_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x63, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4e, 0x0a, 0x08, 0x50, 0x65, 0x61, 0x6b, 0x48, 0x6f, 0x75, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70,
+	0x6c, 0x69, 0x65, 0x72, 0x22, 0xd2, 0x02, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x65, 0x72,
+	0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x46, 0x65, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x14, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x16, 0x64, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x6b, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x4b, 0x6d, 0x12, 0x31,
+	0x0a, 0x15, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x6f, 0x6e, 0x75, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x64,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x50, 0x65, 0x72, 0x4b,
+	0x6d, 0x12, 0x36, 0x0a, 0x0a, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x61, 0x6b, 0x48, 0x6f, 0x75, 0x72, 0x52, 0x09,
+	0x70, 0x65, 0x61, 0x6b, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x22, 0xab, 0x03, 0x0a, 0x12, 0x43, 0x6f,
+	0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49,
+	0x64, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x70, 0x65, 0x72,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x46, 0x65, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x12, 0x33, 0x0a, 0x16, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x6f,
+	0x6e, 0x75, 0x73, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6b, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x13, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x6f, 0x6e, 0x75, 0x73,
+	0x46, 0x72, 0x6f, 0x6d, 0x4b, 0x6d, 0x12, 0x31, 0x0a, 0x15, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x6d, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42,
+	0x6f, 0x6e, 0x75, 0x73, 0x50, 0x65, 0x72, 0x4b, 0x6d, 0x12, 0x36, 0x0a, 0x0a, 0x70, 0x65, 0x61,
+	0x6b, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65,
+	0x61, 0x6b, 0x48, 0x6f, 0x75, 0x72, 0x52, 0x09, 0x70, 0x65, 0x61, 0x6b, 0x48, 0x6f, 0x75, 0x72,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xfa, 0x02, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10,
+	0x70, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x66, 0x65, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x46, 0x65, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x16,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x6b, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x4b,
+	0x6d, 0x12, 0x31, 0x0a, 0x15, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x6f,
+	0x6e, 0x75, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x12, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x50,
+	0x65, 0x72, 0x4b, 0x6d, 0x12, 0x36, 0x0a, 0x0a, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x68, 0x6f, 0x75,
+	0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x61, 0x6b, 0x48, 0x6f, 0x75,
+	0x72, 0x52, 0x09, 0x70, 0x65, 0x61, 0x6b, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x22, 0x61, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x22, 0x8c, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x14, 0x63, 0x6f,
+	0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x13, 0x63, 0x6f, 0x6d,
+	0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xfd, 0x02, 0x0a, 0x0e, 0x43, 0x6f, 0x75, 0x72, 0x69,
+	0x65, 0x72, 0x45, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x69, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x46, 0x65, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0d, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5b, 0x0a, 0x16, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x74, 0x6f, 0x22, 0xb6, 0x02, 0x0a, 0x0f, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x69,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x75,
+	0x72, 0x69, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x46, 0x65, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x39, 0x0a, 0x08, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x45, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x52, 0x08, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x32, 0xb1, 0x03, 0x0a,
+	0x19, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x06, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x65,
+	0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x44, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x18, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x2e,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x06,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3d, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x32, 0x77, 0x0a, 0x16, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x45, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x25, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x42, 0x18, 0x5a, 0x16, 0x67, 0x65, 0x6e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_courier_earnings_proto_rawDescOnce sync.Once
+	file_courier_earnings_proto_rawDescData = file_courier_earnings_proto_rawDesc
+)
+
+func file_courier_earnings_proto_rawDescGZIP() []byte {
+	file_courier_earnings_proto_rawDescOnce.Do(func() {
+		file_courier_earnings_proto_rawDescData = protoimpl.X.CompressGZIP(file_courier_earnings_proto_rawDescData)
+	})
+	return file_courier_earnings_proto_rawDescData
+}
+
+var file_courier_earnings_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_courier_earnings_proto_goTypes = []interface{}{
+	(*PeakHour)(nil),                        // 0: order_service.PeakHour
+	(*CreateCompensationSchemeRequest)(nil), // 1: order_service.CreateCompensationSchemeRequest
+	(*CompensationScheme)(nil),              // 2: order_service.CompensationScheme
+	(*UpdateCompensationSchemeRequest)(nil), // 3: order_service.UpdateCompensationSchemeRequest
+	(*ListCompensationSchemeRequest)(nil),   // 4: order_service.ListCompensationSchemeRequest
+	(*ListCompensationSchemeResponse)(nil),  // 5: order_service.ListCompensationSchemeResponse
+	(*CourierEarning)(nil),                  // 6: order_service.CourierEarning
+	(*PayoutStatementRequest)(nil),          // 7: order_service.PayoutStatementRequest
+	(*PayoutStatement)(nil),                 // 8: order_service.PayoutStatement
+	(*IdRequest)(nil),                       // 9: order_service.IdRequest
+	(*Response)(nil),                        // 10: order_service.Response
+}
+var file_courier_earnings_proto_depIdxs = []int32{
+	0,  // 0: order_service.CreateCompensationSchemeRequest.peak_hours:type_name -> order_service.PeakHour
+	0,  // 1: order_service.CompensationScheme.peak_hours:type_name -> order_service.PeakHour
+	0,  // 2: order_service.UpdateCompensationSchemeRequest.peak_hours:type_name -> order_service.PeakHour
+	2,  // 3: order_service.ListCompensationSchemeResponse.compensation_schemes:type_name -> order_service.CompensationScheme
+	6,  // 4: order_service.PayoutStatement.earnings:type_name -> order_service.CourierEarning
+	1,  // 5: order_service.CompensationSchemeService.Create:input_type -> order_service.CreateCompensationSchemeRequest
+	9,  // 6: order_service.CompensationSchemeService.Get:input_type -> order_service.IdRequest
+	4,  // 7: order_service.CompensationSchemeService.List:input_type -> order_service.ListCompensationSchemeRequest
+	3,  // 8: order_service.CompensationSchemeService.Update:input_type -> order_service.UpdateCompensationSchemeRequest
+	9,  // 9: order_service.CompensationSchemeService.Delete:input_type -> order_service.IdRequest
+	7,  // 10: order_service.CourierEarningsService.GetPayoutStatement:input_type -> order_service.PayoutStatementRequest
+	10, // 11: order_service.CompensationSchemeService.Create:output_type -> order_service.Response
+	2,  // 12: order_service.CompensationSchemeService.Get:output_type -> order_service.CompensationScheme
+	5,  // 13: order_service.CompensationSchemeService.List:output_type -> order_service.ListCompensationSchemeResponse
+	10, // 14: order_service.CompensationSchemeService.Update:output_type -> order_service.Response
+	10, // 15: order_service.CompensationSchemeService.Delete:output_type -> order_service.Response
+	8,  // 16: order_service.CourierEarningsService.GetPayoutStatement:output_type -> order_service.PayoutStatement
+	11, // [11:17] is the sub-list for method output_type
+	5,  // [5:11] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_courier_earnings_proto_init() }
+func file_courier_earnings_proto_init() {
+	if File_courier_earnings_proto != nil {
+		return
+	}
+	file_order_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_courier_earnings_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeakHour); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_courier_earnings_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCompensationSchemeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_courier_earnings_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompensationScheme); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_courier_earnings_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCompensationSchemeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_courier_earnings_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCompensationSchemeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_courier_earnings_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCompensationSchemeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_courier_earnings_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourierEarning); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_courier_earnings_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayoutStatementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_courier_earnings_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayoutStatement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_courier_earnings_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_courier_earnings_proto_goTypes,
+		DependencyIndexes: file_courier_earnings_proto_depIdxs,
+		MessageInfos:      file_courier_earnings_proto_msgTypes,
+	}.Build()
+	File_courier_earnings_proto = out.File
+	file_courier_earnings_proto_rawDesc = nil
+	file_courier_earnings_proto_goTypes = nil
+	file_courier_earnings_proto_depIdxs = nil
+}
@@ -0,0 +1,337 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: courier_earnings.proto
+
+package order_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// CompensationSchemeServiceClient is the client API for CompensationSchemeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CompensationSchemeServiceClient interface {
+	Create(ctx context.Context, in *CreateCompensationSchemeRequest, opts ...grpc.CallOption) (*Response, error)
+	Get(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*CompensationScheme, error)
+	List(ctx context.Context, in *ListCompensationSchemeRequest, opts ...grpc.CallOption) (*ListCompensationSchemeResponse, error)
+	Update(ctx context.Context, in *UpdateCompensationSchemeRequest, opts ...grpc.CallOption) (*Response, error)
+	Delete(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Response, error)
+}
+
+type compensationSchemeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCompensationSchemeServiceClient(cc grpc.ClientConnInterface) CompensationSchemeServiceClient {
+	return &compensationSchemeServiceClient{cc}
+}
+
+func (c *compensationSchemeServiceClient) Create(ctx context.Context, in *CreateCompensationSchemeRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/order_service.CompensationSchemeService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compensationSchemeServiceClient) Get(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*CompensationScheme, error) {
+	out := new(CompensationScheme)
+	err := c.cc.Invoke(ctx, "/order_service.CompensationSchemeService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compensationSchemeServiceClient) List(ctx context.Context, in *ListCompensationSchemeRequest, opts ...grpc.CallOption) (*ListCompensationSchemeResponse, error) {
+	out := new(ListCompensationSchemeResponse)
+	err := c.cc.Invoke(ctx, "/order_service.CompensationSchemeService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compensationSchemeServiceClient) Update(ctx context.Context, in *UpdateCompensationSchemeRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/order_service.CompensationSchemeService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compensationSchemeServiceClient) Delete(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/order_service.CompensationSchemeService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CompensationSchemeServiceServer is the server API for CompensationSchemeService service.
+// All implementations must embed UnimplementedCompensationSchemeServiceServer
+// for forward compatibility
+type CompensationSchemeServiceServer interface {
+	Create(context.Context, *CreateCompensationSchemeRequest) (*Response, error)
+	Get(context.Context, *IdRequest) (*CompensationScheme, error)
+	List(context.Context, *ListCompensationSchemeRequest) (*ListCompensationSchemeResponse, error)
+	Update(context.Context, *UpdateCompensationSchemeRequest) (*Response, error)
+	Delete(context.Context, *IdRequest) (*Response, error)
+	mustEmbedUnimplementedCompensationSchemeServiceServer()
+}
+
+// UnimplementedCompensationSchemeServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCompensationSchemeServiceServer struct {
+}
+
+func (UnimplementedCompensationSchemeServiceServer) Create(context.Context, *CreateCompensationSchemeRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedCompensationSchemeServiceServer) Get(context.Context, *IdRequest) (*CompensationScheme, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedCompensationSchemeServiceServer) List(context.Context, *ListCompensationSchemeRequest) (*ListCompensationSchemeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedCompensationSchemeServiceServer) Update(context.Context, *UpdateCompensationSchemeRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedCompensationSchemeServiceServer) Delete(context.Context, *IdRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedCompensationSchemeServiceServer) mustEmbedUnimplementedCompensationSchemeServiceServer() {
+}
+
+// UnsafeCompensationSchemeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CompensationSchemeServiceServer will
+// result in compilation errors.
+type UnsafeCompensationSchemeServiceServer interface {
+	mustEmbedUnimplementedCompensationSchemeServiceServer()
+}
+
+func RegisterCompensationSchemeServiceServer(s grpc.ServiceRegistrar, srv CompensationSchemeServiceServer) {
+	s.RegisterService(&CompensationSchemeService_ServiceDesc, srv)
+}
+
+func _CompensationSchemeService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCompensationSchemeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompensationSchemeServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.CompensationSchemeService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompensationSchemeServiceServer).Create(ctx, req.(*CreateCompensationSchemeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompensationSchemeService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompensationSchemeServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.CompensationSchemeService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompensationSchemeServiceServer).Get(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompensationSchemeService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompensationSchemeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompensationSchemeServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.CompensationSchemeService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompensationSchemeServiceServer).List(ctx, req.(*ListCompensationSchemeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompensationSchemeService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCompensationSchemeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompensationSchemeServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.CompensationSchemeService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompensationSchemeServiceServer).Update(ctx, req.(*UpdateCompensationSchemeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompensationSchemeService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompensationSchemeServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.CompensationSchemeService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompensationSchemeServiceServer).Delete(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CompensationSchemeService_ServiceDesc is the grpc.ServiceDesc for CompensationSchemeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CompensationSchemeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "order_service.CompensationSchemeService",
+	HandlerType: (*CompensationSchemeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _CompensationSchemeService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _CompensationSchemeService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _CompensationSchemeService_List_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _CompensationSchemeService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _CompensationSchemeService_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "courier_earnings.proto",
+}
+
+// CourierEarningsServiceClient is the client API for CourierEarningsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CourierEarningsServiceClient interface {
+	GetPayoutStatement(ctx context.Context, in *PayoutStatementRequest, opts ...grpc.CallOption) (*PayoutStatement, error)
+}
+
+type courierEarningsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCourierEarningsServiceClient(cc grpc.ClientConnInterface) CourierEarningsServiceClient {
+	return &courierEarningsServiceClient{cc}
+}
+
+func (c *courierEarningsServiceClient) GetPayoutStatement(ctx context.Context, in *PayoutStatementRequest, opts ...grpc.CallOption) (*PayoutStatement, error) {
+	out := new(PayoutStatement)
+	err := c.cc.Invoke(ctx, "/order_service.CourierEarningsService/GetPayoutStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CourierEarningsServiceServer is the server API for CourierEarningsService service.
+// All implementations must embed UnimplementedCourierEarningsServiceServer
+// for forward compatibility
+type CourierEarningsServiceServer interface {
+	GetPayoutStatement(context.Context, *PayoutStatementRequest) (*PayoutStatement, error)
+	mustEmbedUnimplementedCourierEarningsServiceServer()
+}
+
+// UnimplementedCourierEarningsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCourierEarningsServiceServer struct {
+}
+
+func (UnimplementedCourierEarningsServiceServer) GetPayoutStatement(context.Context, *PayoutStatementRequest) (*PayoutStatement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayoutStatement not implemented")
+}
+func (UnimplementedCourierEarningsServiceServer) mustEmbedUnimplementedCourierEarningsServiceServer() {
+}
+
+// UnsafeCourierEarningsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CourierEarningsServiceServer will
+// result in compilation errors.
+type UnsafeCourierEarningsServiceServer interface {
+	mustEmbedUnimplementedCourierEarningsServiceServer()
+}
+
+func RegisterCourierEarningsServiceServer(s grpc.ServiceRegistrar, srv CourierEarningsServiceServer) {
+	s.RegisterService(&CourierEarningsService_ServiceDesc, srv)
+}
+
+func _CourierEarningsService_GetPayoutStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayoutStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierEarningsServiceServer).GetPayoutStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.CourierEarningsService/GetPayoutStatement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierEarningsServiceServer).GetPayoutStatement(ctx, req.(*PayoutStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CourierEarningsService_ServiceDesc is the grpc.ServiceDesc for CourierEarningsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CourierEarningsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "order_service.CourierEarningsService",
+	HandlerType: (*CourierEarningsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPayoutStatement",
+			Handler:    _CourierEarningsService_GetPayoutStatement_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "courier_earnings.proto",
+}
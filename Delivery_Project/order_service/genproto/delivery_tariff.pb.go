@@ -521,7 +521,7 @@ var file_delivery_tariff_proto_rawDesc = []byte{
 	0x66, 0x72, 0x6f, 0x6d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f,
 	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x74, 0x6f, 0x50,
 	0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x32, 0x99, 0x03, 0x0a, 0x15, 0x44,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x32, 0xb8, 0x04, 0x0a, 0x15, 0x44,
 	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x53, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x2a,
 	0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
@@ -547,9 +547,19 @@ var file_delivery_tariff_proto_rawDesc = []byte{
 	0x65, 0x12, 0x18, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
 	0x65, 0x2e, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6f, 0x72,
 	0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x18, 0x5a, 0x16, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x12, 0x18, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x21, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x18, 0x5a, 0x16, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -573,28 +583,33 @@ var file_delivery_tariff_proto_goTypes = []interface{}{
 	(*ListDeliveryTariffResponse)(nil),  // 4: order_service.ListDeliveryTariffResponse
 	(*DeliveryTariffValues)(nil),        // 5: order_service.DeliveryTariffValues
 	(*IdRequest)(nil),                   // 6: order_service.IdRequest
-	(*Response)(nil),                    // 7: order_service.Response
+	(*ListDeletedRequest)(nil),          // 7: order_service.ListDeletedRequest
+	(*Response)(nil),                    // 8: order_service.Response
 }
 var file_delivery_tariff_proto_depIdxs = []int32{
-	5, // 0: order_service.CreateDeliveryTariffRequest.Values:type_name -> order_service.DeliveryTariffValues
-	5, // 1: order_service.DeliveryTariff.Values:type_name -> order_service.DeliveryTariffValues
-	5, // 2: order_service.UpdateDeliveryTariffRequest.Values:type_name -> order_service.DeliveryTariffValues
-	1, // 3: order_service.ListDeliveryTariffResponse.DeliveryTariffs:type_name -> order_service.DeliveryTariff
-	0, // 4: order_service.DeliveryTariffService.Create:input_type -> order_service.CreateDeliveryTariffRequest
-	6, // 5: order_service.DeliveryTariffService.Get:input_type -> order_service.IdRequest
-	3, // 6: order_service.DeliveryTariffService.List:input_type -> order_service.ListDeliveryTariffRequest
-	2, // 7: order_service.DeliveryTariffService.Update:input_type -> order_service.UpdateDeliveryTariffRequest
-	6, // 8: order_service.DeliveryTariffService.Delete:input_type -> order_service.IdRequest
-	7, // 9: order_service.DeliveryTariffService.Create:output_type -> order_service.Response
-	1, // 10: order_service.DeliveryTariffService.Get:output_type -> order_service.DeliveryTariff
-	4, // 11: order_service.DeliveryTariffService.List:output_type -> order_service.ListDeliveryTariffResponse
-	7, // 12: order_service.DeliveryTariffService.Update:output_type -> order_service.Response
-	7, // 13: order_service.DeliveryTariffService.Delete:output_type -> order_service.Response
-	9, // [9:14] is the sub-list for method output_type
-	4, // [4:9] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	5,  // 0: order_service.CreateDeliveryTariffRequest.Values:type_name -> order_service.DeliveryTariffValues
+	5,  // 1: order_service.DeliveryTariff.Values:type_name -> order_service.DeliveryTariffValues
+	5,  // 2: order_service.UpdateDeliveryTariffRequest.Values:type_name -> order_service.DeliveryTariffValues
+	1,  // 3: order_service.ListDeliveryTariffResponse.DeliveryTariffs:type_name -> order_service.DeliveryTariff
+	0,  // 4: order_service.DeliveryTariffService.Create:input_type -> order_service.CreateDeliveryTariffRequest
+	6,  // 5: order_service.DeliveryTariffService.Get:input_type -> order_service.IdRequest
+	3,  // 6: order_service.DeliveryTariffService.List:input_type -> order_service.ListDeliveryTariffRequest
+	2,  // 7: order_service.DeliveryTariffService.Update:input_type -> order_service.UpdateDeliveryTariffRequest
+	6,  // 8: order_service.DeliveryTariffService.Delete:input_type -> order_service.IdRequest
+	6,  // 9: order_service.DeliveryTariffService.Restore:input_type -> order_service.IdRequest
+	7,  // 10: order_service.DeliveryTariffService.ListDeleted:input_type -> order_service.ListDeletedRequest
+	8,  // 11: order_service.DeliveryTariffService.Create:output_type -> order_service.Response
+	1,  // 12: order_service.DeliveryTariffService.Get:output_type -> order_service.DeliveryTariff
+	4,  // 13: order_service.DeliveryTariffService.List:output_type -> order_service.ListDeliveryTariffResponse
+	8,  // 14: order_service.DeliveryTariffService.Update:output_type -> order_service.Response
+	8,  // 15: order_service.DeliveryTariffService.Delete:output_type -> order_service.Response
+	8,  // 16: order_service.DeliveryTariffService.Restore:output_type -> order_service.Response
+	4,  // 17: order_service.DeliveryTariffService.ListDeleted:output_type -> order_service.ListDeliveryTariffResponse
+	11, // [11:18] is the sub-list for method output_type
+	4,  // [4:11] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_delivery_tariff_proto_init() }
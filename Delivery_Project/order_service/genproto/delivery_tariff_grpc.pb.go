@@ -27,6 +27,8 @@ type DeliveryTariffServiceClient interface {
 	List(ctx context.Context, in *ListDeliveryTariffRequest, opts ...grpc.CallOption) (*ListDeliveryTariffResponse, error)
 	Update(ctx context.Context, in *UpdateDeliveryTariffRequest, opts ...grpc.CallOption) (*Response, error)
 	Delete(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Response, error)
+	Restore(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Response, error)
+	ListDeleted(ctx context.Context, in *ListDeletedRequest, opts ...grpc.CallOption) (*ListDeliveryTariffResponse, error)
 }
 
 type deliveryTariffServiceClient struct {
@@ -82,6 +84,24 @@ func (c *deliveryTariffServiceClient) Delete(ctx context.Context, in *IdRequest,
 	return out, nil
 }
 
+func (c *deliveryTariffServiceClient) Restore(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/order_service.DeliveryTariffService/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deliveryTariffServiceClient) ListDeleted(ctx context.Context, in *ListDeletedRequest, opts ...grpc.CallOption) (*ListDeliveryTariffResponse, error) {
+	out := new(ListDeliveryTariffResponse)
+	err := c.cc.Invoke(ctx, "/order_service.DeliveryTariffService/ListDeleted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeliveryTariffServiceServer is the server API for DeliveryTariffService service.
 // All implementations must embed UnimplementedDeliveryTariffServiceServer
 // for forward compatibility
@@ -91,6 +111,8 @@ type DeliveryTariffServiceServer interface {
 	List(context.Context, *ListDeliveryTariffRequest) (*ListDeliveryTariffResponse, error)
 	Update(context.Context, *UpdateDeliveryTariffRequest) (*Response, error)
 	Delete(context.Context, *IdRequest) (*Response, error)
+	Restore(context.Context, *IdRequest) (*Response, error)
+	ListDeleted(context.Context, *ListDeletedRequest) (*ListDeliveryTariffResponse, error)
 	mustEmbedUnimplementedDeliveryTariffServiceServer()
 }
 
@@ -113,6 +135,12 @@ func (UnimplementedDeliveryTariffServiceServer) Update(context.Context, *UpdateD
 func (UnimplementedDeliveryTariffServiceServer) Delete(context.Context, *IdRequest) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedDeliveryTariffServiceServer) Restore(context.Context, *IdRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedDeliveryTariffServiceServer) ListDeleted(context.Context, *ListDeletedRequest) (*ListDeliveryTariffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeleted not implemented")
+}
 func (UnimplementedDeliveryTariffServiceServer) mustEmbedUnimplementedDeliveryTariffServiceServer() {}
 
 // UnsafeDeliveryTariffServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -216,6 +244,42 @@ func _DeliveryTariffService_Delete_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeliveryTariffService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryTariffServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.DeliveryTariffService/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryTariffServiceServer).Restore(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeliveryTariffService_ListDeleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryTariffServiceServer).ListDeleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.DeliveryTariffService/ListDeleted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryTariffServiceServer).ListDeleted(ctx, req.(*ListDeletedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DeliveryTariffService_ServiceDesc is the grpc.ServiceDesc for DeliveryTariffService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -243,6 +307,14 @@ var DeliveryTariffService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _DeliveryTariffService_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _DeliveryTariffService_Restore_Handler,
+		},
+		{
+			MethodName: "ListDeleted",
+			Handler:    _DeliveryTariffService_ListDeleted_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "delivery_tariff.proto",
@@ -0,0 +1,285 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: promo_code.proto
+
+package order_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PromoCodeServiceClient is the client API for PromoCodeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PromoCodeServiceClient interface {
+	Create(ctx context.Context, in *CreatePromoCodeRequest, opts ...grpc.CallOption) (*Response, error)
+	Get(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*PromoCode, error)
+	List(ctx context.Context, in *ListPromoCodeRequest, opts ...grpc.CallOption) (*ListPromoCodeResponse, error)
+	Update(ctx context.Context, in *UpdatePromoCodeRequest, opts ...grpc.CallOption) (*Response, error)
+	Delete(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Response, error)
+	ApplyPromo(ctx context.Context, in *ApplyPromoRequest, opts ...grpc.CallOption) (*ApplyPromoResponse, error)
+}
+
+type promoCodeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPromoCodeServiceClient(cc grpc.ClientConnInterface) PromoCodeServiceClient {
+	return &promoCodeServiceClient{cc}
+}
+
+func (c *promoCodeServiceClient) Create(ctx context.Context, in *CreatePromoCodeRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/order_service.PromoCodeService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoCodeServiceClient) Get(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*PromoCode, error) {
+	out := new(PromoCode)
+	err := c.cc.Invoke(ctx, "/order_service.PromoCodeService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoCodeServiceClient) List(ctx context.Context, in *ListPromoCodeRequest, opts ...grpc.CallOption) (*ListPromoCodeResponse, error) {
+	out := new(ListPromoCodeResponse)
+	err := c.cc.Invoke(ctx, "/order_service.PromoCodeService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoCodeServiceClient) Update(ctx context.Context, in *UpdatePromoCodeRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/order_service.PromoCodeService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoCodeServiceClient) Delete(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/order_service.PromoCodeService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoCodeServiceClient) ApplyPromo(ctx context.Context, in *ApplyPromoRequest, opts ...grpc.CallOption) (*ApplyPromoResponse, error) {
+	out := new(ApplyPromoResponse)
+	err := c.cc.Invoke(ctx, "/order_service.PromoCodeService/ApplyPromo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PromoCodeServiceServer is the server API for PromoCodeService service.
+// All implementations must embed UnimplementedPromoCodeServiceServer
+// for forward compatibility
+type PromoCodeServiceServer interface {
+	Create(context.Context, *CreatePromoCodeRequest) (*Response, error)
+	Get(context.Context, *IdRequest) (*PromoCode, error)
+	List(context.Context, *ListPromoCodeRequest) (*ListPromoCodeResponse, error)
+	Update(context.Context, *UpdatePromoCodeRequest) (*Response, error)
+	Delete(context.Context, *IdRequest) (*Response, error)
+	ApplyPromo(context.Context, *ApplyPromoRequest) (*ApplyPromoResponse, error)
+	mustEmbedUnimplementedPromoCodeServiceServer()
+}
+
+// UnimplementedPromoCodeServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPromoCodeServiceServer struct {
+}
+
+func (UnimplementedPromoCodeServiceServer) Create(context.Context, *CreatePromoCodeRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedPromoCodeServiceServer) Get(context.Context, *IdRequest) (*PromoCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedPromoCodeServiceServer) List(context.Context, *ListPromoCodeRequest) (*ListPromoCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedPromoCodeServiceServer) Update(context.Context, *UpdatePromoCodeRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedPromoCodeServiceServer) Delete(context.Context, *IdRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedPromoCodeServiceServer) ApplyPromo(context.Context, *ApplyPromoRequest) (*ApplyPromoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyPromo not implemented")
+}
+func (UnimplementedPromoCodeServiceServer) mustEmbedUnimplementedPromoCodeServiceServer() {}
+
+// UnsafePromoCodeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PromoCodeServiceServer will
+// result in compilation errors.
+type UnsafePromoCodeServiceServer interface {
+	mustEmbedUnimplementedPromoCodeServiceServer()
+}
+
+func RegisterPromoCodeServiceServer(s grpc.ServiceRegistrar, srv PromoCodeServiceServer) {
+	s.RegisterService(&PromoCodeService_ServiceDesc, srv)
+}
+
+func _PromoCodeService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoCodeServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.PromoCodeService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoCodeServiceServer).Create(ctx, req.(*CreatePromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoCodeService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoCodeServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.PromoCodeService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoCodeServiceServer).Get(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoCodeService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoCodeServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.PromoCodeService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoCodeServiceServer).List(ctx, req.(*ListPromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoCodeService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoCodeServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.PromoCodeService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoCodeServiceServer).Update(ctx, req.(*UpdatePromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoCodeService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoCodeServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.PromoCodeService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoCodeServiceServer).Delete(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoCodeService_ApplyPromo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyPromoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoCodeServiceServer).ApplyPromo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order_service.PromoCodeService/ApplyPromo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoCodeServiceServer).ApplyPromo(ctx, req.(*ApplyPromoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PromoCodeService_ServiceDesc is the grpc.ServiceDesc for PromoCodeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PromoCodeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "order_service.PromoCodeService",
+	HandlerType: (*PromoCodeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _PromoCodeService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _PromoCodeService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _PromoCodeService_List_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _PromoCodeService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _PromoCodeService_Delete_Handler,
+		},
+		{
+			MethodName: "ApplyPromo",
+			Handler:    _PromoCodeService_ApplyPromo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "promo_code.proto",
+}
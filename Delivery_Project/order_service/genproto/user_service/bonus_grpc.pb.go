@@ -0,0 +1,285 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: bonus.proto
+
+package user_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// BonusServiceClient is the client API for BonusService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BonusServiceClient interface {
+	Accrue(ctx context.Context, in *AccrueBonusRequest, opts ...grpc.CallOption) (*BonusTransaction, error)
+	Spend(ctx context.Context, in *SpendBonusRequest, opts ...grpc.CallOption) (*BonusTransaction, error)
+	Refund(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*BonusTransaction, error)
+	RefundOrder(ctx context.Context, in *RefundOrderRequest, opts ...grpc.CallOption) (*BonusTransaction, error)
+	GetBalance(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*BonusBalance, error)
+	GetStatement(ctx context.Context, in *BonusStatementRequest, opts ...grpc.CallOption) (*BonusStatementResponse, error)
+}
+
+type bonusServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBonusServiceClient(cc grpc.ClientConnInterface) BonusServiceClient {
+	return &bonusServiceClient{cc}
+}
+
+func (c *bonusServiceClient) Accrue(ctx context.Context, in *AccrueBonusRequest, opts ...grpc.CallOption) (*BonusTransaction, error) {
+	out := new(BonusTransaction)
+	err := c.cc.Invoke(ctx, "/user_service.BonusService/Accrue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bonusServiceClient) Spend(ctx context.Context, in *SpendBonusRequest, opts ...grpc.CallOption) (*BonusTransaction, error) {
+	out := new(BonusTransaction)
+	err := c.cc.Invoke(ctx, "/user_service.BonusService/Spend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bonusServiceClient) Refund(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*BonusTransaction, error) {
+	out := new(BonusTransaction)
+	err := c.cc.Invoke(ctx, "/user_service.BonusService/Refund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bonusServiceClient) RefundOrder(ctx context.Context, in *RefundOrderRequest, opts ...grpc.CallOption) (*BonusTransaction, error) {
+	out := new(BonusTransaction)
+	err := c.cc.Invoke(ctx, "/user_service.BonusService/RefundOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bonusServiceClient) GetBalance(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*BonusBalance, error) {
+	out := new(BonusBalance)
+	err := c.cc.Invoke(ctx, "/user_service.BonusService/GetBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bonusServiceClient) GetStatement(ctx context.Context, in *BonusStatementRequest, opts ...grpc.CallOption) (*BonusStatementResponse, error) {
+	out := new(BonusStatementResponse)
+	err := c.cc.Invoke(ctx, "/user_service.BonusService/GetStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BonusServiceServer is the server API for BonusService service.
+// All implementations must embed UnimplementedBonusServiceServer
+// for forward compatibility
+type BonusServiceServer interface {
+	Accrue(context.Context, *AccrueBonusRequest) (*BonusTransaction, error)
+	Spend(context.Context, *SpendBonusRequest) (*BonusTransaction, error)
+	Refund(context.Context, *IdRequest) (*BonusTransaction, error)
+	RefundOrder(context.Context, *RefundOrderRequest) (*BonusTransaction, error)
+	GetBalance(context.Context, *IdRequest) (*BonusBalance, error)
+	GetStatement(context.Context, *BonusStatementRequest) (*BonusStatementResponse, error)
+	mustEmbedUnimplementedBonusServiceServer()
+}
+
+// UnimplementedBonusServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBonusServiceServer struct {
+}
+
+func (UnimplementedBonusServiceServer) Accrue(context.Context, *AccrueBonusRequest) (*BonusTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Accrue not implemented")
+}
+func (UnimplementedBonusServiceServer) Spend(context.Context, *SpendBonusRequest) (*BonusTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Spend not implemented")
+}
+func (UnimplementedBonusServiceServer) Refund(context.Context, *IdRequest) (*BonusTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refund not implemented")
+}
+func (UnimplementedBonusServiceServer) RefundOrder(context.Context, *RefundOrderRequest) (*BonusTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundOrder not implemented")
+}
+func (UnimplementedBonusServiceServer) GetBalance(context.Context, *IdRequest) (*BonusBalance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
+}
+func (UnimplementedBonusServiceServer) GetStatement(context.Context, *BonusStatementRequest) (*BonusStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatement not implemented")
+}
+func (UnimplementedBonusServiceServer) mustEmbedUnimplementedBonusServiceServer() {}
+
+// UnsafeBonusServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BonusServiceServer will
+// result in compilation errors.
+type UnsafeBonusServiceServer interface {
+	mustEmbedUnimplementedBonusServiceServer()
+}
+
+func RegisterBonusServiceServer(s grpc.ServiceRegistrar, srv BonusServiceServer) {
+	s.RegisterService(&BonusService_ServiceDesc, srv)
+}
+
+func _BonusService_Accrue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccrueBonusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BonusServiceServer).Accrue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.BonusService/Accrue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BonusServiceServer).Accrue(ctx, req.(*AccrueBonusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BonusService_Spend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpendBonusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BonusServiceServer).Spend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.BonusService/Spend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BonusServiceServer).Spend(ctx, req.(*SpendBonusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BonusService_Refund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BonusServiceServer).Refund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.BonusService/Refund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BonusServiceServer).Refund(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BonusService_RefundOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BonusServiceServer).RefundOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.BonusService/RefundOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BonusServiceServer).RefundOrder(ctx, req.(*RefundOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BonusService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BonusServiceServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.BonusService/GetBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BonusServiceServer).GetBalance(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BonusService_GetStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BonusStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BonusServiceServer).GetStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.BonusService/GetStatement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BonusServiceServer).GetStatement(ctx, req.(*BonusStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BonusService_ServiceDesc is the grpc.ServiceDesc for BonusService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BonusService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user_service.BonusService",
+	HandlerType: (*BonusServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Accrue",
+			Handler:    _BonusService_Accrue_Handler,
+		},
+		{
+			MethodName: "Spend",
+			Handler:    _BonusService_Spend_Handler,
+		},
+		{
+			MethodName: "Refund",
+			Handler:    _BonusService_Refund_Handler,
+		},
+		{
+			MethodName: "RefundOrder",
+			Handler:    _BonusService_RefundOrder_Handler,
+		},
+		{
+			MethodName: "GetBalance",
+			Handler:    _BonusService_GetBalance_Handler,
+		},
+		{
+			MethodName: "GetStatement",
+			Handler:    _BonusService_GetStatement_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bonus.proto",
+}
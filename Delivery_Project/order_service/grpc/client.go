@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"order_service/config"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// DialUserService connects to user service. With a CA the server's own
+// certificate is presented as the client one, so user service has to allow
+// order-service in its TLS_ALLOWED_CLIENTS.
+func DialUserService(cfg config.Config) (*grpc.ClientConn, error) {
+	creds, err := clientCredentials(cfg, "user-service")
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc.Dial("dns:///"+cfg.UserServiceHost+cfg.UserGRPCPort, grpc.WithTransportCredentials(creds))
+}
+
+// clientCredentials is TLS when a CA is configured, the server name is fixed
+// the way the gateway does it
+func clientCredentials(cfg config.Config, serverName string) (credentials.TransportCredentials, error) {
+	if cfg.TLSCAFile == "" {
+		return insecure.NewCredentials(), nil
+	}
+
+	ca, err := os.ReadFile(cfg.TLSCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read TLS CA: %w", err)
+	}
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("no certificates found in %s", cfg.TLSCAFile)
+	}
+
+	tlsConfig := &tls.Config{
+		RootCAs:    rootCAs,
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if cfg.TLSCertFile != "" || cfg.TLSKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
@@ -1,23 +1,78 @@
 package grpc
 
 import (
+	"context"
+
 	"order_service/config"
 	order_service "order_service/genproto"
+	user_service "order_service/genproto/user_service"
 	"order_service/grpc/service"
 
+	"order_service/pkg/errs"
+	"order_service/pkg/hub"
+	"order_service/pkg/identity"
+	"order_service/pkg/interceptor"
 	"order_service/pkg/logger"
+	"order_service/pkg/metrics"
+	"order_service/pkg/payment"
 	"order_service/storage"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
-func SetUpServer(cfg config.Config, log logger.LoggerI, strg storage.StorageI) (grpcServer *grpc.Server) {
-	grpcServer = grpc.NewServer()
+func SetUpServer(ctx context.Context, cfg config.Config, log logger.LoggerI, strg storage.StorageI, provider payment.Provider, bonus user_service.BonusServiceClient, m *metrics.Metrics) (grpcServer *grpc.Server, err error) {
+	opts, err := serverOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// the caller's identity is checked after errs, so a rejected one is a typed error too
+	verifier := identity.NewVerifier(cfg.IdentitySecret, cfg.IdentityMaxAge)
+
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(
+			interceptor.UnaryRequestID(),
+			m.UnaryServerInterceptor(),
+			interceptor.UnaryLogging(log),
+			interceptor.UnaryRecovery(log),
+			errs.UnaryServerInterceptor(),
+			verifier.UnaryServerInterceptor(),
+			interceptor.UnaryDeadline(cfg.RPCTimeout),
+			validationRules().UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			interceptor.StreamRequestID(),
+			m.StreamServerInterceptor(),
+			interceptor.StreamLogging(log),
+			interceptor.StreamRecovery(log),
+			errs.StreamServerInterceptor(),
+			verifier.StreamServerInterceptor(),
+		),
+	)
+	grpcServer = grpc.NewServer(opts...)
 
-	order_service.RegisterOrderServiceServer(grpcServer, service.NewOrderService(cfg, log, strg))
+	// order changes wake up the kitchen screens of their branch
+	kitchen := hub.New()
+
+	order_service.RegisterOrderServiceServer(grpcServer, service.NewOrderService(cfg, log, strg, provider, bonus, kitchen))
 	order_service.RegisterDeliveryTariffServiceServer(grpcServer, service.NewDeliveryTariffService(cfg, log, strg))
+	order_service.RegisterPromoCodeServiceServer(grpcServer, service.NewPromoCodeService(cfg, log, strg))
+	order_service.RegisterPaymentServiceServer(grpcServer, service.NewPaymentService(cfg, log, strg, provider, kitchen))
+	order_service.RegisterCourierCashServiceServer(grpcServer, service.NewCourierCashService(cfg, log, strg))
+	order_service.RegisterCompensationSchemeServiceServer(grpcServer, service.NewCompensationSchemeService(cfg, log, strg))
+	order_service.RegisterCourierEarningsServiceServer(grpcServer, service.NewCourierEarningsService(cfg, log, strg))
+	order_service.RegisterFeedbackServiceServer(grpcServer, service.NewFeedbackService(cfg, log, strg))
+	order_service.RegisterKitchenServiceServer(grpcServer, service.NewKitchenService(cfg, log, strg, kitchen))
+	order_service.RegisterAuditServiceServer(grpcServer, service.NewAuditService(cfg, log, strg))
+	order_service.RegisterClientOrdersServiceServer(grpcServer, service.NewClientOrdersService(cfg, log, strg))
+
+	hs := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, hs)
+	go watchHealth(ctx, hs, strg, log, cfg.HealthCheckInterval)
 
 	reflection.Register(grpcServer)
-	return
+	return grpcServer, nil
 }
@@ -0,0 +1,114 @@
+package grpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"order_service/config"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+)
+
+// serverOptions returns the transport options of the server, TLS is only
+// enabled when cfg has a certificate and is mutual when it also has a CA
+func serverOptions(cfg config.Config) ([]grpc.ServerOption, error) {
+	opts := []grpc.ServerOption{
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionIdle: cfg.MaxConnectionIdle,
+			Time:              cfg.KeepaliveTime,
+			Timeout:           cfg.KeepaliveTimeout,
+		}),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             cfg.KeepaliveMinTime,
+			PermitWithoutStream: true,
+		}),
+	}
+
+	if cfg.TLSCertFile != "" || cfg.TLSKeyFile != "" {
+		tlsConfig, err := serverTLSConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	return opts, nil
+}
+
+func serverTLSConfig(cfg config.Config) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if cfg.TLSCAFile == "" {
+		return tlsConfig, nil
+	}
+
+	ca, err := os.ReadFile(cfg.TLSCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read TLS CA: %w", err)
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("no certificates found in %s", cfg.TLSCAFile)
+	}
+
+	tlsConfig.ClientCAs = clientCAs
+	tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	// the chain is already verified against the CA, only the identity is left
+	tlsConfig.VerifyConnection = func(cs tls.ConnectionState) error {
+		if len(cs.PeerCertificates) == 0 {
+			return errors.New("client certificate is required")
+		}
+		if peer := cs.PeerCertificates[0]; !allowedClient(peer, cfg.TLSAllowedClients) {
+			return fmt.Errorf("client %q is not allowed", peer.Subject.CommonName)
+		}
+		return nil
+	}
+
+	return tlsConfig, nil
+}
+
+// allowedClient matches the common name and DNS names of cert against the
+// allowlist, an empty allowlist lets in every certificate of the CA
+func allowedClient(cert *x509.Certificate, allowed []string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+
+	for _, name := range allowed {
+		if cert.Subject.CommonName == name || slices.Contains(cert.DNSNames, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// GracefulStop stops accepting new RPCs and waits for in-flight ones up to
+// timeout, then closes the connections that are left.
+// Kitchen streams never finish on their own, the timeout is what ends them.
+func GracefulStop(s *grpc.Server, timeout time.Duration) {
+	done := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		s.Stop()
+	}
+}
@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"order_service/config"
+)
+
+func TestServerTLSAllowedClients(t *testing.T) {
+	dir := testCerts(t, "order-service", "api-gateway", "other-service")
+
+	ca, err := os.ReadFile(filepath.Join(dir, "ca.crt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rootCAs := x509.NewCertPool()
+	rootCAs.AppendCertsFromPEM(ca)
+
+	tests := []struct {
+		name    string
+		allowed []string
+		client  string
+		wantErr string
+	}{
+		{name: "common name", allowed: []string{"api-gateway"}, client: "api-gateway"},
+		{name: "dns name", allowed: []string{"user-service", "api-gateway.local"}, client: "api-gateway"},
+		{name: "empty allowlist", client: "other-service"},
+		// a certificate of the same CA that is not on the allowlist
+		{name: "other client", allowed: []string{"api-gateway"}, client: "other-service", wantErr: `client "other-service" is not allowed`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverTLS, err := serverTLSConfig(config.Config{
+				TLSCertFile:       filepath.Join(dir, "order-service.crt"),
+				TLSKeyFile:        filepath.Join(dir, "order-service.key"),
+				TLSCAFile:         filepath.Join(dir, "ca.crt"),
+				TLSAllowedClients: tt.allowed,
+			})
+			if err != nil {
+				t.Fatalf("serverTLSConfig: %v", err)
+			}
+
+			lis, err := tls.Listen("tcp", "127.0.0.1:0", serverTLS)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer lis.Close()
+
+			serverErr := make(chan error, 1)
+			go func() {
+				conn, err := lis.Accept()
+				if err != nil {
+					serverErr <- err
+					return
+				}
+				defer conn.Close()
+				serverErr <- conn.(*tls.Conn).Handshake()
+			}()
+
+			cert, err := tls.LoadX509KeyPair(filepath.Join(dir, tt.client+".crt"), filepath.Join(dir, tt.client+".key"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// with TLS 1.3 the client is done before the server checks its
+			// certificate, so the server side tells whether it was let in
+			conn, dialErr := tls.Dial("tcp", lis.Addr().String(), &tls.Config{
+				RootCAs:      rootCAs,
+				ServerName:   "order-service",
+				Certificates: []tls.Certificate{cert},
+				MinVersion:   tls.VersionTLS12,
+			})
+			err = <-serverErr
+			if dialErr == nil {
+				conn.Close()
+			} else if tt.wantErr == "" {
+				t.Fatalf("dial: %v", dialErr)
+			}
+
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("server handshake: %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("server handshake error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
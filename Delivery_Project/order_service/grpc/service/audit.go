@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"order_service/config"
+	order_service "order_service/genproto"
+	"order_service/pkg/errs"
+	"order_service/pkg/identity"
+	"order_service/pkg/logger"
+	"order_service/storage"
+)
+
+// auditRole is the role allowed to read the audit log, staff users log in with it
+const auditRole = "user"
+
+type AuditService struct {
+	cfg     config.Config
+	log     logger.LoggerI
+	storage storage.StorageI
+	order_service.UnimplementedAuditServiceServer
+}
+
+func NewAuditService(cfg config.Config, log logger.LoggerI, strg storage.StorageI) *AuditService {
+	return &AuditService{
+		cfg:     cfg,
+		log:     log,
+		storage: strg,
+	}
+}
+
+func (b *AuditService) List(ctx context.Context, req *order_service.ListAuditRequest) (*order_service.ListAuditResponse, error) {
+	caller, ok := identity.FromContext(ctx)
+	if !ok || caller.Role != auditRole {
+		return nil, errs.PermissionDenied("only staff users can read the audit log")
+	}
+
+	resp, err := b.storage.Audit().List(ctx, req)
+	if err != nil {
+		b.log.Error("error while getting audit log", logger.Error(err))
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"order_service/config"
+	order_service "order_service/genproto"
+	"order_service/pkg/errs"
+	"order_service/pkg/identity"
+	"order_service/pkg/logger"
+	"order_service/storage"
+)
+
+const (
+	// clientDataRole is the role allowed to export and anonymize client data
+	clientDataRole = "user"
+	// exportPageSize is the page size used to collect the lists of an export
+	exportPageSize = 100
+)
+
+type ClientOrdersService struct {
+	cfg     config.Config
+	log     logger.LoggerI
+	storage storage.StorageI
+	order_service.UnimplementedClientOrdersServiceServer
+}
+
+func NewClientOrdersService(cfg config.Config, log logger.LoggerI, strg storage.StorageI) *ClientOrdersService {
+	return &ClientOrdersService{
+		cfg:     cfg,
+		log:     log,
+		storage: strg,
+	}
+}
+
+// Export collects every order of the client, deleted ones included, with
+// their products, payments and feedback
+func (b *ClientOrdersService) Export(ctx context.Context, req *order_service.ClientIdRequest) (*order_service.ClientOrdersExport, error) {
+	caller, ok := identity.FromContext(ctx)
+	if !ok || caller.Role != clientDataRole {
+		return nil, errs.PermissionDenied("only staff users can export client data")
+	}
+
+	resp := &order_service.ClientOrdersExport{}
+	for cursor := ""; ; {
+		orders, err := b.storage.Order().GetListWithDeleted(ctx, &order_service.ListOrderRequest{
+			ClientId: req.ClientId,
+			Limit:    exportPageSize,
+			Cursor:   cursor,
+		})
+		if err != nil {
+			b.log.Error("error while getting orders for export", logger.Error(err))
+			return nil, err
+		}
+
+		for _, o := range orders.Orders {
+			order, err := b.storage.Order().GetWithDeleted(ctx, &order_service.IdStrRequest{Id: o.OrderId})
+			if err != nil {
+				b.log.Error("error while getting order for export", logger.Error(err))
+				return nil, err
+			}
+			resp.Orders = append(resp.Orders, order)
+
+			payment, err := b.storage.Payment().Get(ctx, &order_service.OrderIdRequest{OrderId: o.OrderId})
+			if err != nil {
+				if !errs.Is(err, errs.CodeNotFound) {
+					b.log.Error("error while getting payment for export", logger.Error(err))
+					return nil, err
+				}
+				continue
+			}
+			resp.Payments = append(resp.Payments, payment)
+		}
+
+		if orders.NextCursor == "" {
+			break
+		}
+		cursor = orders.NextCursor
+	}
+
+	for page := int32(1); ; page++ {
+		feedback, err := b.storage.Feedback().GetList(ctx, &order_service.ListFeedbackRequest{
+			ClientId: req.ClientId,
+			Limit:    exportPageSize,
+			Page:     page,
+		})
+		if err != nil {
+			b.log.Error("error while getting feedback for export", logger.Error(err))
+			return nil, err
+		}
+		resp.Feedback = append(resp.Feedback, feedback.Feedbacks...)
+
+		if len(feedback.Feedbacks) < exportPageSize {
+			break
+		}
+	}
+
+	return resp, nil
+}
+
+func (b *ClientOrdersService) Anonymize(ctx context.Context, req *order_service.ClientIdRequest) (*order_service.Response, error) {
+	caller, ok := identity.FromContext(ctx)
+	if !ok || caller.Role != clientDataRole {
+		return nil, errs.PermissionDenied("only staff users can anonymize client data")
+	}
+
+	msg, err := b.storage.Order().Anonymize(ctx, req)
+	if err != nil {
+		b.log.Error("error while anonymizing client orders", logger.Error(err))
+		return nil, err
+	}
+
+	return &order_service.Response{Message: msg}, nil
+}
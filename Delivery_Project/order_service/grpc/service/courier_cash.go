@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"order_service/config"
+	order_service "order_service/genproto"
+	"order_service/pkg/errs"
+	"order_service/pkg/identity"
+	"order_service/pkg/logger"
+	"order_service/storage"
+)
+
+type CourierCashService struct {
+	cfg     config.Config
+	log     logger.LoggerI
+	storage storage.StorageI
+	order_service.UnimplementedCourierCashServiceServer
+}
+
+func NewCourierCashService(cfg config.Config, log logger.LoggerI, strg storage.StorageI) *CourierCashService {
+	return &CourierCashService{
+		cfg:     cfg,
+		log:     log,
+		storage: strg,
+	}
+}
+
+// Handover records the cash a courier brought back, it is received by the
+// calling branch staff and staff bound to a branch only take it from its couriers
+func (b *CourierCashService) Handover(ctx context.Context, req *order_service.CashHandoverRequest) (*order_service.CashTransaction, error) {
+	caller, ok := identity.FromContext(ctx)
+	if !ok || caller.Role != staffRole {
+		return nil, errs.PermissionDenied("only branch staff can record cash hand-overs")
+	}
+
+	if caller.BranchID != 0 && req.BranchId != caller.BranchID {
+		return nil, errs.PermissionDenied("courier with ID %d belongs to another branch", req.CourierId)
+	}
+	req.ReceivedBy = caller.UserID
+
+	resp, err := b.storage.CourierCash().Handover(ctx, req)
+	if err != nil {
+		b.log.Error("error while recording cash handover", logger.Error(err))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (b *CourierCashService) GetBalance(ctx context.Context, req *order_service.IdRequest) (*order_service.CourierCashBalance, error) {
+	resp, err := b.storage.CourierCash().GetBalance(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (b *CourierCashService) ListTransactions(ctx context.Context, req *order_service.ListCashTransactionsRequest) (*order_service.ListCashTransactionsResponse, error) {
+	resp, err := b.storage.CourierCash().GetList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (b *CourierCashService) Reconcile(ctx context.Context, req *order_service.CashReconciliationRequest) (*order_service.CashReconciliation, error) {
+	resp, err := b.storage.CourierCash().Reconcile(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"order_service/config"
+	order_service "order_service/genproto"
+	"order_service/pkg/logger"
+	"order_service/storage"
+)
+
+type CompensationSchemeService struct {
+	cfg     config.Config
+	log     logger.LoggerI
+	storage storage.StorageI
+	order_service.UnimplementedCompensationSchemeServiceServer
+}
+
+func NewCompensationSchemeService(cfg config.Config, log logger.LoggerI, strg storage.StorageI) *CompensationSchemeService {
+	return &CompensationSchemeService{
+		cfg:     cfg,
+		log:     log,
+		storage: strg,
+	}
+}
+
+func (b *CompensationSchemeService) Create(ctx context.Context, req *order_service.CreateCompensationSchemeRequest) (*order_service.Response, error) {
+	id, err := b.storage.CompensationScheme().Create(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &order_service.Response{Message: id}, nil
+}
+
+func (b *CompensationSchemeService) Get(ctx context.Context, req *order_service.IdRequest) (*order_service.CompensationScheme, error) {
+	resp, err := b.storage.CompensationScheme().Get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (b *CompensationSchemeService) List(ctx context.Context, req *order_service.ListCompensationSchemeRequest) (*order_service.ListCompensationSchemeResponse, error) {
+	resp, err := b.storage.CompensationScheme().GetList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *CompensationSchemeService) Update(ctx context.Context, req *order_service.UpdateCompensationSchemeRequest) (*order_service.Response, error) {
+	resp, err := s.storage.CompensationScheme().Update(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &order_service.Response{Message: resp}, nil
+}
+
+func (s *CompensationSchemeService) Delete(ctx context.Context, req *order_service.IdRequest) (*order_service.Response, error) {
+	resp, err := s.storage.CompensationScheme().Delete(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &order_service.Response{Message: resp}, nil
+}
+
+type CourierEarningsService struct {
+	cfg     config.Config
+	log     logger.LoggerI
+	storage storage.StorageI
+	order_service.UnimplementedCourierEarningsServiceServer
+}
+
+func NewCourierEarningsService(cfg config.Config, log logger.LoggerI, strg storage.StorageI) *CourierEarningsService {
+	return &CourierEarningsService{
+		cfg:     cfg,
+		log:     log,
+		storage: strg,
+	}
+}
+
+func (b *CourierEarningsService) GetPayoutStatement(ctx context.Context, req *order_service.PayoutStatementRequest) (*order_service.PayoutStatement, error) {
+	resp, err := b.storage.CourierEarnings().GetPayoutStatement(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -24,7 +24,7 @@ func NewDeliveryTariffService(cfg config.Config, log logger.LoggerI, strg storag
 }
 
 func (b *DeliveryTariffService) Create(ctx context.Context, req *order_service.CreateDeliveryTariffRequest) (*order_service.Response, error) {
-	id, err := b.storage.DeliveryTariff().Create(context.Background(), req)
+	id, err := b.storage.DeliveryTariff().Create(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -33,7 +33,7 @@ func (b *DeliveryTariffService) Create(ctx context.Context, req *order_service.C
 }
 
 func (b *DeliveryTariffService) Get(ctx context.Context, req *order_service.IdRequest) (*order_service.DeliveryTariff, error) {
-	reso, err := b.storage.DeliveryTariff().Get(context.Background(), req)
+	reso, err := b.storage.DeliveryTariff().Get(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +42,7 @@ func (b *DeliveryTariffService) Get(ctx context.Context, req *order_service.IdRe
 }
 
 func (b *DeliveryTariffService) List(ctx context.Context, req *order_service.ListDeliveryTariffRequest) (*order_service.ListDeliveryTariffResponse, error) {
-	DeliveryTariffs, err := b.storage.DeliveryTariff().GetList(context.Background(), req)
+	DeliveryTariffs, err := b.storage.DeliveryTariff().GetList(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +52,7 @@ func (b *DeliveryTariffService) List(ctx context.Context, req *order_service.Lis
 }
 
 func (s *DeliveryTariffService) Update(ctx context.Context, req *order_service.UpdateDeliveryTariffRequest) (*order_service.Response, error) {
-	resp, err := s.storage.DeliveryTariff().Update(context.Background(), req)
+	resp, err := s.storage.DeliveryTariff().Update(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -61,10 +61,28 @@ func (s *DeliveryTariffService) Update(ctx context.Context, req *order_service.U
 }
 
 func (s *DeliveryTariffService) Delete(ctx context.Context, req *order_service.IdRequest) (*order_service.Response, error) {
-	resp, err := s.storage.DeliveryTariff().Delete(context.Background(), req)
+	resp, err := s.storage.DeliveryTariff().Delete(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
 	return &order_service.Response{Message: resp}, nil
 }
+
+func (s *DeliveryTariffService) Restore(ctx context.Context, req *order_service.IdRequest) (*order_service.Response, error) {
+	resp, err := s.storage.DeliveryTariff().Restore(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &order_service.Response{Message: resp}, nil
+}
+
+func (s *DeliveryTariffService) ListDeleted(ctx context.Context, req *order_service.ListDeletedRequest) (*order_service.ListDeliveryTariffResponse, error) {
+	resp, err := s.storage.DeliveryTariff().GetDeletedList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"order_service/config"
+	order_service "order_service/genproto"
+	"order_service/pkg/errs"
+	"order_service/pkg/logger"
+	"order_service/storage"
+)
+
+type FeedbackService struct {
+	cfg     config.Config
+	log     logger.LoggerI
+	storage storage.StorageI
+	order_service.UnimplementedFeedbackServiceServer
+}
+
+func NewFeedbackService(cfg config.Config, log logger.LoggerI, strg storage.StorageI) *FeedbackService {
+	return &FeedbackService{
+		cfg:     cfg,
+		log:     log,
+		storage: strg,
+	}
+}
+
+func (b *FeedbackService) Create(ctx context.Context, req *order_service.CreateFeedbackRequest) (*order_service.Feedback, error) {
+	resp, alerts, err := b.storage.Feedback().Create(ctx, req, b.cfg.LowRatingThreshold)
+	if err != nil {
+		b.log.Error("error while creating feedback", logger.Error(err))
+		return nil, err
+	}
+
+	for _, alert := range alerts {
+		b.log.Warn("low rating feedback",
+			logger.String("reason", alert.Reason),
+			logger.String("order_id", alert.OrderId),
+			logger.Int("courier_id", int(alert.CourierId)),
+			logger.Int("branch_id", int(alert.BranchId)),
+		)
+	}
+
+	return resp, nil
+}
+
+func (b *FeedbackService) Get(ctx context.Context, req *order_service.OrderIdRequest) (*order_service.Feedback, error) {
+	resp, err := b.storage.Feedback().Get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (b *FeedbackService) List(ctx context.Context, req *order_service.ListFeedbackRequest) (*order_service.ListFeedbackResponse, error) {
+	resp, err := b.storage.Feedback().GetList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (b *FeedbackService) GetRating(ctx context.Context, req *order_service.RatingRequest) (*order_service.Rating, error) {
+	switch req.SubjectType {
+	case "courier", "branch", "product":
+	default:
+		return nil, errs.InvalidArgument("subject_type must be courier, branch or product")
+	}
+
+	resp, err := b.storage.Feedback().GetRating(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (b *FeedbackService) ListAlerts(ctx context.Context, req *order_service.ListFeedbackAlertsRequest) (*order_service.ListFeedbackAlertsResponse, error) {
+	resp, err := b.storage.Feedback().GetAlertList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"order_service/config"
+	order_service "order_service/genproto"
+	"order_service/pkg/errs"
+	"order_service/pkg/logger"
+	"order_service/storage"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// IdempotentReplayHeader is set in the response header when Create returned
+// the stored result of an earlier call instead of creating an order
+const IdempotentReplayHeader = "idempotent-replayed"
+
+// requestHash fingerprints a create request without its idempotency key
+func requestHash(req *order_service.CreateOrderRequest) (string, error) {
+	clone := proto.Clone(req).(*order_service.CreateOrderRequest)
+	clone.IdempotencyKey = ""
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(clone)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal create order request: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func replayCreate(ctx context.Context, key *storage.IdempotencyKey, record *storage.IdempotencyRecord) (*order_service.Response, error) {
+	if record.RequestHash != key.RequestHash {
+		return nil, errs.InvalidArgument("idempotency key %s was used with a different request", key.Key)
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(IdempotentReplayHeader, "true"))
+
+	// the response is empty if the service stopped right after the order was created
+	message := record.Response
+	if message == "" {
+		message = fmt.Sprintf("created with orderID: %s", record.OrderId)
+	}
+
+	return &order_service.Response{Message: message}, nil
+}
+
+// RunIdempotencyPurge deletes expired idempotency keys every
+// cfg.IdempotencyPurgeInterval until ctx is done.
+func RunIdempotencyPurge(ctx context.Context, cfg config.Config, log logger.LoggerI, strg storage.StorageI) {
+	ticker := time.NewTicker(cfg.IdempotencyPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			count, err := strg.Idempotency().Purge(ctx, cfg.IdempotencyKeyTTL)
+			if err != nil {
+				log.Error("error while purging idempotency keys", logger.Error(err))
+				continue
+			}
+			if count > 0 {
+				log.Info("idempotency keys purged", logger.Any("keys", count))
+			}
+		}
+	}
+}
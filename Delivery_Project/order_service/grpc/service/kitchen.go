@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"order_service/config"
+	order_service "order_service/genproto"
+	"order_service/pkg/hub"
+	"order_service/pkg/logger"
+	"order_service/storage"
+)
+
+type KitchenService struct {
+	cfg     config.Config
+	log     logger.LoggerI
+	storage storage.StorageI
+	kitchen *hub.Hub
+	order_service.UnimplementedKitchenServiceServer
+}
+
+func NewKitchenService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, kitchen *hub.Hub) *KitchenService {
+	return &KitchenService{
+		cfg:     cfg,
+		log:     log,
+		storage: strg,
+		kitchen: kitchen,
+	}
+}
+
+func (s *KitchenService) GetQueue(ctx context.Context, req *order_service.KitchenQueueRequest) (*order_service.KitchenQueue, error) {
+	resp, err := s.storage.Kitchen().GetQueue(ctx, req)
+	if err != nil {
+		s.log.Error("error while getting kitchen queue", logger.Error(err))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *KitchenService) StartPreparing(ctx context.Context, req *order_service.OrderIdRequest) (*order_service.Response, error) {
+	return s.updateStatus(ctx, req.OrderId, "preparing")
+}
+
+func (s *KitchenService) MarkReady(ctx context.Context, req *order_service.OrderIdRequest) (*order_service.Response, error) {
+	return s.updateStatus(ctx, req.OrderId, "ready_in_branch")
+}
+
+func (s *KitchenService) updateStatus(ctx context.Context, orderID, status string) (*order_service.Response, error) {
+	resp, err := s.storage.Order().UpdateStatus(ctx, &order_service.UpdateOrderStatusRequest{
+		OrderId: orderID,
+		Status:  status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publishOrderChange(ctx, s.storage, s.kitchen, s.log, orderID)
+
+	return &order_service.Response{Message: resp}, nil
+}
+
+// WatchQueue keeps the stream open until the client leaves, the queue is
+// read again whenever an order of the branch changes
+func (s *KitchenService) WatchQueue(req *order_service.KitchenQueueRequest, stream order_service.KitchenService_WatchQueueServer) error {
+	updates, unsubscribe := s.kitchen.Subscribe(req.BranchId)
+	defer unsubscribe()
+
+	for {
+		queue, err := s.storage.Kitchen().GetQueue(stream.Context(), req)
+		if err != nil {
+			s.log.Error("error while getting kitchen queue", logger.Error(err))
+			return err
+		}
+
+		if err = stream.Send(queue); err != nil {
+			return err
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-updates:
+		}
+	}
+}
+
+// publishOrderChange wakes up the kitchen screens of the order's branch,
+// a failure only delays the screens until the next change
+func publishOrderChange(ctx context.Context, strg storage.StorageI, kitchen *hub.Hub, log logger.LoggerI, orderID string) {
+	order, err := strg.Order().Get(ctx, &order_service.IdStrRequest{Id: orderID})
+	if err != nil {
+		log.Error("error while getting order for kitchen queue", logger.Error(err))
+		return
+	}
+
+	kitchen.Publish(order.BranchId)
+}
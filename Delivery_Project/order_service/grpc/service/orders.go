@@ -2,38 +2,179 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"order_service/config"
 	order_service "order_service/genproto"
+	user_service "order_service/genproto/user_service"
+	"order_service/pkg/errs"
+	"order_service/pkg/hub"
+	"order_service/pkg/identity"
 	"order_service/pkg/logger"
+	"order_service/pkg/payment"
 	"order_service/storage"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// staffRole is the role of the branch staff, they hand pick-up orders over and
+// take the cash couriers bring back
+const staffRole = "user"
+
 type OrderService struct {
-	cfg     config.Config
-	log     logger.LoggerI
-	storage storage.StorageI
+	cfg      config.Config
+	log      logger.LoggerI
+	storage  storage.StorageI
+	provider payment.Provider
+	bonus    user_service.BonusServiceClient
+	kitchen  *hub.Hub
 	order_service.UnimplementedOrderServiceServer
 }
 
-func NewOrderService(cfg config.Config, log logger.LoggerI, strg storage.StorageI) *OrderService {
+func NewOrderService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, provider payment.Provider, bonus user_service.BonusServiceClient, kitchen *hub.Hub) *OrderService {
 	return &OrderService{
-		cfg:     cfg,
-		log:     log,
-		storage: strg,
+		cfg:      cfg,
+		log:      log,
+		storage:  strg,
+		provider: provider,
+		bonus:    bonus,
+		kitchen:  kitchen,
 	}
 }
 
 func (b *OrderService) Create(ctx context.Context, req *order_service.CreateOrderRequest) (*order_service.Response, error) {
-	id, err := b.storage.Order().Create(context.Background(), req)
+	// a retried create with the same key gets the first response back
+	var key *storage.IdempotencyKey
+	if req.IdempotencyKey != "" {
+		hash, err := requestHash(req)
+		if err != nil {
+			return nil, err
+		}
+		key = &storage.IdempotencyKey{ClientId: req.ClientId, Key: req.IdempotencyKey, RequestHash: hash, TTL: b.cfg.IdempotencyKeyTTL}
+
+		record, err := b.storage.Idempotency().Get(ctx, key)
+		if err == nil {
+			return replayCreate(ctx, key, record)
+		}
+		if !errs.Is(err, errs.CodeNotFound) {
+			return nil, err
+		}
+	}
+
+	switch req.Type {
+	case "", "delivery":
+		req.Type = "delivery"
+		if req.DistanceKm > b.cfg.MaxDeliveryDistanceKm {
+			return nil, errs.InvalidArgument("distance_km must not be more than %g", b.cfg.MaxDeliveryDistanceKm)
+		}
+	case "pick_up":
+		// the client collects the order, so there is nothing to deliver
+		req.CourierId = 0
+		req.DeliveryPrice = 0
+		req.AddressId = 0
+		req.AddressDetails = nil
+	default:
+		return nil, errs.InvalidArgument("order type must be delivery or pick_up")
+	}
+
+	promisedIn, err := b.estimateDelivery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// the points are spent under the order number, a spend that may have gone
+	// through is given back when the order is not created
+	var spentOn string
+	spend := func(ctx context.Context, orderID string) error {
+		spentOn = orderID
+		_, err := b.bonus.Spend(ctx, &user_service.SpendBonusRequest{
+			ClientId: req.ClientId,
+			Amount:   req.BonusPoints,
+			OrderId:  orderID,
+		})
+		return err
+	}
+
+	id, err := b.storage.Order().Create(ctx, req, promisedIn, key, spend)
 	if err != nil {
+		if spentOn != "" {
+			b.refundBonus(ctx, spentOn)
+		}
+		// a concurrent create with the same key won the claim, it is replayed
+		if key != nil && errs.Is(err, errs.CodeConflict) {
+			if record, getErr := b.storage.Idempotency().Get(ctx, key); getErr == nil {
+				return replayCreate(ctx, key, record)
+			}
+		}
 		return nil, err
 	}
 
-	return &order_service.Response{Message: id}, nil
+	b.kitchen.Publish(req.BranchId)
+
+	resp := &order_service.Response{Message: b.createdMessage(ctx, req, id)}
+	if key != nil {
+		if err = b.storage.Idempotency().SaveResponse(ctx, key, resp.Message); err != nil {
+			b.log.Error("error while saving idempotency response", logger.Error(err))
+		}
+	}
+
+	return resp, nil
+}
+
+// refundBonus gives back the points spent on an order that was not created.
+// It outlives the request, as the request is often what failed, and a refund
+// that fails is left to the outbox.
+func (b *OrderService) refundBonus(ctx context.Context, orderID string) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), b.cfg.RPCTimeout)
+	defer cancel()
+
+	_, err := b.bonus.RefundOrder(ctx, &user_service.RefundOrderRequest{OrderId: orderID})
+	if err == nil || status.Code(err) == codes.NotFound {
+		return
+	}
+
+	b.log.Error("error while refunding bonus of order", logger.String("order_id", orderID), logger.Error(err))
+	if err = b.storage.Outbox().Add(ctx, orderID, storage.OutboxRefundBonus); err != nil {
+		b.log.Error("error while queueing bonus refund of order", logger.String("order_id", orderID), logger.Error(err))
+	}
+}
+
+// createdMessage tells the client the order number, and the pickup code or
+// payment url when the order has one
+func (b *OrderService) createdMessage(ctx context.Context, req *order_service.CreateOrderRequest, id string) string {
+	message := fmt.Sprintf("created with orderID: %s", id)
+
+	if req.Type != "pick_up" && req.PaymentType != "card" {
+		return message
+	}
+
+	order, err := b.storage.Order().Get(ctx, &order_service.IdStrRequest{Id: id})
+	if err != nil {
+		b.log.Error("error while getting created order", logger.Error(err))
+		return message
+	}
+
+	if order.PickupCode != "" {
+		message += fmt.Sprintf(", pickup code: %s", order.PickupCode)
+	}
+
+	// the order stays pending if the provider is down, the intent can be
+	// created again through PaymentService.CreateIntent
+	if req.PaymentType == "card" {
+		intent, err := createIntent(ctx, b.storage, b.provider, order, req.ClientPhone)
+		if err != nil {
+			b.log.Error("error while creating payment intent", logger.Error(err))
+		} else {
+			message += fmt.Sprintf(", payment url: %s", intent.PaymentUrl)
+		}
+	}
+
+	return message
 }
 
 func (b *OrderService) Get(ctx context.Context, req *order_service.IdStrRequest) (*order_service.Order, error) {
-	resp, err := b.storage.Order().Get(context.Background(), req)
+	resp, err := b.storage.Order().Get(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +183,7 @@ func (b *OrderService) Get(ctx context.Context, req *order_service.IdStrRequest)
 }
 
 func (b *OrderService) List(ctx context.Context, req *order_service.ListOrderRequest) (*order_service.ListOrderResponse, error) {
-	Orders, err := b.storage.Order().GetList(context.Background(), req)
+	Orders, err := b.storage.Order().GetList(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +193,7 @@ func (b *OrderService) List(ctx context.Context, req *order_service.ListOrderReq
 }
 
 func (s *OrderService) Update(ctx context.Context, req *order_service.UpdateOrderRequest) (*order_service.Response, error) {
-	resp, err := s.storage.Order().Update(context.Background(), req)
+	resp, err := s.storage.Order().Update(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -61,24 +202,44 @@ func (s *OrderService) Update(ctx context.Context, req *order_service.UpdateOrde
 }
 
 func (s *OrderService) UpdateStatus(ctx context.Context, req *order_service.UpdateOrderStatusRequest) (*order_service.Response, error) {
-	resp, err := s.storage.Order().UpdateStatus(context.Background(), req)
+	resp, err := s.storage.Order().UpdateStatus(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
+	publishOrderChange(ctx, s.storage, s.kitchen, s.log, req.OrderId)
+
 	return &order_service.Response{Message: resp}, nil
 }
 
 func (s *OrderService) Delete(ctx context.Context, req *order_service.IdRequest) (*order_service.Response, error) {
-	resp, err := s.storage.Order().Delete(context.Background(), req)
+	resp, err := s.storage.Order().Delete(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
 	return &order_service.Response{Message: resp}, nil
 }
+
+func (s *OrderService) Restore(ctx context.Context, req *order_service.IdRequest) (*order_service.Response, error) {
+	resp, err := s.storage.Order().Restore(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &order_service.Response{Message: resp}, nil
+}
+
+func (s *OrderService) ListDeleted(ctx context.Context, req *order_service.ListDeletedRequest) (*order_service.ListOrderResponse, error) {
+	resp, err := s.storage.Order().GetDeletedList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
 func (b *OrderService) GetOrderStatus(ctx context.Context, req *order_service.OrderIdRequest) (*order_service.OrderStatusResponse, error) {
-	resp, err := b.storage.Order().GetOrderStatus(context.Background(), req)
+	resp, err := b.storage.Order().GetOrderStatus(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +247,7 @@ func (b *OrderService) GetOrderStatus(ctx context.Context, req *order_service.Or
 	return resp, nil
 }
 func (b *OrderService) GetAllAcceptableOrders(ctx context.Context, req *order_service.IdRequest) (*order_service.Order, error) {
-	resp, err := b.storage.Order().GetAllAcceptableOrders(context.Background(), req)
+	resp, err := b.storage.Order().GetAllAcceptableOrders(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -94,10 +255,68 @@ func (b *OrderService) GetAllAcceptableOrders(ctx context.Context, req *order_se
 	return resp, nil
 }
 func (b *OrderService) GetAllAcceptedOrders(ctx context.Context, req *order_service.IdRequest) (*order_service.Order, error) {
-	resp, err := b.storage.Order().GetAllAcceptedOrders(context.Background(), req)
+	resp, err := b.storage.Order().GetAllAcceptedOrders(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// estimateDelivery returns how long after creation the order is promised to the
+// client: branch preparation time, time to clear the current queue and the trip itself
+func (b *OrderService) estimateDelivery(ctx context.Context, req *order_service.CreateOrderRequest) (time.Duration, error) {
+	queue, err := b.storage.Order().GetQueueLength(ctx, req.BranchId)
+	if err != nil {
+		b.log.Error("error while getting branch queue length", logger.Error(err))
+		return 0, err
+	}
+
+	preparation := req.PreparationMinutes
+	if preparation <= 0 {
+		preparation = b.cfg.EtaDefaultPreparationMinutes
+	}
+
+	minutes := float64(preparation) + float64(queue*b.cfg.EtaMinutesPerQueuedOrder)
+	if req.Type != "pick_up" {
+		minutes += req.DistanceKm * b.cfg.EtaMinutesPerKm
+	}
+
+	return time.Duration(minutes * float64(time.Minute)), nil
+}
+
+func (b *OrderService) ListLateOrders(ctx context.Context, req *order_service.ListLateOrdersRequest) (*order_service.ListOrderResponse, error) {
+	resp, err := b.storage.Order().GetLateList(ctx, req)
 	if err != nil {
+		b.log.Error("error while getting late orders", logger.Error(err))
 		return nil, err
 	}
 
 	return resp, nil
 }
+
+// CompletePickup finishes a pick-up order once branch staff verified the client's
+// code, staff bound to a branch can only hand over the orders of that branch
+func (b *OrderService) CompletePickup(ctx context.Context, req *order_service.CompletePickupRequest) (*order_service.Response, error) {
+	caller, ok := identity.FromContext(ctx)
+	if !ok || caller.Role != staffRole {
+		return nil, errs.PermissionDenied("only branch staff can hand over pick-up orders")
+	}
+
+	if caller.BranchID != 0 {
+		order, err := b.storage.Order().Get(ctx, &order_service.IdStrRequest{Id: req.OrderId})
+		if err != nil {
+			return nil, err
+		}
+		if order.BranchId != caller.BranchID {
+			return nil, errs.PermissionDenied("order with ID %s belongs to another branch", req.OrderId)
+		}
+	}
+
+	resp, err := b.storage.Order().CompletePickup(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &order_service.Response{Message: resp}, nil
+}
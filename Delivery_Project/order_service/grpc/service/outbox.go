@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"order_service/config"
+	order_service "order_service/genproto"
+	user_service "order_service/genproto/user_service"
+	"order_service/pkg/logger"
+	"order_service/storage"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// outboxBatch is how many jobs one tick takes on
+const outboxBatch = 100
+
+// RunOutbox makes the user service calls orders owe every cfg.OutboxInterval
+// until ctx is done. The calls are idempotent per order, so a job that went
+// through but was not deleted is simply made again.
+func RunOutbox(ctx context.Context, cfg config.Config, log logger.LoggerI, strg storage.StorageI, bonus user_service.BonusServiceClient, clients user_service.ClientServiceClient) {
+	ticker := time.NewTicker(cfg.OutboxInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			jobs, err := strg.Outbox().Due(ctx, outboxBatch)
+			if err != nil {
+				log.Error("error while getting outbox jobs", logger.Error(err))
+				continue
+			}
+
+			for _, job := range jobs {
+				callCtx, cancel := context.WithTimeout(ctx, cfg.RPCTimeout)
+				err = runOutboxJob(callCtx, strg, bonus, clients, job)
+				cancel()
+
+				if err != nil {
+					log.Error("error while running outbox job", logger.String("order_id", job.OrderID), logger.String("kind", job.Kind), logger.Error(err))
+					if err = strg.Outbox().Retry(ctx, job, outboxBackoff(cfg.OutboxInterval, job.Attempts), err); err != nil {
+						log.Error("error while putting off outbox job", logger.Error(err))
+					}
+					continue
+				}
+
+				if err = strg.Outbox().Done(ctx, job); err != nil {
+					log.Error("error while deleting outbox job", logger.Error(err))
+				}
+			}
+		}
+	}
+}
+
+func runOutboxJob(ctx context.Context, strg storage.StorageI, bonus user_service.BonusServiceClient, clients user_service.ClientServiceClient, job *storage.OutboxJob) error {
+	switch job.Kind {
+	case storage.OutboxFinish:
+		order, err := strg.Order().GetWithDeleted(ctx, &order_service.IdStrRequest{Id: job.OrderID})
+		if err != nil {
+			return err
+		}
+
+		_, err = clients.UpdateOrder(ctx, &user_service.UpdateClientsOrderRequest{
+			Id:               order.ClientId,
+			TotalOrdersCount: 1,
+			TotalOrdersSum:   order.Price,
+			OrderId:          order.OrderId,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update client statistics: %w", err)
+		}
+
+		_, err = bonus.Accrue(ctx, &user_service.AccrueBonusRequest{
+			ClientId: order.ClientId,
+			OrderId:  order.OrderId,
+			OrderSum: order.Price,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to accrue bonus points: %w", err)
+		}
+
+		return nil
+	case storage.OutboxRefundBonus:
+		_, err := bonus.RefundOrder(ctx, &user_service.RefundOrderRequest{OrderId: job.OrderID})
+		// nothing was spent on the order
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+		return err
+	default:
+		return fmt.Errorf("unknown outbox job kind: %s", job.Kind)
+	}
+}
+
+// outboxBackoff doubles the wait after each failed attempt, up to an hour
+func outboxBackoff(interval time.Duration, attempts int32) time.Duration {
+	wait := interval
+	for i := int32(0); i < attempts && wait < time.Hour; i++ {
+		wait *= 2
+	}
+
+	return min(wait, time.Hour)
+}
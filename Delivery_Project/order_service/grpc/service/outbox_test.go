@@ -0,0 +1,28 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOutboxBackoff(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempts int32
+		want     time.Duration
+	}{
+		{name: "first try", attempts: 0, want: 10 * time.Second},
+		{name: "second try", attempts: 1, want: 20 * time.Second},
+		{name: "fifth try", attempts: 4, want: 160 * time.Second},
+		{name: "capped", attempts: 9, want: time.Hour},
+		{name: "many attempts", attempts: 1000, want: time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := outboxBackoff(10*time.Second, tt.attempts); got != tt.want {
+				t.Errorf("outboxBackoff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"order_service/config"
+	order_service "order_service/genproto"
+	"order_service/pkg/errs"
+	"order_service/pkg/hub"
+	"order_service/pkg/logger"
+	"order_service/pkg/payment"
+	"order_service/storage"
+)
+
+type PaymentService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	storage  storage.StorageI
+	provider payment.Provider
+	kitchen  *hub.Hub
+	order_service.UnimplementedPaymentServiceServer
+}
+
+func NewPaymentService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, provider payment.Provider, kitchen *hub.Hub) *PaymentService {
+	return &PaymentService{
+		cfg:      cfg,
+		log:      log,
+		storage:  strg,
+		provider: provider,
+		kitchen:  kitchen,
+	}
+}
+
+// CreateIntent starts a new payment for a card order, e.g. when the first
+// attempt failed while the order was created
+func (s *PaymentService) CreateIntent(ctx context.Context, req *order_service.OrderIdRequest) (*order_service.Payment, error) {
+	order, err := s.storage.Order().Get(ctx, &order_service.IdStrRequest{Id: req.OrderId})
+	if err != nil {
+		return nil, err
+	}
+
+	return createIntent(ctx, s.storage, s.provider, order, "")
+}
+
+func (s *PaymentService) Get(ctx context.Context, req *order_service.OrderIdRequest) (*order_service.Payment, error) {
+	resp, err := s.storage.Payment().Get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// HandleWebhook never fails on a bad callback: the provider gets its own
+// error reply in the body, so it can tell rejected payments from outages
+func (s *PaymentService) HandleWebhook(ctx context.Context, req *order_service.WebhookRequest) (*order_service.WebhookResponse, error) {
+	if req.Provider != s.provider.Name() {
+		return nil, errs.NotFound("payment provider %s is not configured", req.Provider)
+	}
+
+	event, err := s.provider.ParseWebhook(req.Payload, req.Signature)
+	if err == nil {
+		err = s.applyEvent(ctx, event)
+	}
+	if err != nil {
+		s.log.Error("payment webhook rejected", logger.Error(err))
+	}
+
+	body, contentType := s.provider.Ack(event, err)
+	return &order_service.WebhookResponse{Body: body, ContentType: contentType}, nil
+}
+
+func (s *PaymentService) applyEvent(ctx context.Context, event *payment.Event) error {
+	current, err := s.storage.Payment().Get(ctx, &order_service.OrderIdRequest{OrderId: event.OrderID})
+	if err != nil {
+		return payment.ErrPaymentNotFound
+	}
+
+	if math.Abs(current.Amount-event.Amount) >= 0.01 {
+		return payment.ErrAmountMismatch
+	}
+
+	// providers retry webhooks, a repeated one only gets acknowledged again
+	if current.Status == event.Status {
+		return nil
+	}
+	if err = settledError(current.Status); err != nil {
+		return err
+	}
+
+	// prepare-like events only check that the payment can be accepted
+	if event.Status == payment.StatusPending {
+		return nil
+	}
+
+	err = s.storage.Payment().UpdateStatus(ctx, event.OrderID, event.Status)
+	if errs.Is(err, errs.CodeFailedPrecondition) {
+		// a concurrent webhook settled the payment after it was read above
+		current, getErr := s.storage.Payment().Get(ctx, &order_service.OrderIdRequest{OrderId: event.OrderID})
+		if getErr != nil {
+			return err
+		}
+		if current.Status == event.Status {
+			return nil
+		}
+		if settled := settledError(current.Status); settled != nil {
+			return settled
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	// paid card orders join the kitchen queue
+	publishOrderChange(ctx, s.storage, s.kitchen, s.log, event.OrderID)
+
+	return nil
+}
+
+// settledError tells the provider why a paid or cancelled payment can not move
+func settledError(status string) error {
+	switch status {
+	case payment.StatusPaid:
+		return payment.ErrAlreadyPaid
+	case payment.StatusCancelled:
+		return payment.ErrPaymentCancelled
+	}
+
+	return nil
+}
+
+func createIntent(ctx context.Context, strg storage.StorageI, provider payment.Provider, order *order_service.Order, phone string) (*order_service.Payment, error) {
+	if order.PaymentType != "card" {
+		return nil, errs.FailedPrecondition("order %s is not paid by card", order.OrderId)
+	}
+
+	amount := order.Price + order.DeliveryPrice
+	intent, err := provider.CreateIntent(ctx, payment.IntentRequest{
+		OrderID: order.OrderId,
+		Amount:  amount,
+		Phone:   phone,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create payment intent: %w", err)
+	}
+
+	return strg.Payment().Create(ctx, &order_service.Payment{
+		OrderId:    order.OrderId,
+		Provider:   provider.Name(),
+		ExternalId: intent.ExternalID,
+		Amount:     amount,
+		PaymentUrl: intent.PaymentURL,
+	})
+}
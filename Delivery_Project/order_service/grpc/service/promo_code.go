@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"order_service/config"
+	order_service "order_service/genproto"
+	"order_service/pkg/logger"
+	"order_service/storage"
+)
+
+type PromoCodeService struct {
+	cfg     config.Config
+	log     logger.LoggerI
+	storage storage.StorageI
+	order_service.UnimplementedPromoCodeServiceServer
+}
+
+func NewPromoCodeService(cfg config.Config, log logger.LoggerI, strg storage.StorageI) *PromoCodeService {
+	return &PromoCodeService{
+		cfg:     cfg,
+		log:     log,
+		storage: strg,
+	}
+}
+
+func (b *PromoCodeService) Create(ctx context.Context, req *order_service.CreatePromoCodeRequest) (*order_service.Response, error) {
+	id, err := b.storage.PromoCode().Create(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &order_service.Response{Message: id}, nil
+}
+
+func (b *PromoCodeService) Get(ctx context.Context, req *order_service.IdRequest) (*order_service.PromoCode, error) {
+	resp, err := b.storage.PromoCode().Get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (b *PromoCodeService) List(ctx context.Context, req *order_service.ListPromoCodeRequest) (*order_service.ListPromoCodeResponse, error) {
+	PromoCodes, err := b.storage.PromoCode().GetList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &order_service.ListPromoCodeResponse{PromoCodes: PromoCodes.PromoCodes,
+		Count: PromoCodes.Count}, nil
+}
+
+func (s *PromoCodeService) Update(ctx context.Context, req *order_service.UpdatePromoCodeRequest) (*order_service.Response, error) {
+	resp, err := s.storage.PromoCode().Update(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &order_service.Response{Message: resp}, nil
+}
+
+func (s *PromoCodeService) Delete(ctx context.Context, req *order_service.IdRequest) (*order_service.Response, error) {
+	resp, err := s.storage.PromoCode().Delete(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &order_service.Response{Message: resp}, nil
+}
+
+func (s *PromoCodeService) ApplyPromo(ctx context.Context, req *order_service.ApplyPromoRequest) (*order_service.ApplyPromoResponse, error) {
+	resp, err := s.storage.PromoCode().Apply(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"order_service/config"
+	"order_service/pkg/logger"
+	"order_service/storage"
+)
+
+// RunTrashPurge purges rows soft deleted longer than cfg.TrashRetention ago
+// every cfg.TrashPurgeInterval until ctx is done. It does nothing without a
+// retention, purged rows can not be restored.
+func RunTrashPurge(ctx context.Context, cfg config.Config, log logger.LoggerI, strg storage.StorageI) {
+	if cfg.TrashRetention <= 0 {
+		log.Info("trash purge is off, set TRASH_RETENTION to turn it on")
+		return
+	}
+
+	purges := []struct {
+		entity string
+		purge  func(context.Context, time.Duration) (int64, error)
+	}{
+		{"orders", strg.Order().Purge},
+		{"delivery_tarif", strg.DeliveryTariff().Purge},
+	}
+
+	ticker := time.NewTicker(cfg.TrashPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, p := range purges {
+				count, err := p.purge(ctx, cfg.TrashRetention)
+				if err != nil {
+					log.Error("error while purging deleted rows", logger.String("entity", p.entity), logger.Error(err))
+					continue
+				}
+				if count > 0 {
+					log.Info("deleted rows purged", logger.String("entity", p.entity), logger.Any("rows", count))
+				}
+			}
+		}
+	}
+}
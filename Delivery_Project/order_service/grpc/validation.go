@@ -0,0 +1,236 @@
+package grpc
+
+import (
+	"fmt"
+	"time"
+
+	order_service "order_service/genproto"
+	"order_service/pkg/helper"
+	"order_service/pkg/validator"
+)
+
+var (
+	orderTypes    = []string{"delivery", "pick_up"}
+	paymentTypes  = []string{"cash", "card"}
+	orderStatuses = []string{"accepted", "courier_accepted", "preparing", "ready_in_branch", "on_way", "finished", "cancelled"}
+	tariffTypes   = []string{"fixed", "alternative"}
+	discountTypes = []string{"percent", "fixed"}
+
+	// promo codes accept a date or a full timestamp
+	timestampLayouts = []string{time.DateOnly, time.DateTime, time.RFC3339}
+	clockLayouts     = []string{"15:04", time.TimeOnly}
+
+	// the audit log is filtered by a day or an exact time
+	auditLayouts = []string{time.DateOnly, time.DateTime}
+)
+
+// validationRules declares what a valid create/update request looks like,
+// the interceptor rejects anything else before it reaches storage
+func validationRules() *validator.Registry {
+	r := validator.New()
+
+	validator.Register(r, func(req *order_service.CreateOrderRequest, v *validator.Violations) {
+		v.RequiredID("client_id", req.ClientId)
+		v.RequiredID("branch_id", req.BranchId)
+		if req.Type != "" {
+			v.OneOf("type", req.Type, orderTypes...)
+		}
+		if req.Type != "pick_up" && req.Address == "" && req.AddressId == 0 {
+			v.Add("address", "is required for delivery orders")
+		}
+		v.OneOf("payment_type", req.PaymentType, paymentTypes...)
+		v.NotNegative("price", req.Price)
+		v.NotNegative("delivery_price", req.DeliveryPrice)
+		v.NotNegative("discount", req.Discount)
+		v.NotNegative("bonus_points", req.BonusPoints)
+		v.NotNegative("distance_km", req.DistanceKm)
+		// the distance pays the courier, an order without a delivery address has none
+		if req.Type == "pick_up" && req.DistanceKm != 0 {
+			v.Add("distance_km", "must not be set for pick-up orders")
+		}
+		v.NotNegative("preparation_minutes", float64(req.PreparationMinutes))
+		v.Phone("client_phone", req.ClientPhone)
+		if len(req.Products) == 0 {
+			v.Add("products", "at least one product is required")
+		}
+		for i, p := range req.Products {
+			orderProduct(v, fmt.Sprintf("products[%d]", i), p)
+		}
+		if a := req.AddressDetails; a != nil {
+			coordinates(v, "address_details.", a.Latitude, a.Longitude)
+		}
+		if len(req.IdempotencyKey) > 128 {
+			v.Add("idempotency_key", "must not be longer than 128 characters")
+		}
+	})
+
+	validator.Register(r, func(req *order_service.UpdateOrderRequest, v *validator.Violations) {
+		if req.Id == 0 && req.OrderId == "" {
+			v.Add("id", "id or order_id is required")
+		}
+		v.RequiredID("client_id", req.ClientId)
+		v.RequiredID("branch_id", req.BranchId)
+		v.OneOf("type", req.Type, orderTypes...)
+		v.OneOf("payment_type", req.PaymentType, paymentTypes...)
+		v.OneOf("status", req.Status, orderStatuses...)
+		v.NotNegative("price", req.Price)
+		v.NotNegative("delivery_price", req.DeliveryPrice)
+		v.NotNegative("discount", req.Discount)
+	})
+
+	validator.Register(r, func(req *order_service.UpdateOrderStatusRequest, v *validator.Violations) {
+		v.Required("order_id", req.OrderId)
+		v.OneOf("status", req.Status, orderStatuses...)
+	})
+
+	validator.Register(r, func(req *order_service.CompletePickupRequest, v *validator.Violations) {
+		v.Required("order_id", req.OrderId)
+		v.Required("pickup_code", req.PickupCode)
+	})
+
+	validator.Register(r, func(req *order_service.CreateDeliveryTariffRequest, v *validator.Violations) {
+		deliveryTariff(v, req.Name, req.TariffType, req.BasePrice, req.Values)
+	})
+
+	validator.Register(r, func(req *order_service.UpdateDeliveryTariffRequest, v *validator.Violations) {
+		v.RequiredID("id", req.Id)
+		deliveryTariff(v, req.Name, req.TariffType, req.BasePrice, req.Values)
+	})
+
+	validator.Register(r, func(req *order_service.CreatePromoCodeRequest, v *validator.Violations) {
+		promoCode(v, req.Code, req.DiscountType, req.DiscountValue, req.MinOrderSum, req.ValidFrom, req.ValidTo, req.UsageLimit, req.PerClientLimit)
+	})
+
+	validator.Register(r, func(req *order_service.UpdatePromoCodeRequest, v *validator.Violations) {
+		v.RequiredID("id", req.Id)
+		promoCode(v, req.Code, req.DiscountType, req.DiscountValue, req.MinOrderSum, req.ValidFrom, req.ValidTo, req.UsageLimit, req.PerClientLimit)
+	})
+
+	validator.Register(r, func(req *order_service.CreateCompensationSchemeRequest, v *validator.Violations) {
+		compensationScheme(v, req.Name, req.PerDeliveryFee, req.DeliveryPricePercent, req.DistanceBonusFromKm, req.DistanceBonusPerKm, req.PeakHours)
+	})
+
+	validator.Register(r, func(req *order_service.UpdateCompensationSchemeRequest, v *validator.Violations) {
+		v.RequiredID("id", req.Id)
+		compensationScheme(v, req.Name, req.PerDeliveryFee, req.DeliveryPricePercent, req.DistanceBonusFromKm, req.DistanceBonusPerKm, req.PeakHours)
+	})
+
+	validator.Register(r, func(req *order_service.CreateFeedbackRequest, v *validator.Violations) {
+		v.Required("order_id", req.OrderId)
+		v.RequiredID("client_id", req.ClientId)
+		// 0 leaves the courier unrated
+		if req.CourierRating != 0 {
+			v.Range("courier_rating", float64(req.CourierRating), 1, 5)
+		}
+		v.Range("food_rating", float64(req.FoodRating), 1, 5)
+	})
+
+	validator.Register(r, func(req *order_service.CashHandoverRequest, v *validator.Violations) {
+		v.RequiredID("courier_id", req.CourierId)
+		v.RequiredID("branch_id", req.BranchId)
+		v.Positive("amount", req.Amount)
+	})
+
+	validator.Register(r, func(req *order_service.ListAuditRequest, v *validator.Violations) {
+		v.Range("limit", float64(req.Limit), 0, 1000)
+		v.NotNegative("page", float64(req.Page))
+		from, okFrom := v.Time("from", req.From, auditLayouts...)
+		to, okTo := v.Time("to", req.To, auditLayouts...)
+		if okFrom && okTo && to.Before(from) {
+			v.Add("to", "must not be before from")
+		}
+	})
+
+	validator.Register(r, func(req *order_service.ListOrderRequest, v *validator.Violations) {
+		listCursor(v, req.Cursor)
+		for i, status := range req.Statuses {
+			v.OneOf(fmt.Sprintf("statuses[%d]", i), status, orderStatuses...)
+		}
+	})
+
+	// a zero client_id would match the orders of every client
+	validator.Register(r, func(req *order_service.ClientIdRequest, v *validator.Violations) {
+		v.RequiredID("client_id", req.ClientId)
+	})
+
+	return r
+}
+
+func orderProduct(v *validator.Violations, field string, p *order_service.OrderProducts) {
+	v.RequiredID(field+".product_id", p.ProductId)
+	v.Positive(field+".quantity", float64(p.Quantity))
+	v.NotNegative(field+".price", p.Price)
+}
+
+func coordinates(v *validator.Violations, prefix string, latitude, longitude float64) {
+	v.Range(prefix+"latitude", latitude, -90, 90)
+	v.Range(prefix+"longitude", longitude, -180, 180)
+}
+
+func deliveryTariff(v *validator.Violations, name, tariffType string, basePrice float64, values *order_service.DeliveryTariffValues) {
+	v.Required("name", name)
+	v.OneOf("tariff_type", tariffType, tariffTypes...)
+	v.NotNegative("base_price", basePrice)
+
+	if tariffType != "alternative" {
+		return
+	}
+	if values == nil {
+		v.Add("values", "is required for alternative tariffs")
+		return
+	}
+	v.NotNegative("values.from_price", values.FromPrice)
+	v.NotNegative("values.price", values.Price)
+	if values.ToPrice <= values.FromPrice {
+		v.Add("values.to_price", "must be greater than from_price")
+	}
+}
+
+func promoCode(v *validator.Violations, code, discountType string, discountValue, minOrderSum float64, validFrom, validTo string, usageLimit, perClientLimit int32) {
+	v.Required("code", code)
+	v.OneOf("discount_type", discountType, discountTypes...)
+	v.Positive("discount_value", discountValue)
+	if discountType == "percent" && discountValue > 100 {
+		v.Add("discount_value", "must not exceed 100 percent")
+	}
+	v.NotNegative("min_order_sum", minOrderSum)
+	v.NotNegative("usage_limit", float64(usageLimit))
+	v.NotNegative("per_client_limit", float64(perClientLimit))
+
+	from, okFrom := v.Time("valid_from", validFrom, timestampLayouts...)
+	to, okTo := v.Time("valid_to", validTo, timestampLayouts...)
+	if okFrom && okTo && !to.After(from) {
+		v.Add("valid_to", "must be after valid_from")
+	}
+}
+
+func compensationScheme(v *validator.Violations, name string, perDeliveryFee, deliveryPricePercent, distanceBonusFromKm, distanceBonusPerKm float64, peakHours []*order_service.PeakHour) {
+	v.Required("name", name)
+	v.NotNegative("per_delivery_fee", perDeliveryFee)
+	v.Range("delivery_price_percent", deliveryPricePercent, 0, 100)
+	v.NotNegative("distance_bonus_from_km", distanceBonusFromKm)
+	v.NotNegative("distance_bonus_per_km", distanceBonusPerKm)
+
+	// a window may wrap midnight, so only equal bounds are rejected
+	for i, p := range peakHours {
+		field := fmt.Sprintf("peak_hours[%d]", i)
+		v.Required(field+".from", p.From)
+		v.Required(field+".to", p.To)
+		from, okFrom := v.Time(field+".from", p.From, clockLayouts...)
+		to, okTo := v.Time(field+".to", p.To, clockLayouts...)
+		if okFrom && okTo && from.Equal(to) {
+			v.Add(field+".to", "must differ from from")
+		}
+		v.Positive(field+".multiplier", p.Multiplier)
+	}
+}
+
+// listCursor checks that cursor is empty or a next_cursor of a list
+func listCursor(v *validator.Violations, cursor string) {
+	if cursor == "" {
+		return
+	}
+	if _, _, err := helper.DecodeCursor(cursor); err != nil {
+		v.Add("cursor", "must be the next_cursor of a previous page")
+	}
+}
@@ -0,0 +1,123 @@
+package grpc
+
+import (
+	"reflect"
+	"testing"
+
+	order_service "order_service/genproto"
+	"order_service/pkg/helper"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+// violatedFields lists the fields a validation error complains about
+func violatedFields(err error) []string {
+	var fields []string
+	for _, d := range status.Convert(err).Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+
+	return fields
+}
+
+func TestValidationRules(t *testing.T) {
+	order := func(change func(req *order_service.CreateOrderRequest)) *order_service.CreateOrderRequest {
+		req := &order_service.CreateOrderRequest{
+			ClientId:    9,
+			BranchId:    3,
+			Type:        "delivery",
+			Address:     "Chilonzor 9",
+			PaymentType: "cash",
+			Price:       120000,
+			Products:    []*order_service.OrderProducts{{ProductId: 1, Quantity: 2, Price: 60000}},
+		}
+		if change != nil {
+			change(req)
+		}
+		return req
+	}
+
+	tests := []struct {
+		name       string
+		req        interface{}
+		wantFields []string
+	}{
+		{name: "order delivery", req: order(nil)},
+		{
+			name: "order pick up without address",
+			req: order(func(req *order_service.CreateOrderRequest) {
+				req.Type, req.Address = "pick_up", ""
+			}),
+		},
+		{
+			name: "order pick up with distance",
+			req: order(func(req *order_service.CreateOrderRequest) {
+				req.Type, req.Address, req.DistanceKm = "pick_up", "", 4.5
+			}),
+			wantFields: []string{"distance_km"},
+		},
+		{
+			name: "order delivery without address",
+			req: order(func(req *order_service.CreateOrderRequest) {
+				req.Address = ""
+			}),
+			wantFields: []string{"address"},
+		},
+		{
+			name: "order without products",
+			req: order(func(req *order_service.CreateOrderRequest) {
+				req.Products = nil
+			}),
+			wantFields: []string{"products"},
+		},
+		{
+			name: "order product without quantity",
+			req: order(func(req *order_service.CreateOrderRequest) {
+				req.Products = append(req.Products, &order_service.OrderProducts{ProductId: 2})
+			}),
+			wantFields: []string{"products[1].quantity"},
+		},
+		{
+			name: "order unknown payment type",
+			req: order(func(req *order_service.CreateOrderRequest) {
+				req.PaymentType = "crypto"
+			}),
+			wantFields: []string{"payment_type"},
+		},
+		{name: "list first page", req: &order_service.ListOrderRequest{}},
+		{
+			name: "list next page",
+			req:  &order_service.ListOrderRequest{Cursor: helper.EncodeCursor("2024-03-01T10:15:30Z", 12)},
+		},
+		{name: "list forged cursor", req: &order_service.ListOrderRequest{Cursor: "page-2"}, wantFields: []string{"cursor"}},
+		{name: "list statuses", req: &order_service.ListOrderRequest{Statuses: []string{"accepted", "on_way"}}},
+		{
+			name:       "list unknown status",
+			req:        &order_service.ListOrderRequest{Statuses: []string{"accepted", "lost"}},
+			wantFields: []string{"statuses[1]"},
+		},
+		{name: "feedback", req: &order_service.CreateFeedbackRequest{OrderId: "003-240101-0001", ClientId: 9, CourierRating: 5, FoodRating: 4}},
+		{name: "feedback without courier rating", req: &order_service.CreateFeedbackRequest{OrderId: "003-240101-0001", ClientId: 9, FoodRating: 4}},
+		{
+			name:       "feedback courier rating out of range",
+			req:        &order_service.CreateFeedbackRequest{OrderId: "003-240101-0001", ClientId: 9, CourierRating: 6, FoodRating: 4},
+			wantFields: []string{"courier_rating"},
+		},
+		{name: "client orders without client", req: &order_service.ClientIdRequest{}, wantFields: []string{"client_id"}},
+	}
+
+	rules := validationRules()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := rules.Validate(tt.req)
+			if got := violatedFields(err); !reflect.DeepEqual(got, tt.wantFields) {
+				t.Errorf("Validate() = %v, want violations of %v", err, tt.wantFields)
+			}
+		})
+	}
+}
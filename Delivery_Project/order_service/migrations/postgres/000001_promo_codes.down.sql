@@ -0,0 +1,5 @@
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "promo_code_id";
+
+DROP TABLE IF EXISTS "order_products";
+DROP TABLE IF EXISTS "promo_code_redemptions";
+DROP TABLE IF EXISTS "promo_codes";
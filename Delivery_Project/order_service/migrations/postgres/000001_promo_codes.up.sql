@@ -0,0 +1,44 @@
+CREATE TABLE IF NOT EXISTS "promo_codes" (
+    "id" SERIAL PRIMARY KEY,
+    "code" VARCHAR(64) NOT NULL,
+    "discount_type" VARCHAR(16) NOT NULL CHECK ("discount_type" IN ('percent', 'fixed')),
+    "discount_value" NUMERIC NOT NULL CHECK ("discount_value" > 0),
+    "min_order_sum" NUMERIC NOT NULL DEFAULT 0,
+    "valid_from" TIMESTAMP,
+    "valid_to" TIMESTAMP,
+    "usage_limit" INT NOT NULL DEFAULT 0,
+    "per_client_limit" INT NOT NULL DEFAULT 0,
+    "used_count" INT NOT NULL DEFAULT 0,
+    "branch_ids" INT[] NOT NULL DEFAULT '{}',
+    "category_ids" INT[] NOT NULL DEFAULT '{}',
+    "active" BOOLEAN NOT NULL DEFAULT TRUE,
+    "created_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+    "updated_at" TIMESTAMP,
+    "deleted_at" TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "promo_codes_code_uidx" ON "promo_codes" (UPPER("code")) WHERE "deleted_at" IS NULL;
+
+CREATE TABLE IF NOT EXISTS "promo_code_redemptions" (
+    "id" SERIAL PRIMARY KEY,
+    "promo_code_id" INT NOT NULL REFERENCES "promo_codes" ("id"),
+    "client_id" INT NOT NULL,
+    "order_id" VARCHAR(64) NOT NULL,
+    "discount" NUMERIC NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS "promo_code_redemptions_client_idx" ON "promo_code_redemptions" ("promo_code_id", "client_id");
+
+CREATE TABLE IF NOT EXISTS "order_products" (
+    "id" SERIAL PRIMARY KEY,
+    "order_id" VARCHAR(64) NOT NULL,
+    "product_id" INT NOT NULL,
+    "category_id" INT NOT NULL DEFAULT 0,
+    "quantity" INT NOT NULL CHECK ("quantity" > 0),
+    "price" NUMERIC NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS "order_products_order_idx" ON "order_products" ("order_id");
+
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS "promo_code_id" INT REFERENCES "promo_codes" ("id");
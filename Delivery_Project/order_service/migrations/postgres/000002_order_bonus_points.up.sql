@@ -0,0 +1 @@
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS "bonus_points" NUMERIC NOT NULL DEFAULT 0;
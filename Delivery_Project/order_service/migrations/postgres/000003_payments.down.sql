@@ -0,0 +1,3 @@
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "payment_status";
+
+DROP TABLE IF EXISTS "payments";
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS "payments" (
+    "id" SERIAL PRIMARY KEY,
+    "order_id" VARCHAR(64) NOT NULL,
+    "provider" VARCHAR(32) NOT NULL,
+    "external_id" VARCHAR(128) NOT NULL DEFAULT '',
+    "amount" NUMERIC NOT NULL,
+    "status" VARCHAR(16) NOT NULL DEFAULT 'pending' CHECK ("status" IN ('pending', 'paid', 'failed', 'cancelled')),
+    "payment_url" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+    "updated_at" TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "payments_order_uidx" ON "payments" ("order_id");
+
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS "payment_status" VARCHAR(16) NOT NULL DEFAULT 'not_required';
@@ -0,0 +1,15 @@
+-- amount is signed: collected cash adds to what the courier owes, hand-overs subtract
+CREATE TABLE IF NOT EXISTS "courier_cash_transactions" (
+    "id" SERIAL PRIMARY KEY,
+    "courier_id" INT NOT NULL,
+    "branch_id" INT NOT NULL,
+    "type" VARCHAR(16) NOT NULL CHECK ("type" IN ('collected', 'handover')),
+    "amount" NUMERIC NOT NULL,
+    "order_id" VARCHAR(64) NOT NULL DEFAULT '',
+    "received_by" INT NOT NULL DEFAULT 0,
+    "note" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS "courier_cash_transactions_courier_idx" ON "courier_cash_transactions" ("courier_id", "created_at");
+CREATE UNIQUE INDEX IF NOT EXISTS "courier_cash_transactions_order_uidx" ON "courier_cash_transactions" ("order_id") WHERE "type" = 'collected';
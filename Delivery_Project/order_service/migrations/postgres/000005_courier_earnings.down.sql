@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "courier_earnings";
+DROP TABLE IF EXISTS "compensation_peak_hours";
+DROP TABLE IF EXISTS "compensation_schemes";
+
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "distance_km";
@@ -0,0 +1,45 @@
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS "distance_km" NUMERIC NOT NULL DEFAULT 0;
+
+-- branch_id 0 is the default scheme for branches without their own
+CREATE TABLE IF NOT EXISTS "compensation_schemes" (
+    "id" SERIAL PRIMARY KEY,
+    "name" VARCHAR(128) NOT NULL,
+    "branch_id" INT NOT NULL DEFAULT 0,
+    "per_delivery_fee" NUMERIC NOT NULL DEFAULT 0,
+    "delivery_price_percent" NUMERIC NOT NULL DEFAULT 0 CHECK ("delivery_price_percent" BETWEEN 0 AND 100),
+    "distance_bonus_from_km" NUMERIC NOT NULL DEFAULT 0,
+    "distance_bonus_per_km" NUMERIC NOT NULL DEFAULT 0,
+    "active" BOOLEAN NOT NULL DEFAULT TRUE,
+    "created_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+    "updated_at" TIMESTAMP,
+    "deleted_at" TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "compensation_schemes_branch_uidx" ON "compensation_schemes" ("branch_id") WHERE "active" AND "deleted_at" IS NULL;
+
+-- a window may wrap midnight, e.g. 22:00 - 02:00
+CREATE TABLE IF NOT EXISTS "compensation_peak_hours" (
+    "id" SERIAL PRIMARY KEY,
+    "scheme_id" INT NOT NULL REFERENCES "compensation_schemes" ("id") ON DELETE CASCADE,
+    "from_time" TIME NOT NULL,
+    "to_time" TIME NOT NULL,
+    "multiplier" NUMERIC NOT NULL CHECK ("multiplier" > 0)
+);
+
+CREATE TABLE IF NOT EXISTS "courier_earnings" (
+    "id" SERIAL PRIMARY KEY,
+    "order_id" VARCHAR(64) NOT NULL,
+    "courier_id" INT NOT NULL,
+    "branch_id" INT NOT NULL,
+    "scheme_id" INT NOT NULL REFERENCES "compensation_schemes" ("id"),
+    "delivery_fee" NUMERIC NOT NULL,
+    "delivery_share" NUMERIC NOT NULL,
+    "distance_km" NUMERIC NOT NULL,
+    "distance_bonus" NUMERIC NOT NULL,
+    "multiplier" NUMERIC NOT NULL,
+    "amount" NUMERIC NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "courier_earnings_order_uidx" ON "courier_earnings" ("order_id");
+CREATE INDEX IF NOT EXISTS "courier_earnings_courier_idx" ON "courier_earnings" ("courier_id", "created_at");
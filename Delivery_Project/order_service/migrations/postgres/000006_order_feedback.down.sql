@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "feedback_alerts";
+DROP TABLE IF EXISTS "rating_aggregates";
+DROP TABLE IF EXISTS "order_feedback";
@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS "order_feedback" (
+    "id" SERIAL PRIMARY KEY,
+    "order_id" VARCHAR(64) NOT NULL,
+    "client_id" INT NOT NULL,
+    "courier_id" INT NOT NULL DEFAULT 0,
+    "branch_id" INT NOT NULL,
+    "courier_rating" INT NOT NULL CHECK ("courier_rating" BETWEEN 1 AND 5),
+    "food_rating" INT NOT NULL CHECK ("food_rating" BETWEEN 1 AND 5),
+    "comment" TEXT NOT NULL DEFAULT '',
+    "tags" TEXT[] NOT NULL DEFAULT '{}',
+    "created_at" TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- a client rates an order once
+CREATE UNIQUE INDEX IF NOT EXISTS "order_feedback_order_uidx" ON "order_feedback" ("order_id");
+CREATE INDEX IF NOT EXISTS "order_feedback_courier_idx" ON "order_feedback" ("courier_id", "created_at");
+CREATE INDEX IF NOT EXISTS "order_feedback_branch_idx" ON "order_feedback" ("branch_id", "created_at");
+
+-- couriers are rated by courier_rating, branches and products by food_rating
+CREATE TABLE IF NOT EXISTS "rating_aggregates" (
+    "subject_type" VARCHAR(16) NOT NULL CHECK ("subject_type" IN ('courier', 'branch', 'product')),
+    "subject_id" INT NOT NULL,
+    "ratings_count" INT NOT NULL DEFAULT 0,
+    "ratings_sum" INT NOT NULL DEFAULT 0,
+    "updated_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY ("subject_type", "subject_id")
+);
+
+CREATE TABLE IF NOT EXISTS "feedback_alerts" (
+    "id" SERIAL PRIMARY KEY,
+    "feedback_id" INT NOT NULL REFERENCES "order_feedback" ("id"),
+    "order_id" VARCHAR(64) NOT NULL,
+    "courier_id" INT NOT NULL DEFAULT 0,
+    "branch_id" INT NOT NULL,
+    "reason" VARCHAR(32) NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT NOW()
+);
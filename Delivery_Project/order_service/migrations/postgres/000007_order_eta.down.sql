@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS "orders_promised_at_idx";
+DROP TABLE IF EXISTS "order_status_history";
+
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "promised_at";
@@ -0,0 +1,13 @@
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS "promised_at" TIMESTAMP;
+
+-- every status an order passes through, used for SLA tracking
+CREATE TABLE IF NOT EXISTS "order_status_history" (
+    "id" SERIAL PRIMARY KEY,
+    "order_id" VARCHAR(64) NOT NULL,
+    "status" VARCHAR(32) NOT NULL,
+    "changed_at" TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS "order_status_history_order_idx" ON "order_status_history" ("order_id", "changed_at");
+
+CREATE INDEX IF NOT EXISTS "orders_promised_at_idx" ON "orders" ("promised_at") WHERE "status" <> 'finished' AND "deleted_at" IS NULL;
@@ -0,0 +1,2 @@
+-- empty for delivery orders
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS "pickup_code" VARCHAR(8) NOT NULL DEFAULT '';
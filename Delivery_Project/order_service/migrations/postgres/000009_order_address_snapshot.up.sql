@@ -0,0 +1,3 @@
+-- the saved client address is copied, so editing it later keeps the order unchanged
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS "address_id" INT;
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS "address_snapshot" JSONB;
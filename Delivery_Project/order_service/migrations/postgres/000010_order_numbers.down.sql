@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS "orders_order_id_uidx";
+DROP TABLE IF EXISTS "order_number_counters";
@@ -0,0 +1,24 @@
+-- last order number handed out per branch and day. The counter row is bumped
+-- by a single upsert outside the order's transaction, so numbers never repeat
+-- across replicas, but the number of an order that fails to be created is
+-- skipped and the sequence can have gaps
+CREATE TABLE IF NOT EXISTS "order_number_counters" (
+    "branch_id" INT NOT NULL,
+    "day" DATE NOT NULL,
+    "last_number" INT NOT NULL DEFAULT 0,
+    PRIMARY KEY ("branch_id", "day")
+);
+
+-- the old six-digit ids came from a per-process counter and could repeat, the
+-- oldest order keeps its id and later copies get a -N suffix so the unique
+-- index can be built. Rows of other tables keyed by a repeated id stay with
+-- the oldest order, they can not be told apart anymore
+UPDATE "orders" AS o
+SET "order_id" = o."order_id" || '-' || d."copy"
+FROM (
+    SELECT "id", ROW_NUMBER() OVER (PARTITION BY "order_id" ORDER BY "created_at", "id") - 1 AS "copy"
+    FROM "orders"
+) AS d
+WHERE d."id" = o."id" AND d."copy" > 0;
+
+CREATE UNIQUE INDEX IF NOT EXISTS "orders_order_id_uidx" ON "orders" ("order_id");
@@ -0,0 +1,11 @@
+-- keys of created orders, a retried create with the same key and request gets
+-- the stored response back, rows older than the retention window are purged
+CREATE TABLE IF NOT EXISTS "idempotency_keys" (
+    "key" VARCHAR(128) PRIMARY KEY,
+    "request_hash" CHAR(64) NOT NULL,
+    "order_id" VARCHAR(64) NOT NULL,
+    "response" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS "idempotency_keys_created_at_idx" ON "idempotency_keys" ("created_at");
@@ -0,0 +1,4 @@
+ALTER TABLE "compensation_schemes" DROP COLUMN IF EXISTS "updated_by", DROP COLUMN IF EXISTS "created_by";
+ALTER TABLE "promo_codes" DROP COLUMN IF EXISTS "updated_by", DROP COLUMN IF EXISTS "created_by";
+ALTER TABLE "delivery_tarif" DROP COLUMN IF EXISTS "updated_by", DROP COLUMN IF EXISTS "created_by";
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "updated_by", DROP COLUMN IF EXISTS "created_by";
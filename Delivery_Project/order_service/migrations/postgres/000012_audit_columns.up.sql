@@ -0,0 +1,16 @@
+-- who created and last updated a row, as role:id of the caller, see pkg/identity
+ALTER TABLE "orders"
+    ADD COLUMN IF NOT EXISTS "created_by" VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS "updated_by" VARCHAR(64);
+
+ALTER TABLE "delivery_tarif"
+    ADD COLUMN IF NOT EXISTS "created_by" VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS "updated_by" VARCHAR(64);
+
+ALTER TABLE "promo_codes"
+    ADD COLUMN IF NOT EXISTS "created_by" VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS "updated_by" VARCHAR(64);
+
+ALTER TABLE "compensation_schemes"
+    ADD COLUMN IF NOT EXISTS "created_by" VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS "updated_by" VARCHAR(64);
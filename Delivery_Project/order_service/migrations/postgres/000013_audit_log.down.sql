@@ -0,0 +1,10 @@
+DROP TRIGGER IF EXISTS "orders_audit" ON "orders";
+DROP TRIGGER IF EXISTS "order_products_audit" ON "order_products";
+DROP TRIGGER IF EXISTS "delivery_tarif_audit" ON "delivery_tarif";
+DROP TRIGGER IF EXISTS "promo_codes_audit" ON "promo_codes";
+DROP TRIGGER IF EXISTS "compensation_schemes_audit" ON "compensation_schemes";
+DROP TRIGGER IF EXISTS "payments_audit" ON "payments";
+DROP TRIGGER IF EXISTS "order_feedback_audit" ON "order_feedback";
+DROP TRIGGER IF EXISTS "courier_cash_transactions_audit" ON "courier_cash_transactions";
+DROP FUNCTION IF EXISTS audit_changes();
+DROP TABLE IF EXISTS "audit_log";
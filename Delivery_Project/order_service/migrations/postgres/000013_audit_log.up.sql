@@ -0,0 +1,109 @@
+-- every insert, update and delete of the audited tables, see audit_changes.
+-- actor and request_id come from the app.actor and app.request_id settings the
+-- storage sets on each acquired connection, NULL for internal changes
+CREATE TABLE IF NOT EXISTS "audit_log" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "entity" VARCHAR(64) NOT NULL,
+    "entity_id" VARCHAR(64) NOT NULL DEFAULT '',
+    "action" VARCHAR(16) NOT NULL,
+    "actor" VARCHAR(64),
+    "request_id" VARCHAR(128),
+    "before" JSONB,
+    "after" JSONB,
+    "created_at" TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS "audit_log_entity_idx" ON "audit_log" ("entity", "entity_id", "created_at");
+CREATE INDEX IF NOT EXISTS "audit_log_actor_idx" ON "audit_log" ("actor", "created_at");
+CREATE INDEX IF NOT EXISTS "audit_log_created_at_idx" ON "audit_log" ("created_at");
+
+-- updates keep only the changed columns, updates that change nothing are not
+-- logged and setting deleted_at is logged as a delete. passwords never get in
+CREATE OR REPLACE FUNCTION audit_changes() RETURNS TRIGGER AS $$
+DECLARE
+    old_row JSONB;
+    new_row JSONB;
+    before_row JSONB;
+    after_row JSONB;
+    change VARCHAR(16);
+    col TEXT;
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        old_row := to_jsonb(OLD) - 'password';
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        new_row := to_jsonb(NEW) - 'password';
+    END IF;
+
+    IF TG_OP = 'INSERT' THEN
+        change := 'create';
+        after_row := new_row;
+    ELSIF TG_OP = 'DELETE' THEN
+        change := 'delete';
+        before_row := old_row;
+    ELSE
+        before_row := '{}';
+        after_row := '{}';
+        FOR col IN SELECT jsonb_object_keys(new_row) LOOP
+            IF new_row -> col IS DISTINCT FROM old_row -> col THEN
+                before_row := before_row || jsonb_build_object(col, old_row -> col);
+                after_row := after_row || jsonb_build_object(col, new_row -> col);
+            END IF;
+        END LOOP;
+
+        IF after_row = '{}' THEN
+            RETURN NULL;
+        END IF;
+
+        change := 'update';
+        IF old_row ->> 'deleted_at' IS NULL AND new_row ->> 'deleted_at' IS NOT NULL THEN
+            change := 'delete';
+        END IF;
+    END IF;
+
+    INSERT INTO "audit_log" ("entity", "entity_id", "action", "actor", "request_id", "before", "after")
+    VALUES (
+        TG_TABLE_NAME,
+        COALESCE(COALESCE(new_row, old_row) ->> 'id', ''),
+        change,
+        NULLIF(current_setting('app.actor', true), ''),
+        NULLIF(current_setting('app.request_id', true), ''),
+        before_row,
+        after_row
+    );
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS "orders_audit" ON "orders";
+CREATE TRIGGER "orders_audit" AFTER INSERT OR UPDATE OR DELETE ON "orders"
+    FOR EACH ROW EXECUTE FUNCTION audit_changes();
+
+DROP TRIGGER IF EXISTS "order_products_audit" ON "order_products";
+CREATE TRIGGER "order_products_audit" AFTER INSERT OR UPDATE OR DELETE ON "order_products"
+    FOR EACH ROW EXECUTE FUNCTION audit_changes();
+
+DROP TRIGGER IF EXISTS "delivery_tarif_audit" ON "delivery_tarif";
+CREATE TRIGGER "delivery_tarif_audit" AFTER INSERT OR UPDATE OR DELETE ON "delivery_tarif"
+    FOR EACH ROW EXECUTE FUNCTION audit_changes();
+
+DROP TRIGGER IF EXISTS "promo_codes_audit" ON "promo_codes";
+CREATE TRIGGER "promo_codes_audit" AFTER INSERT OR UPDATE OR DELETE ON "promo_codes"
+    FOR EACH ROW EXECUTE FUNCTION audit_changes();
+
+DROP TRIGGER IF EXISTS "compensation_schemes_audit" ON "compensation_schemes";
+CREATE TRIGGER "compensation_schemes_audit" AFTER INSERT OR UPDATE OR DELETE ON "compensation_schemes"
+    FOR EACH ROW EXECUTE FUNCTION audit_changes();
+
+DROP TRIGGER IF EXISTS "payments_audit" ON "payments";
+CREATE TRIGGER "payments_audit" AFTER INSERT OR UPDATE OR DELETE ON "payments"
+    FOR EACH ROW EXECUTE FUNCTION audit_changes();
+
+DROP TRIGGER IF EXISTS "order_feedback_audit" ON "order_feedback";
+CREATE TRIGGER "order_feedback_audit" AFTER INSERT OR UPDATE OR DELETE ON "order_feedback"
+    FOR EACH ROW EXECUTE FUNCTION audit_changes();
+
+DROP TRIGGER IF EXISTS "courier_cash_transactions_audit" ON "courier_cash_transactions";
+CREATE TRIGGER "courier_cash_transactions_audit" AFTER INSERT OR UPDATE OR DELETE ON "courier_cash_transactions"
+    FOR EACH ROW EXECUTE FUNCTION audit_changes();
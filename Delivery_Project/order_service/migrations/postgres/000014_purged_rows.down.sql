@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "orders_deleted_at_idx";
+ALTER TABLE "delivery_tarif" DROP COLUMN IF EXISTS "purged_at";
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "purged_at";
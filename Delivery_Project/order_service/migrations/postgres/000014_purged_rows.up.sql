@@ -0,0 +1,7 @@
+-- rows deleted longer than the retention ago are purged. Orders are kept for
+-- reports with the client's address scrubbed and tariffs are kept because
+-- branches of user_service refer to them, purged_at marks both
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS "purged_at" TIMESTAMP;
+ALTER TABLE "delivery_tarif" ADD COLUMN IF NOT EXISTS "purged_at" TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS "orders_deleted_at_idx" ON "orders" ("deleted_at") WHERE "deleted_at" IS NOT NULL AND "purged_at" IS NULL;
@@ -0,0 +1 @@
+ALTER TABLE "delivery_tarif" ADD COLUMN IF NOT EXISTS "purged_at" TIMESTAMP;
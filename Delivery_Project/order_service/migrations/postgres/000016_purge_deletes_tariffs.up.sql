@@ -0,0 +1,3 @@
+-- purged tariffs are deleted now, orders keep the delivery price they were
+-- charged, see tariffRepo.Purge
+ALTER TABLE "delivery_tarif" DROP COLUMN IF EXISTS "purged_at";
@@ -0,0 +1,2 @@
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "pickup_locked_until";
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "pickup_attempts";
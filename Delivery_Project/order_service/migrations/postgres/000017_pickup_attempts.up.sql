@@ -0,0 +1,3 @@
+-- wrong pick-up codes in a row, too many lock the order for a while
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS "pickup_attempts" SMALLINT NOT NULL DEFAULT 0;
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS "pickup_locked_until" TIMESTAMP;
@@ -0,0 +1,15 @@
+-- user service calls an order still owes: the statistics and bonus accrual of
+-- a finished order, the refund of points spent on an order that was not
+-- created. Rows are written with the change that owes the call and deleted
+-- once user service took it, see service.RunOutbox
+CREATE TABLE IF NOT EXISTS "order_outbox" (
+    "order_id" VARCHAR NOT NULL,
+    "kind" VARCHAR NOT NULL,
+    "attempts" INT NOT NULL DEFAULT 0,
+    "last_error" TEXT NOT NULL DEFAULT '',
+    "run_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+    "created_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY ("order_id", "kind")
+);
+
+CREATE INDEX IF NOT EXISTS "order_outbox_run_at_idx" ON "order_outbox" ("run_at");
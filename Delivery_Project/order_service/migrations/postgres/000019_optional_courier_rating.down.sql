@@ -0,0 +1,3 @@
+ALTER TABLE "order_feedback" DROP CONSTRAINT IF EXISTS "order_feedback_courier_rating_check";
+ALTER TABLE "order_feedback" ADD CONSTRAINT "order_feedback_courier_rating_check"
+    CHECK ("courier_rating" BETWEEN 1 AND 5) NOT VALID;
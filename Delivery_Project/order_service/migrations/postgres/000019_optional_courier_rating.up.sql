@@ -0,0 +1,5 @@
+-- courier_rating is 0 when the client does not rate the courier or the order
+-- had none
+ALTER TABLE "order_feedback" DROP CONSTRAINT IF EXISTS "order_feedback_courier_rating_check";
+ALTER TABLE "order_feedback" ADD CONSTRAINT "order_feedback_courier_rating_check"
+    CHECK ("courier_rating" = 0 OR "courier_rating" BETWEEN 1 AND 5);
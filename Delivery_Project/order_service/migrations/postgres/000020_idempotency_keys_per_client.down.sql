@@ -0,0 +1,8 @@
+-- keys of different clients may collide, only the newest one is kept
+DELETE FROM "idempotency_keys" AS k
+USING "idempotency_keys" AS newer
+WHERE newer."key" = k."key" AND (newer."created_at", newer."client_id") > (k."created_at", k."client_id");
+
+ALTER TABLE "idempotency_keys" DROP CONSTRAINT IF EXISTS "idempotency_keys_pkey";
+ALTER TABLE "idempotency_keys" ADD PRIMARY KEY ("key");
+ALTER TABLE "idempotency_keys" DROP COLUMN IF EXISTS "client_id";
@@ -0,0 +1,10 @@
+-- idempotency keys are chosen by clients, so each client has its own keys
+ALTER TABLE "idempotency_keys" ADD COLUMN IF NOT EXISTS "client_id" INT NOT NULL DEFAULT 0;
+
+UPDATE "idempotency_keys" AS k
+SET "client_id" = o."client_id"
+FROM "orders" AS o
+WHERE o."order_id" = k."order_id";
+
+ALTER TABLE "idempotency_keys" DROP CONSTRAINT IF EXISTS "idempotency_keys_pkey";
+ALTER TABLE "idempotency_keys" ADD PRIMARY KEY ("client_id", "key");
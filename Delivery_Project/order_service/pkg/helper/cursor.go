@@ -0,0 +1,44 @@
+package helper
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// errCursor is returned for cursors that were not made by EncodeCursor
+var errCursor = errors.New("malformed cursor")
+
+// EncodeCursor makes the opaque cursor of a row of a list ordered by
+// created_at and id newest first, the next page starts after that row.
+// createdAt is the row's created_at as it is scanned, in RFC 3339.
+func EncodeCursor(createdAt string, id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(createdAt + "," + strconv.FormatInt(id, 10)))
+}
+
+// DecodeCursor returns the created_at and id of the row a cursor was made for
+func DecodeCursor(cursor string) (time.Time, int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, 0, errCursor
+	}
+
+	createdAt, id, ok := strings.Cut(string(raw), ",")
+	if !ok {
+		return time.Time{}, 0, errCursor
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return time.Time{}, 0, errCursor
+	}
+
+	n, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return time.Time{}, 0, errCursor
+	}
+
+	return t, n, nil
+}
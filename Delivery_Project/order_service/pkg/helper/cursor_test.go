@@ -0,0 +1,63 @@
+package helper
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		createdAt string
+		id        int64
+	}{
+		{name: "utc", createdAt: "2024-03-01T10:15:30Z", id: 1},
+		{name: "microseconds", createdAt: "2024-03-01T10:15:30.123456Z", id: 42},
+		{name: "offset", createdAt: "2024-03-01T15:15:30.5+05:00", id: 9007199254740993},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := time.Parse(time.RFC3339Nano, tt.createdAt)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			createdAt, id, err := DecodeCursor(EncodeCursor(tt.createdAt, tt.id))
+			if err != nil {
+				t.Fatalf("DecodeCursor: %v", err)
+			}
+			if !createdAt.Equal(want) || id != tt.id {
+				t.Errorf("DecodeCursor() = %v, %d, want %v, %d", createdAt, id, want, tt.id)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorMalformed(t *testing.T) {
+	encode := func(raw string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(raw))
+	}
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{name: "not base64", cursor: "not a cursor!"},
+		{name: "padded base64", cursor: base64.URLEncoding.EncodeToString([]byte("2024-03-01T10:15:30Z,1"))},
+		{name: "no separator", cursor: encode("2024-03-01T10:15:30Z")},
+		{name: "bad time", cursor: encode("yesterday,1")},
+		{name: "date only", cursor: encode("2024-03-01,1")},
+		{name: "bad id", cursor: encode("2024-03-01T10:15:30Z,one")},
+		{name: "empty id", cursor: encode("2024-03-01T10:15:30Z,")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := DecodeCursor(tt.cursor); err != errCursor {
+				t.Errorf("DecodeCursor() error = %v, want %v", err, errCursor)
+			}
+		})
+	}
+}
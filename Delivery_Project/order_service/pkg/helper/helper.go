@@ -1,12 +1,11 @@
 package helper
 
 import (
+	"crypto/rand"
 	"fmt"
-	"math/rand"
+	"math/big"
 	"strconv"
 	"strings"
-	"sync"
-	"time"
 )
 
 func ReplaceQueryParams(namedQuery string, params map[string]interface{}) (string, []interface{}) {
@@ -26,34 +25,15 @@ func ReplaceQueryParams(namedQuery string, params map[string]interface{}) (strin
 	return namedQuery, args
 }
 
-var (
-	counter int
-	mutex   sync.Mutex
-	rnd     *rand.Rand
-)
-
-func init() {
-	rnd = rand.New(rand.NewSource(time.Now().UnixNano()))
-}
-
-// GenerateUniqueID generates a unique six-digit ID as a string
-func GenerateUniqueID() string {
-	mutex.Lock()
-	defer mutex.Unlock()
+// pickupCodeRange is the number of six-digit pick-up codes
+var pickupCodeRange = big.NewInt(1000000)
 
-	// Increment the counter
-	counter++
-
-	// Reset the counter if it exceeds 999,999
-	if counter > 999999 {
-		counter = 1
+// GeneratePickupCode generates a six-digit code the client shows in the branch
+func GeneratePickupCode() (string, error) {
+	n, err := rand.Int(rand.Reader, pickupCodeRange)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate pick-up code: %w", err)
 	}
 
-	// Generate a random number between 0 and 9999 (inclusive)
-	randomNumber := rnd.Intn(10000)
-
-	// Combine the counter with the random number to form the ID
-	id := fmt.Sprintf("%06d", counter*10000+randomNumber)
-
-	return id
+	return fmt.Sprintf("%06d", n.Int64()), nil
 }
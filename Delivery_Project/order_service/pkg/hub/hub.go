@@ -0,0 +1,59 @@
+package hub
+
+import "sync"
+
+// Hub notifies in-process subscribers that something changed for a branch.
+// Notifications carry no data and are coalesced: a subscriber that is busy
+// gets a single pending notification however many changes happened meanwhile,
+// so it should re-read the current state when woken up.
+type Hub struct {
+	mu     sync.Mutex
+	nextID int
+	subs   map[int32]map[int]chan struct{}
+}
+
+// New ...
+func New() *Hub {
+	return &Hub{
+		subs: make(map[int32]map[int]chan struct{}),
+	}
+}
+
+// Subscribe returns a channel receiving notifications for the branch and a
+// function that must be called to stop them
+func (h *Hub) Subscribe(branchID int32) (<-chan struct{}, func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.nextID++
+	id := h.nextID
+	ch := make(chan struct{}, 1)
+
+	if h.subs[branchID] == nil {
+		h.subs[branchID] = make(map[int]chan struct{})
+	}
+	h.subs[branchID][id] = ch
+
+	return ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+
+		delete(h.subs[branchID], id)
+		if len(h.subs[branchID]) == 0 {
+			delete(h.subs, branchID)
+		}
+	}
+}
+
+// Publish wakes up every subscriber of the branch without blocking
+func (h *Hub) Publish(branchID int32) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, ch := range h.subs[branchID] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package identity
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"order_service/pkg/errs"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey carries the identity the gateway signed for the authenticated
+// caller, as base64url(json) "." base64url(hmac-sha256 of the json)
+const MetadataKey = "x-identity"
+
+// requestIDKey is the metadata key of the request id, the identity is only
+// valid for the request it was signed for
+const requestIDKey = "x-request-id"
+
+// Identity is the authenticated caller of an RPC
+type Identity struct {
+	UserID    int32  `json:"user_id"`
+	Role      string `json:"role"`
+	BranchID  int32  `json:"branch_id"`
+	RequestID string `json:"request_id"`
+	IssuedAt  int64  `json:"iat"`
+}
+
+// Subject names the caller in audit fields, users and couriers are kept in
+// different tables so the role is part of it
+func (i Identity) Subject() string {
+	return fmt.Sprintf("%s:%d", i.Role, i.UserID)
+}
+
+type contextKey struct{}
+
+// FromContext returns the identity of the caller, false for anonymous calls
+func FromContext(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(contextKey{}).(Identity)
+	return id, ok
+}
+
+// Verifier checks the identities signed by the gateway
+type Verifier struct {
+	secret []byte
+	maxAge time.Duration
+}
+
+func NewVerifier(secret string, maxAge time.Duration) *Verifier {
+	return &Verifier{secret: []byte(secret), maxAge: maxAge}
+}
+
+// withIdentity puts the identity of the call into ctx. Calls without one are
+// anonymous, a forged, stale or replayed identity is rejected.
+func (v *Verifier) withIdentity(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(MetadataKey)) == 0 {
+		return ctx, nil
+	}
+
+	id, err := v.verify(md.Get(MetadataKey)[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if requestIDs := md.Get(requestIDKey); len(requestIDs) == 0 || requestIDs[0] != id.RequestID {
+		return nil, errs.Unauthenticated("identity was signed for another request")
+	}
+
+	return context.WithValue(ctx, contextKey{}, id), nil
+}
+
+func (v *Verifier) verify(value string) (Identity, error) {
+	var id Identity
+
+	payload, signature, ok := strings.Cut(value, ".")
+	if !ok {
+		return id, errs.Unauthenticated("malformed identity")
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return id, errs.Unauthenticated("malformed identity")
+	}
+	sum, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return id, errs.Unauthenticated("malformed identity")
+	}
+
+	mac := hmac.New(sha256.New, v.secret)
+	mac.Write(data)
+	if !hmac.Equal(sum, mac.Sum(nil)) {
+		return id, errs.Unauthenticated("invalid identity signature")
+	}
+
+	if err := json.Unmarshal(data, &id); err != nil {
+		return id, errs.Unauthenticated("malformed identity")
+	}
+
+	if age := time.Since(time.Unix(id.IssuedAt, 0)); age > v.maxAge || age < -v.maxAge {
+		return id, errs.Unauthenticated("identity is expired")
+	}
+
+	return id, nil
+}
+
+// serverStream replaces the context of a stream
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// UnaryServerInterceptor makes the identity of the caller available through FromContext
+func (v *Verifier) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := v.withIdentity(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is UnaryServerInterceptor for streaming calls
+func (v *Verifier) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := v.withIdentity(ss.Context())
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
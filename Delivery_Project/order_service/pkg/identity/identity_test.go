@@ -0,0 +1,122 @@
+package identity
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"order_service/pkg/errs"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const testSecret = "test-secret"
+
+// sign encodes id the way the gateway's identity.Sign does
+func sign(t *testing.T, id Identity, secret string) string {
+	t.Helper()
+
+	data, err := json.Marshal(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(data)
+
+	return base64.RawURLEncoding.EncodeToString(data) + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Now().Unix()
+	valid := Identity{UserID: 7, Role: "user", BranchID: 3, RequestID: "req-1", IssuedAt: now}
+
+	tampered := sign(t, valid, testSecret)
+	payload, signature, _ := strings.Cut(tampered, ".")
+	forged, _ := json.Marshal(Identity{UserID: 1, Role: "user", RequestID: "req-1", IssuedAt: now})
+	tampered = base64.RawURLEncoding.EncodeToString(forged) + "." + signature
+
+	tests := []struct {
+		name    string
+		value   string
+		want    Identity
+		wantErr string
+	}{
+		{name: "valid", value: sign(t, valid, testSecret), want: valid},
+		{name: "wrong secret", value: sign(t, valid, "other-secret"), wantErr: "invalid identity signature"},
+		{name: "tampered payload", value: tampered, wantErr: "invalid identity signature"},
+		{name: "no signature", value: payload, wantErr: "malformed identity"},
+		{name: "payload not base64", value: "!!." + signature, wantErr: "malformed identity"},
+		{name: "signature not base64", value: payload + ".!!", wantErr: "malformed identity"},
+		{
+			name:    "expired",
+			value:   sign(t, Identity{UserID: 7, Role: "user", IssuedAt: now - 120}, testSecret),
+			wantErr: "identity is expired",
+		},
+		{
+			name:    "issued in the future",
+			value:   sign(t, Identity{UserID: 7, Role: "user", IssuedAt: now + 120}, testSecret),
+			wantErr: "identity is expired",
+		},
+	}
+
+	v := NewVerifier(testSecret, time.Minute)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := v.verify(tt.value)
+			if tt.wantErr != "" {
+				if !errs.Is(err, errs.CodeUnauthenticated) || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("verify() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("verify: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("verify() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithIdentity(t *testing.T) {
+	id := Identity{UserID: 7, Role: "courier", RequestID: "req-1", IssuedAt: time.Now().Unix()}
+	token := sign(t, id, testSecret)
+
+	tests := []struct {
+		name      string
+		md        metadata.MD
+		wantID    bool
+		wantError bool
+	}{
+		{name: "anonymous", md: metadata.Pairs(requestIDKey, "req-1")},
+		{name: "signed for the request", md: metadata.Pairs(MetadataKey, token, requestIDKey, "req-1"), wantID: true},
+		{name: "replayed on another request", md: metadata.Pairs(MetadataKey, token, requestIDKey, "req-2"), wantError: true},
+		{name: "no request id", md: metadata.Pairs(MetadataKey, token), wantError: true},
+		{name: "forged", md: metadata.Pairs(MetadataKey, sign(t, id, "other-secret"), requestIDKey, "req-1"), wantError: true},
+	}
+
+	v := NewVerifier(testSecret, time.Minute)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := v.withIdentity(metadata.NewIncomingContext(context.Background(), tt.md))
+			if (err != nil) != tt.wantError {
+				t.Fatalf("withIdentity() error = %v, want error %v", err, tt.wantError)
+			}
+			if err != nil {
+				return
+			}
+
+			got, ok := FromContext(ctx)
+			if ok != tt.wantID || (ok && got != id) {
+				t.Errorf("FromContext() = %+v, %v, want %+v, %v", got, ok, id, tt.wantID)
+			}
+		})
+	}
+}
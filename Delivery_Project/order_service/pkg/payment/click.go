@@ -0,0 +1,211 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Click error codes used in webhook replies
+const (
+	clickOK               = 0
+	clickSignFailed       = -1
+	clickIncorrectAmount  = -2
+	clickActionNotFound   = -3
+	clickAlreadyPaid      = -4
+	clickOrderNotFound    = -5
+	clickTransactionError = -7
+	clickCancelled        = -9
+)
+
+// Click talks to the Click merchant API: invoices are created over HTTP and
+// payment results arrive as Prepare/Complete callbacks signed with md5.
+type Click struct {
+	cfg    Config
+	client *http.Client
+}
+
+// NewClick ...
+func NewClick(cfg Config) *Click {
+	if cfg.ClickBaseURL == "" {
+		cfg.ClickBaseURL = "https://api.click.uz"
+	}
+
+	return &Click{
+		cfg:    cfg,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type clickInvoiceRequest struct {
+	ServiceID       int     `json:"service_id"`
+	Amount          float64 `json:"amount"`
+	PhoneNumber     string  `json:"phone_number"`
+	MerchantTransID string  `json:"merchant_trans_id"`
+}
+
+type clickInvoiceResponse struct {
+	ErrorCode int    `json:"error_code"`
+	ErrorNote string `json:"error_note"`
+	InvoiceID int64  `json:"invoice_id"`
+}
+
+func (p *Click) Name() string {
+	return "click"
+}
+
+func (p *Click) CreateIntent(ctx context.Context, req IntentRequest) (*Intent, error) {
+	body, err := json.Marshal(clickInvoiceRequest{
+		ServiceID:       p.cfg.ClickServiceID,
+		Amount:          req.Amount,
+		PhoneNumber:     req.Phone,
+		MerchantTransID: req.OrderID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.cfg.ClickBaseURL+"/v2/merchant/invoice/create", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Accept", "application/json")
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Auth", p.authHeader(time.Now()))
+
+	resp, err := p.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("click invoice request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var invoice clickInvoiceResponse
+	if err = json.NewDecoder(resp.Body).Decode(&invoice); err != nil {
+		return nil, fmt.Errorf("click invoice response: %w", err)
+	}
+	if invoice.ErrorCode != clickOK {
+		return nil, fmt.Errorf("click invoice error %d: %s", invoice.ErrorCode, invoice.ErrorNote)
+	}
+
+	payURL := url.Values{}
+	payURL.Set("service_id", strconv.Itoa(p.cfg.ClickServiceID))
+	payURL.Set("merchant_id", strconv.Itoa(p.cfg.ClickMerchantID))
+	payURL.Set("amount", strconv.FormatFloat(req.Amount, 'f', 2, 64))
+	payURL.Set("transaction_param", req.OrderID)
+
+	return &Intent{
+		ExternalID: strconv.FormatInt(invoice.InvoiceID, 10),
+		PaymentURL: "https://my.click.uz/services/pay?" + payURL.Encode(),
+		Status:     StatusPending,
+	}, nil
+}
+
+// ParseWebhook handles Prepare (action=0) and Complete (action=1) callbacks.
+// Click signs the form fields itself, so the signature argument is not used.
+func (p *Click) ParseWebhook(payload []byte, signature string) (*Event, error) {
+	form, err := url.ParseQuery(string(payload))
+	if err != nil {
+		return nil, ErrInvalidPayload
+	}
+
+	raw := make(map[string]string, len(form))
+	for k := range form {
+		raw[k] = form.Get(k)
+	}
+
+	action := raw["action"]
+	if action != "0" && action != "1" {
+		return &Event{Raw: raw}, ErrInvalidPayload
+	}
+
+	sign := raw["click_trans_id"] + raw["service_id"] + p.cfg.ClickSecretKey + raw["merchant_trans_id"]
+	if action == "1" {
+		sign += raw["merchant_prepare_id"]
+	}
+	sign += raw["amount"] + action + raw["sign_time"]
+
+	sum := md5.Sum([]byte(sign))
+	if subtle.ConstantTimeCompare([]byte(hex.EncodeToString(sum[:])), []byte(raw["sign_string"])) != 1 {
+		return &Event{Raw: raw}, ErrInvalidSignature
+	}
+
+	amount, err := strconv.ParseFloat(raw["amount"], 64)
+	if err != nil {
+		return &Event{Raw: raw}, ErrInvalidPayload
+	}
+
+	status := StatusPending
+	if action == "1" {
+		status = StatusPaid
+		if clickErr, _ := strconv.Atoi(raw["error"]); clickErr < 0 {
+			status = StatusCancelled
+		}
+	}
+
+	return &Event{
+		ExternalID: raw["click_trans_id"],
+		OrderID:    raw["merchant_trans_id"],
+		Amount:     amount,
+		Status:     status,
+		Raw:        raw,
+	}, nil
+}
+
+func (p *Click) Ack(event *Event, err error) ([]byte, string) {
+	resp := map[string]interface{}{
+		"error":      clickOK,
+		"error_note": "Success",
+	}
+
+	if event != nil {
+		resp["click_trans_id"] = event.Raw["click_trans_id"]
+		resp["merchant_trans_id"] = event.Raw["merchant_trans_id"]
+		if event.Raw["action"] == "1" {
+			resp["merchant_confirm_id"] = event.Raw["merchant_trans_id"]
+		} else {
+			resp["merchant_prepare_id"] = event.Raw["merchant_trans_id"]
+		}
+	}
+
+	if err != nil {
+		resp["error"] = clickErrorCode(err)
+		resp["error_note"] = err.Error()
+	}
+
+	body, _ := json.Marshal(resp)
+	return body, "application/json"
+}
+
+func (p *Click) authHeader(now time.Time) string {
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	digest := sha1.Sum([]byte(timestamp + p.cfg.ClickSecretKey))
+	return fmt.Sprintf("%d:%s:%s", p.cfg.ClickMerchantUserID, hex.EncodeToString(digest[:]), timestamp)
+}
+
+func clickErrorCode(err error) int {
+	switch err {
+	case ErrInvalidSignature:
+		return clickSignFailed
+	case ErrInvalidPayload:
+		return clickActionNotFound
+	case ErrAmountMismatch:
+		return clickIncorrectAmount
+	case ErrAlreadyPaid:
+		return clickAlreadyPaid
+	case ErrPaymentNotFound:
+		return clickOrderNotFound
+	case ErrPaymentCancelled:
+		return clickCancelled
+	default:
+		return clickTransactionError
+	}
+}
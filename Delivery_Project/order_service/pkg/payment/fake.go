@@ -0,0 +1,97 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// Fake keeps intents in memory and accepts JSON webhooks signed with
+// hex(HMAC-SHA256(secret, body)). It is meant for local runs and tests.
+type Fake struct {
+	secret  string
+	counter int64
+
+	mu      sync.Mutex
+	intents map[string]Intent
+}
+
+// NewFake ...
+func NewFake(secret string) *Fake {
+	return &Fake{
+		secret:  secret,
+		intents: make(map[string]Intent),
+	}
+}
+
+type fakeWebhook struct {
+	ExternalID string  `json:"external_id"`
+	OrderID    string  `json:"order_id"`
+	Amount     float64 `json:"amount"`
+	Status     string  `json:"status"`
+}
+
+func (f *Fake) Name() string {
+	return "fake"
+}
+
+func (f *Fake) CreateIntent(ctx context.Context, req IntentRequest) (*Intent, error) {
+	id := fmt.Sprintf("fake-%d", atomic.AddInt64(&f.counter, 1))
+	intent := Intent{
+		ExternalID: id,
+		PaymentURL: "https://pay.example.com/" + id,
+		Status:     StatusPending,
+	}
+
+	f.mu.Lock()
+	f.intents[id] = intent
+	f.mu.Unlock()
+
+	return &intent, nil
+}
+
+func (f *Fake) ParseWebhook(payload []byte, signature string) (*Event, error) {
+	if !hmac.Equal([]byte(f.Sign(payload)), []byte(signature)) {
+		return nil, ErrInvalidSignature
+	}
+
+	var body fakeWebhook
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil, ErrInvalidPayload
+	}
+
+	switch body.Status {
+	case StatusPaid, StatusFailed, StatusCancelled:
+	default:
+		return nil, ErrInvalidPayload
+	}
+
+	return &Event{
+		ExternalID: body.ExternalID,
+		OrderID:    body.OrderID,
+		Amount:     body.Amount,
+		Status:     body.Status,
+	}, nil
+}
+
+func (f *Fake) Ack(event *Event, err error) ([]byte, string) {
+	resp := map[string]interface{}{"ok": err == nil}
+	if err != nil {
+		resp["error"] = err.Error()
+	}
+
+	body, _ := json.Marshal(resp)
+	return body, "application/json"
+}
+
+// Sign returns the signature the fake provider expects for payload
+func (f *Fake) Sign(payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(f.secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,82 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+const (
+	StatusPending   = "pending"
+	StatusPaid      = "paid"
+	StatusFailed    = "failed"
+	StatusCancelled = "cancelled"
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrInvalidPayload   = errors.New("invalid webhook payload")
+	ErrAmountMismatch   = errors.New("payment amount does not match the order")
+	ErrAlreadyPaid      = errors.New("order is already paid")
+	ErrPaymentNotFound  = errors.New("payment not found")
+	ErrPaymentCancelled = errors.New("payment is cancelled")
+)
+
+// IntentRequest is what a provider needs to start collecting money for an order
+type IntentRequest struct {
+	OrderID string
+	Amount  float64
+	Phone   string
+}
+
+// Intent is the provider side of a payment the client still has to complete
+type Intent struct {
+	ExternalID string
+	PaymentURL string
+	Status     string
+}
+
+// Event is a verified webhook notification about a payment
+type Event struct {
+	ExternalID string
+	OrderID    string
+	Amount     float64
+	Status     string
+
+	// Raw keeps provider specific fields needed to acknowledge the webhook
+	Raw map[string]string
+}
+
+// Provider is implemented by every payment gateway adapter
+type Provider interface {
+	Name() string
+	CreateIntent(ctx context.Context, req IntentRequest) (*Intent, error)
+	// ParseWebhook verifies the signature and decodes the callback body
+	ParseWebhook(payload []byte, signature string) (*Event, error)
+	// Ack builds the body the provider expects in reply to its webhook
+	Ack(event *Event, err error) (body []byte, contentType string)
+}
+
+// Config holds settings of all providers, only the selected one is used
+type Config struct {
+	Provider      string
+	WebhookSecret string
+
+	ClickBaseURL        string
+	ClickServiceID      int
+	ClickMerchantID     int
+	ClickMerchantUserID int
+	ClickSecretKey      string
+}
+
+// NewProvider ...
+func NewProvider(cfg Config) (Provider, error) {
+	switch cfg.Provider {
+	case "", "fake":
+		return NewFake(cfg.WebhookSecret), nil
+	case "click":
+		return NewClick(cfg), nil
+	default:
+		return nil, fmt.Errorf("unknown payment provider: %s", cfg.Provider)
+	}
+}
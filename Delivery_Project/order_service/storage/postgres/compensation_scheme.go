@@ -0,0 +1,305 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"order_service/pkg/errs"
+
+	order_service "order_service/genproto"
+	"order_service/pkg/helper"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type compensationSchemeRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewCompensationScheme(db *pgxpool.Pool) *compensationSchemeRepo {
+	return &compensationSchemeRepo{
+		db: db,
+	}
+}
+
+const compensationSchemeColumns = `
+			"id",
+			"name",
+			"branch_id",
+			"per_delivery_fee",
+			"delivery_price_percent",
+			"distance_bonus_from_km",
+			"distance_bonus_per_km",
+			"active",
+			"created_at"::text,
+			"updated_at"::text`
+
+func (b *compensationSchemeRepo) Create(c context.Context, req *order_service.CreateCompensationSchemeRequest) (string, error) {
+	tx, err := b.db.Begin(c)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(c)
+
+	query := `
+		INSERT INTO "compensation_schemes"(
+			"name",
+			"branch_id",
+			"per_delivery_fee",
+			"delivery_price_percent",
+			"distance_bonus_from_km",
+			"distance_bonus_per_km",
+			"created_by",
+			"created_at"
+			)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW()) RETURNING "id"
+	`
+
+	var id int32
+	err = tx.QueryRow(c, query,
+		req.Name,
+		req.BranchId,
+		req.PerDeliveryFee,
+		req.DeliveryPricePercent,
+		req.DistanceBonusFromKm,
+		req.DistanceBonusPerKm,
+		actor(c),
+	).Scan(&id)
+	if err != nil {
+		return "", fmt.Errorf("failed to create compensation scheme: %w", err)
+	}
+
+	if err = insertPeakHours(c, tx, id, req.PeakHours); err != nil {
+		return "", err
+	}
+
+	if err = tx.Commit(c); err != nil {
+		return "", fmt.Errorf("failed to commit compensation scheme: %w", err)
+	}
+
+	return fmt.Sprintf("created compensation scheme with ID: %d", id), nil
+}
+
+func (b *compensationSchemeRepo) Get(c context.Context, req *order_service.IdRequest) (*order_service.CompensationScheme, error) {
+	query := `SELECT ` + compensationSchemeColumns + ` FROM "compensation_schemes" WHERE "id" = $1 AND "deleted_at" IS NULL`
+
+	scheme, err := scanCompensationScheme(b.db.QueryRow(c, query, req.Id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errs.NotFound("compensation scheme not found")
+		}
+		return nil, fmt.Errorf("failed to get compensation scheme: %w", err)
+	}
+
+	scheme.PeakHours, err = b.getPeakHours(c, scheme.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return scheme, nil
+}
+
+func (b *compensationSchemeRepo) GetList(c context.Context, req *order_service.ListCompensationSchemeRequest) (*order_service.ListCompensationSchemeResponse, error) {
+	var (
+		resp   order_service.ListCompensationSchemeResponse
+		err    error
+		filter string = ` WHERE "deleted_at" IS NULL `
+		params        = make(map[string]interface{})
+	)
+
+	if req.Search != "" {
+		filter += ` AND "name" ILIKE '%' || :search || '%' `
+		params["search"] = req.Search
+	}
+
+	countQuery := `SELECT count(1) FROM "compensation_schemes" ` + filter
+	q, arr := helper.ReplaceQueryParams(countQuery, params)
+	err = b.db.QueryRow(c, q, arr...).Scan(&resp.Count)
+	if err != nil {
+		return nil, fmt.Errorf("error while scanning count %w", err)
+	}
+
+	query := `SELECT ` + compensationSchemeColumns + ` FROM "compensation_schemes" ` + filter +
+		` ORDER BY created_at DESC LIMIT :limit OFFSET :offset`
+
+	params["limit"] = 10
+	params["offset"] = 0
+
+	if req.Limit > 0 {
+		params["limit"] = req.Limit
+	}
+	if req.Page > 0 {
+		params["offset"] = (req.Page - 1) * req.Limit
+	}
+
+	q, arr = helper.ReplaceQueryParams(query, params)
+	rows, err := b.db.Query(c, q, arr...)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting rows %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		scheme, err := scanCompensationScheme(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning compensation scheme err: %w", err)
+		}
+		resp.CompensationSchemes = append(resp.CompensationSchemes, scheme)
+	}
+	rows.Close()
+
+	for _, scheme := range resp.CompensationSchemes {
+		scheme.PeakHours, err = b.getPeakHours(c, scheme.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &resp, nil
+}
+
+func (b *compensationSchemeRepo) Update(c context.Context, req *order_service.UpdateCompensationSchemeRequest) (string, error) {
+	tx, err := b.db.Begin(c)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(c)
+
+	query := `
+		UPDATE "compensation_schemes"
+		SET
+		"name" = $1,
+		"branch_id" = $2,
+		"per_delivery_fee" = $3,
+		"delivery_price_percent" = $4,
+		"distance_bonus_from_km" = $5,
+		"distance_bonus_per_km" = $6,
+		"active" = $7,
+		"updated_by" = $9,
+		"updated_at" = NOW()
+		WHERE "id" = $8 AND "deleted_at" IS NULL`
+
+	result, err := tx.Exec(c, query,
+		req.Name,
+		req.BranchId,
+		req.PerDeliveryFee,
+		req.DeliveryPricePercent,
+		req.DistanceBonusFromKm,
+		req.DistanceBonusPerKm,
+		req.Active,
+		req.Id,
+		actor(c),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to update compensation scheme: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return "", errs.NotFound("compensation scheme with ID %d not found", req.Id)
+	}
+
+	_, err = tx.Exec(c, `DELETE FROM "compensation_peak_hours" WHERE "scheme_id" = $1`, req.Id)
+	if err != nil {
+		return "", fmt.Errorf("failed to update peak hours: %w", err)
+	}
+
+	if err = insertPeakHours(c, tx, req.Id, req.PeakHours); err != nil {
+		return "", err
+	}
+
+	if err = tx.Commit(c); err != nil {
+		return "", fmt.Errorf("failed to commit compensation scheme: %w", err)
+	}
+
+	return fmt.Sprintf("compensation scheme with ID %d updated", req.Id), nil
+}
+
+func (b *compensationSchemeRepo) Delete(c context.Context, req *order_service.IdRequest) (string, error) {
+	query := `
+		UPDATE "compensation_schemes"
+		SET
+		"deleted_at" = NOW()
+		WHERE "id" = $1 AND "deleted_at" IS NULL`
+
+	result, err := b.db.Exec(c, query, req.Id)
+	if err != nil {
+		return "", fmt.Errorf("failed to delete compensation scheme: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return "", errs.NotFound("compensation scheme with ID %d not found", req.Id)
+	}
+
+	return "deleted", nil
+}
+
+func (b *compensationSchemeRepo) getPeakHours(c context.Context, schemeId int32) ([]*order_service.PeakHour, error) {
+	rows, err := b.db.Query(c, `
+		SELECT to_char("from_time", 'HH24:MI'), to_char("to_time", 'HH24:MI'), "multiplier"
+		FROM "compensation_peak_hours"
+		WHERE "scheme_id" = $1
+		ORDER BY "from_time"`, schemeId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get peak hours: %w", err)
+	}
+	defer rows.Close()
+
+	var peakHours []*order_service.PeakHour
+	for rows.Next() {
+		var p order_service.PeakHour
+		if err = rows.Scan(&p.From, &p.To, &p.Multiplier); err != nil {
+			return nil, fmt.Errorf("error while scanning peak hour err: %w", err)
+		}
+		peakHours = append(peakHours, &p)
+	}
+
+	return peakHours, nil
+}
+
+func insertPeakHours(c context.Context, tx pgx.Tx, schemeId int32, peakHours []*order_service.PeakHour) error {
+	for _, p := range peakHours {
+		_, err := tx.Exec(c,
+			`INSERT INTO "compensation_peak_hours"("scheme_id", "from_time", "to_time", "multiplier") VALUES ($1, $2::time, $3::time, $4)`,
+			schemeId, p.From, p.To, p.Multiplier,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert peak hour %s-%s: %w", p.From, p.To, err)
+		}
+	}
+
+	return nil
+}
+
+func scanCompensationScheme(row pgx.Row) (*order_service.CompensationScheme, error) {
+	var (
+		scheme    order_service.CompensationScheme
+		createdAt sql.NullString
+		updatedAt sql.NullString
+	)
+
+	err := row.Scan(
+		&scheme.Id,
+		&scheme.Name,
+		&scheme.BranchId,
+		&scheme.PerDeliveryFee,
+		&scheme.DeliveryPricePercent,
+		&scheme.DistanceBonusFromKm,
+		&scheme.DistanceBonusPerKm,
+		&scheme.Active,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if createdAt.Valid {
+		scheme.CreatedAt = createdAt.String
+	}
+	if updatedAt.Valid {
+		scheme.UpdatedAt = updatedAt.String
+	}
+
+	return &scheme, nil
+}
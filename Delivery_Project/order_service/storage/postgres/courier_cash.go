@@ -0,0 +1,270 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"order_service/pkg/errs"
+
+	order_service "order_service/genproto"
+	"order_service/pkg/helper"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type courierCashRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewCourierCash(db *pgxpool.Pool) *courierCashRepo {
+	return &courierCashRepo{
+		db: db,
+	}
+}
+
+const cashTransactionColumns = `
+			"id",
+			"courier_id",
+			"branch_id",
+			"type",
+			"amount",
+			"order_id",
+			"received_by",
+			"note",
+			"created_at"::text`
+
+func (b *courierCashRepo) Handover(c context.Context, req *order_service.CashHandoverRequest) (*order_service.CashTransaction, error) {
+	if req.Amount <= 0 {
+		return nil, errs.InvalidArgument("handover amount must be positive")
+	}
+
+	tx, err := b.db.Begin(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(c)
+
+	// couriers live in user service, so the lock is taken on the courier id itself
+	_, err = tx.Exec(c, `SELECT pg_advisory_xact_lock($1)`, req.CourierId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock courier cash: %w", err)
+	}
+
+	var balance float64
+	err = tx.QueryRow(c, `SELECT COALESCE(SUM("amount"), 0) FROM "courier_cash_transactions" WHERE "courier_id" = $1`, req.CourierId).Scan(&balance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get courier cash balance: %w", err)
+	}
+
+	if req.Amount > balance {
+		return nil, errs.FailedPrecondition("handover %.2f exceeds courier cash balance %.2f", req.Amount, balance)
+	}
+
+	query := `
+		INSERT INTO "courier_cash_transactions"(
+			"courier_id",
+			"branch_id",
+			"type",
+			"amount",
+			"received_by",
+			"note",
+			"created_at"
+		) VALUES ($1, $2, 'handover', $3, $4, $5, NOW())
+		RETURNING ` + cashTransactionColumns
+
+	resp, err := scanCashTransaction(tx.QueryRow(c, query, req.CourierId, req.BranchId, -req.Amount, req.ReceivedBy, req.Note))
+	if err != nil {
+		return nil, fmt.Errorf("failed to record cash handover: %w", err)
+	}
+
+	if err = tx.Commit(c); err != nil {
+		return nil, fmt.Errorf("failed to commit cash handover: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (b *courierCashRepo) GetBalance(c context.Context, req *order_service.IdRequest) (*order_service.CourierCashBalance, error) {
+	resp := order_service.CourierCashBalance{CourierId: req.Id}
+
+	err := b.db.QueryRow(c, `SELECT COALESCE(SUM("amount"), 0) FROM "courier_cash_transactions" WHERE "courier_id" = $1`, req.Id).Scan(&resp.Balance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get courier cash balance: %w", err)
+	}
+
+	return &resp, nil
+}
+
+func (b *courierCashRepo) GetList(c context.Context, req *order_service.ListCashTransactionsRequest) (*order_service.ListCashTransactionsResponse, error) {
+	var (
+		resp   order_service.ListCashTransactionsResponse
+		err    error
+		filter string = ` WHERE "courier_id" = :courier_id `
+		params        = make(map[string]interface{})
+	)
+	params["courier_id"] = req.CourierId
+
+	if req.CreatedAtFrom != "" {
+		filter += " AND created_at >= :created_at_from"
+		params["created_at_from"] = req.CreatedAtFrom
+	}
+
+	if req.CreatedAtTo != "" {
+		filter += " AND created_at <= :created_at_to"
+		params["created_at_to"] = req.CreatedAtTo
+	}
+
+	countQuery := `SELECT count(1) FROM "courier_cash_transactions" ` + filter
+	q, arr := helper.ReplaceQueryParams(countQuery, params)
+	err = b.db.QueryRow(c, q, arr...).Scan(&resp.Count)
+	if err != nil {
+		return nil, fmt.Errorf("error while scanning count %w", err)
+	}
+
+	query := `SELECT ` + cashTransactionColumns + ` FROM "courier_cash_transactions" ` + filter +
+		` ORDER BY created_at DESC, id DESC LIMIT :limit OFFSET :offset`
+
+	params["limit"] = 10
+	params["offset"] = 0
+
+	if req.Limit > 0 {
+		params["limit"] = req.Limit
+	}
+	if req.Page > 0 {
+		params["offset"] = (req.Page - 1) * req.Limit
+	}
+
+	q, arr = helper.ReplaceQueryParams(query, params)
+	rows, err := b.db.Query(c, q, arr...)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting rows %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		transaction, err := scanCashTransaction(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning cash transaction err: %w", err)
+		}
+		resp.Transactions = append(resp.Transactions, transaction)
+	}
+
+	return &resp, nil
+}
+
+// Reconcile compares the cash orders the courier finished in the window with
+// what the ledger recorded for them and with what was handed over
+func (b *courierCashRepo) Reconcile(c context.Context, req *order_service.CashReconciliationRequest) (*order_service.CashReconciliation, error) {
+	resp := order_service.CashReconciliation{CourierId: req.CourierId}
+
+	err := b.db.QueryRow(c, `
+		SELECT
+			COALESCE(NULLIF($1, '')::timestamp, date_trunc('day', NOW()))::text,
+			COALESCE(NULLIF($2, '')::timestamp, date_trunc('day', NOW()) + INTERVAL '1 day')::text`,
+		req.From, req.To,
+	).Scan(&resp.From, &resp.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse reconciliation window: %w", err)
+	}
+
+	err = b.db.QueryRow(c, `
+		SELECT
+			COALESCE(SUM("amount") FILTER (WHERE "created_at" < $2), 0),
+			COALESCE(SUM("amount") FILTER (WHERE "type" = 'collected' AND "created_at" >= $2 AND "created_at" < $3), 0),
+			COALESCE(-SUM("amount") FILTER (WHERE "type" = 'handover' AND "created_at" >= $2 AND "created_at" < $3), 0),
+			COALESCE(SUM("amount") FILTER (WHERE "created_at" < $3), 0)
+		FROM "courier_cash_transactions"
+		WHERE "courier_id" = $1`,
+		req.CourierId, resp.From, resp.To,
+	).Scan(&resp.OpeningBalance, &resp.Collected, &resp.HandedOver, &resp.ClosingBalance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get courier cash totals: %w", err)
+	}
+
+	rows, err := b.db.Query(c, `
+		SELECT
+			o."order_id",
+			o."price" + o."delivery_price",
+			t."amount"
+		FROM "orders" o
+		LEFT JOIN "courier_cash_transactions" t ON t."order_id" = o."order_id" AND t."type" = 'collected'
+		WHERE o."courier_id" = $1 AND o."payment_type" = 'cash' AND o."status" = 'finished' AND o."deleted_at" IS NULL
+		  AND COALESCE(t."created_at", o."updated_at") >= $2 AND COALESCE(t."created_at", o."updated_at") < $3
+		ORDER BY o."updated_at"`,
+		req.CourierId, resp.From, resp.To,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting finished cash orders %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			orderId   string
+			expected  float64
+			collected sql.NullFloat64
+		)
+		if err = rows.Scan(&orderId, &expected, &collected); err != nil {
+			return nil, fmt.Errorf("error while scanning finished cash order err: %w", err)
+		}
+		resp.Expected += expected
+
+		switch {
+		case !collected.Valid:
+			resp.Discrepancies = append(resp.Discrepancies, &order_service.CashDiscrepancy{
+				Type:     "missing_collection",
+				OrderId:  orderId,
+				Expected: expected,
+			})
+		case math.Abs(collected.Float64-expected) >= 0.01:
+			resp.Discrepancies = append(resp.Discrepancies, &order_service.CashDiscrepancy{
+				Type:     "amount_mismatch",
+				OrderId:  orderId,
+				Expected: expected,
+				Actual:   collected.Float64,
+			})
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error while reading finished cash orders %w", err)
+	}
+
+	if resp.ClosingBalance >= 0.01 {
+		resp.Discrepancies = append(resp.Discrepancies, &order_service.CashDiscrepancy{
+			Type:   "not_handed_over",
+			Actual: resp.ClosingBalance,
+		})
+	}
+
+	return &resp, nil
+}
+
+func scanCashTransaction(row pgx.Row) (*order_service.CashTransaction, error) {
+	var (
+		transaction order_service.CashTransaction
+		createdAt   sql.NullString
+	)
+
+	err := row.Scan(
+		&transaction.Id,
+		&transaction.CourierId,
+		&transaction.BranchId,
+		&transaction.Type,
+		&transaction.Amount,
+		&transaction.OrderId,
+		&transaction.ReceivedBy,
+		&transaction.Note,
+		&createdAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if createdAt.Valid {
+		transaction.CreatedAt = createdAt.String
+	}
+
+	return &transaction, nil
+}
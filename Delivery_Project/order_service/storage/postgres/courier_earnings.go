@@ -0,0 +1,213 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+
+	order_service "order_service/genproto"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type courierEarningsRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewCourierEarnings(db *pgxpool.Pool) *courierEarningsRepo {
+	return &courierEarningsRepo{
+		db: db,
+	}
+}
+
+const courierEarningColumns = `
+			"id",
+			"order_id",
+			"courier_id",
+			"branch_id",
+			"scheme_id",
+			"delivery_fee",
+			"delivery_share",
+			"distance_km",
+			"distance_bonus",
+			"multiplier",
+			"amount",
+			"created_at"::text`
+
+// recordEarning writes the courier earning of a finished order inside the status
+// transaction. The branch scheme wins over the default one, without any active
+// scheme nothing is recorded.
+func recordEarning(c context.Context, tx pgx.Tx, orderId string) error {
+	var (
+		courierId      int32
+		branchId       int32
+		deliveryPrice  float64
+		distanceKm     float64
+		schemeId       int32
+		fee            float64
+		percent        float64
+		bonusFromKm    float64
+		bonusPerKm     float64
+		peakMultiplier sql.NullFloat64
+	)
+
+	err := tx.QueryRow(c, `
+		SELECT
+			o."courier_id",
+			o."branch_id",
+			o."delivery_price",
+			o."distance_km",
+			s."id",
+			s."per_delivery_fee",
+			s."delivery_price_percent",
+			s."distance_bonus_from_km",
+			s."distance_bonus_per_km",
+			(
+				SELECT MAX(p."multiplier")
+				FROM "compensation_peak_hours" p
+				WHERE p."scheme_id" = s."id" AND (
+					(p."from_time" <= p."to_time" AND LOCALTIME >= p."from_time" AND LOCALTIME < p."to_time")
+					OR (p."from_time" > p."to_time" AND (LOCALTIME >= p."from_time" OR LOCALTIME < p."to_time"))
+				)
+			)
+		FROM "orders" o
+		JOIN "compensation_schemes" s ON s."branch_id" IN (o."branch_id", 0) AND s."active" AND s."deleted_at" IS NULL
+		WHERE o."order_id" = $1
+		ORDER BY s."branch_id" DESC
+		LIMIT 1`, orderId,
+	).Scan(
+		&courierId,
+		&branchId,
+		&deliveryPrice,
+		&distanceKm,
+		&schemeId,
+		&fee,
+		&percent,
+		&bonusFromKm,
+		&bonusPerKm,
+		&peakMultiplier,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil
+		}
+		return fmt.Errorf("failed to get compensation scheme: %w", err)
+	}
+
+	multiplier := 1.0
+	if peakMultiplier.Valid {
+		multiplier = peakMultiplier.Float64
+	}
+
+	share := round2(deliveryPrice * percent / 100)
+	bonus := round2(math.Max(distanceKm-bonusFromKm, 0) * bonusPerKm)
+	amount := round2((fee + share + bonus) * multiplier)
+
+	_, err = tx.Exec(c, `
+		INSERT INTO "courier_earnings"(
+			"order_id",
+			"courier_id",
+			"branch_id",
+			"scheme_id",
+			"delivery_fee",
+			"delivery_share",
+			"distance_km",
+			"distance_bonus",
+			"multiplier",
+			"amount",
+			"created_at"
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW())
+		ON CONFLICT ("order_id") DO NOTHING`,
+		orderId, courierId, branchId, schemeId, fee, share, distanceKm, bonus, multiplier, amount,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record courier earning: %w", err)
+	}
+
+	return nil
+}
+
+func (b *courierEarningsRepo) GetPayoutStatement(c context.Context, req *order_service.PayoutStatementRequest) (*order_service.PayoutStatement, error) {
+	resp := order_service.PayoutStatement{CourierId: req.CourierId}
+
+	err := b.db.QueryRow(c, `
+		SELECT
+			COALESCE(NULLIF($1, '')::timestamp, date_trunc('month', NOW()))::text,
+			COALESCE(NULLIF($2, '')::timestamp, date_trunc('month', NOW()) + INTERVAL '1 month')::text`,
+		req.From, req.To,
+	).Scan(&resp.From, &resp.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse payout period: %w", err)
+	}
+
+	query := `SELECT ` + courierEarningColumns + ` FROM "courier_earnings"
+		WHERE "courier_id" = $1 AND "created_at" >= $2 AND "created_at" < $3
+		ORDER BY "created_at", "id"`
+
+	rows, err := b.db.Query(c, query, req.CourierId, resp.From, resp.To)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting rows %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		earning, err := scanCourierEarning(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning courier earning err: %w", err)
+		}
+
+		resp.Deliveries++
+		resp.DeliveryFee += earning.DeliveryFee
+		resp.DeliveryShare += earning.DeliveryShare
+		resp.DistanceBonus += earning.DistanceBonus
+		resp.Total += earning.Amount
+		resp.Earnings = append(resp.Earnings, earning)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error while reading courier earnings %w", err)
+	}
+
+	resp.DeliveryFee = round2(resp.DeliveryFee)
+	resp.DeliveryShare = round2(resp.DeliveryShare)
+	resp.DistanceBonus = round2(resp.DistanceBonus)
+	resp.Total = round2(resp.Total)
+
+	return &resp, nil
+}
+
+func scanCourierEarning(row pgx.Row) (*order_service.CourierEarning, error) {
+	var (
+		earning   order_service.CourierEarning
+		createdAt sql.NullString
+	)
+
+	err := row.Scan(
+		&earning.Id,
+		&earning.OrderId,
+		&earning.CourierId,
+		&earning.BranchId,
+		&earning.SchemeId,
+		&earning.DeliveryFee,
+		&earning.DeliveryShare,
+		&earning.DistanceKm,
+		&earning.DistanceBonus,
+		&earning.Multiplier,
+		&earning.Amount,
+		&createdAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if createdAt.Valid {
+		earning.CreatedAt = createdAt.String
+	}
+
+	return &earning, nil
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
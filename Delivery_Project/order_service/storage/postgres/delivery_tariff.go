@@ -4,11 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"order_service/pkg/errs"
 	"order_service/pkg/helper"
+	"time"
 
 	tariff_service "order_service/genproto"
 
-	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
@@ -31,15 +33,17 @@ func (b *tariffRepo) Create(c context.Context, req *tariff_service.CreateDeliver
 			"name",    
 			"type", 
 			"base_price",
+			"created_by",
 			"created_at"
 			)
-		VALUES ($1, $2, $3,  NOW()) RETURNING "id"
+		VALUES ($1, $2, $3, $4, NOW()) RETURNING "id"
 	`
 
 		err := b.db.QueryRow(c, query,
 			req.Name,
 			req.TariffType,
 			req.BasePrice,
+			actor(c),
 		).Scan(&tariffID)
 		if err != nil {
 			return "", fmt.Errorf("failed to create fixed tariff: %w", err)
@@ -52,13 +56,15 @@ func (b *tariffRepo) Create(c context.Context, req *tariff_service.CreateDeliver
 		INSERT INTO "delivery_tarif"(
 			"name",    
 			"type", 
+			"created_by",
 			"created_at"
 			)
-		VALUES ($1, $2,  NOW()) RETURNING "id"
+		VALUES ($1, $2, $3, NOW()) RETURNING "id"
 	`
 		err := b.db.QueryRow(c, query,
 			req.Name,
 			req.TariffType,
+			actor(c),
 		).Scan(&tariffID)
 		if err != nil {
 			return "", fmt.Errorf("failed to create alternative tariff: %w", err)
@@ -84,7 +90,7 @@ func (b *tariffRepo) Create(c context.Context, req *tariff_service.CreateDeliver
 		}
 		return fmt.Sprintf("created alternative tariffID: %d", tariffID), nil
 	}
-	return "", fmt.Errorf("something went wrong")
+	return "", errs.InvalidArgument("tariff_type must be fixed or alternative")
 }
 
 func (b *tariffRepo) Get(c context.Context, req *tariff_service.IdRequest) (resp *tariff_service.DeliveryTariff, err error) {
@@ -123,7 +129,7 @@ func (b *tariffRepo) Get(c context.Context, req *tariff_service.IdRequest) (resp
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("tariff not found")
+			return nil, errs.NotFound("tariff not found")
 		}
 		return nil, fmt.Errorf("failed to get tariff: %w", err)
 	}
@@ -139,9 +145,19 @@ func (b *tariffRepo) Get(c context.Context, req *tariff_service.IdRequest) (resp
 }
 
 func (b *tariffRepo) GetList(c context.Context, req *tariff_service.ListDeliveryTariffRequest) (*tariff_service.ListDeliveryTariffResponse, error) {
+	return b.list(c, req, `t."deleted_at" IS NULL`)
+}
+
+// GetDeletedList lists deleted tariffs that can still be restored
+func (b *tariffRepo) GetDeletedList(c context.Context, req *tariff_service.ListDeletedRequest) (*tariff_service.ListDeliveryTariffResponse, error) {
+	return b.list(c, &tariff_service.ListDeliveryTariffRequest{Limit: req.Limit, Page: req.Page}, `t."deleted_at" IS NOT NULL`)
+}
+
+// list lists the tariffs of scope matching req
+func (b *tariffRepo) list(c context.Context, req *tariff_service.ListDeliveryTariffRequest, scope string) (*tariff_service.ListDeliveryTariffResponse, error) {
 	var (
 		err    error
-		filter string = ` WHERE t.deleted_at IS NULL  `
+		filter string = ` WHERE ` + scope + ` `
 		params        = make(map[string]interface{})
 	)
 	resp := tariff_service.ListDeliveryTariffResponse{
@@ -246,16 +262,18 @@ func (b *tariffRepo) Update(c context.Context, req *tariff_service.UpdateDeliver
 			"name" = $1,   
 			"type" = $2,
 			"base_price" = $3,
+			"updated_by" = $5,
 			"updated_at" = NOW()
 			WHERE id = $4 AND "deleted_at" IS NULL`
 
 		result, err := b.db.Exec(
-			context.Background(),
+			c,
 			query,
 			req.Name,
 			req.TariffType,
 			req.BasePrice,
 			req.Id,
+			actor(c),
 		)
 
 		if err != nil {
@@ -263,7 +281,7 @@ func (b *tariffRepo) Update(c context.Context, req *tariff_service.UpdateDeliver
 		}
 
 		if result.RowsAffected() == 0 {
-			return "", fmt.Errorf("tariff with ID %d not found", req.Id)
+			return "", errs.NotFound("tariff with ID %d not found", req.Id)
 		}
 
 		return fmt.Sprintf("tariff with ID %d updated", req.Id), nil
@@ -274,22 +292,24 @@ func (b *tariffRepo) Update(c context.Context, req *tariff_service.UpdateDeliver
 		SET 
 		"name" = $1,   
 		"type" = $2,
+		"updated_by" = $4,
 		"updated_at" = NOW()
 		WHERE id = $3 AND "deleted_at" IS NULL`
 
 		result, err := b.db.Exec(
-			context.Background(),
+			c,
 			query,
 			req.Name,
 			req.TariffType,
 			req.Id,
+			actor(c),
 		)
 
 		if err != nil {
 			return "", fmt.Errorf("failed to update tariff: %w", err)
 		}
 		if result.RowsAffected() == 0 {
-			return "", fmt.Errorf("tariff with ID %d not found", req.Id)
+			return "", errs.NotFound("tariff with ID %d not found", req.Id)
 		}
 
 		query2 := `
@@ -301,7 +321,7 @@ func (b *tariffRepo) Update(c context.Context, req *tariff_service.UpdateDeliver
 		WHERE "delivery_tarif_id" = $4 
 		`
 		result, err = b.db.Exec(
-			context.Background(),
+			c,
 			query2,
 			req.Values.FromPrice,
 			req.Values.ToPrice,
@@ -314,12 +334,12 @@ func (b *tariffRepo) Update(c context.Context, req *tariff_service.UpdateDeliver
 		}
 
 		if result.RowsAffected() == 0 {
-			return "", fmt.Errorf("tariff values with ID %d not found", req.Id)
+			return "", errs.NotFound("tariff values with ID %d not found", req.Id)
 		}
 
 		return fmt.Sprintf("tariff and values with ID %d updated", req.Id), nil
 	}
-	return "", fmt.Errorf("someting went wrong")
+	return "", errs.InvalidArgument("tariff_type must be fixed or alternative")
 }
 
 func (b *tariffRepo) Delete(c context.Context, req *tariff_service.IdRequest) (resp string, err error) {
@@ -331,7 +351,7 @@ func (b *tariffRepo) Delete(c context.Context, req *tariff_service.IdRequest) (r
 				WHERE id = $1  AND "deleted_at" IS NULL`
 
 	result, err := b.db.Exec(
-		context.Background(),
+		c,
 		query,
 		req.Id,
 	)
@@ -341,8 +361,54 @@ func (b *tariffRepo) Delete(c context.Context, req *tariff_service.IdRequest) (r
 	}
 
 	if result.RowsAffected() == 0 {
-		return "", fmt.Errorf("tariff with ID %d  not found", req.Id)
+		return "", errs.NotFound("tariff with ID %d  not found", req.Id)
 	}
 
 	return "deleted", nil
 }
+
+// Restore undoes the delete of a tariff that is not purged yet
+func (b *tariffRepo) Restore(c context.Context, req *tariff_service.IdRequest) (string, error) {
+	result, err := b.db.Exec(c, `
+		UPDATE "delivery_tarif"
+		SET
+			"deleted_at" = NULL,
+			"updated_at" = NOW(),
+			"updated_by" = $2
+		WHERE "id" = $1 AND "deleted_at" IS NOT NULL`,
+		req.Id,
+		actor(c),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to restore tariff: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return "", errs.NotFound("deleted tariff with ID %d not found", req.Id)
+	}
+
+	return "restored", nil
+}
+
+// Purge deletes tariffs deleted longer than retention ago with their values.
+// Orders keep the delivery price they were charged, branches of user_service
+// can not get a deleted tariff already.
+func (b *tariffRepo) Purge(c context.Context, retention time.Duration) (int64, error) {
+	var count int64
+	err := b.db.QueryRow(c, `
+		WITH "purged" AS (
+			DELETE FROM "delivery_tarif"
+			WHERE "deleted_at" <= NOW() - $1 * INTERVAL '1 second'
+			RETURNING "id"
+		), "values" AS (
+			DELETE FROM "delivery_tarif_values" WHERE "delivery_tarif_id" IN (SELECT "id" FROM "purged")
+		)
+		SELECT count(1) FROM "purged"`,
+		int64(retention.Seconds()),
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge tariffs: %w", err)
+	}
+
+	return count, nil
+}
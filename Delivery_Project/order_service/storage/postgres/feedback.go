@@ -0,0 +1,387 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"order_service/pkg/errs"
+
+	order_service "order_service/genproto"
+	"order_service/pkg/helper"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type feedbackRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewFeedback(db *pgxpool.Pool) *feedbackRepo {
+	return &feedbackRepo{
+		db: db,
+	}
+}
+
+const feedbackColumns = `
+			"id",
+			"order_id",
+			"client_id",
+			"courier_id",
+			"branch_id",
+			"courier_rating",
+			"food_rating",
+			"comment",
+			"tags",
+			"created_at"::text`
+
+const feedbackAlertColumns = `
+			"id",
+			"feedback_id",
+			"order_id",
+			"courier_id",
+			"branch_id",
+			"reason",
+			"created_at"::text`
+
+// Create stores the feedback, updates rating aggregates of the courier, the
+// branch and every product of the order, and raises an alert for each rating
+// at or below lowRating
+func (b *feedbackRepo) Create(c context.Context, req *order_service.CreateFeedbackRequest, lowRating int32) (*order_service.Feedback, []*order_service.FeedbackAlert, error) {
+	tx, err := b.db.Begin(c)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(c)
+
+	var (
+		clientId  int32
+		branchId  int32
+		courierId sql.NullInt32
+		status    string
+	)
+	err = tx.QueryRow(c, `
+		SELECT "client_id", "branch_id", "courier_id", "status"
+		FROM "orders"
+		WHERE "order_id" = $1 AND "deleted_at" IS NULL
+		FOR UPDATE`, req.OrderId,
+	).Scan(&clientId, &branchId, &courierId, &status)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil, errs.NotFound("order with ID %s not found", req.OrderId)
+		}
+		return nil, nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	if clientId != req.ClientId {
+		return nil, nil, errs.InvalidArgument("order %s does not belong to client %d", req.OrderId, req.ClientId)
+	}
+	if status != "finished" {
+		return nil, nil, errs.FailedPrecondition("only finished orders can be rated, order %s is %s", req.OrderId, status)
+	}
+	if courierId.Int32 == 0 && req.CourierRating != 0 {
+		return nil, nil, errs.InvalidArgument("order %s has no courier to rate", req.OrderId)
+	}
+
+	tags := req.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	query := `
+		INSERT INTO "order_feedback"(
+			"order_id",
+			"client_id",
+			"courier_id",
+			"branch_id",
+			"courier_rating",
+			"food_rating",
+			"comment",
+			"tags",
+			"created_at"
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+		ON CONFLICT ("order_id") DO NOTHING
+		RETURNING ` + feedbackColumns
+
+	feedback, err := scanFeedback(tx.QueryRow(c, query,
+		req.OrderId,
+		req.ClientId,
+		courierId.Int32,
+		branchId,
+		req.CourierRating,
+		req.FoodRating,
+		req.Comment,
+		tags,
+	))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil, errs.Conflict("order %s is already rated", req.OrderId)
+		}
+		return nil, nil, fmt.Errorf("failed to create feedback: %w", err)
+	}
+
+	upsert := `
+		INSERT INTO "rating_aggregates" AS a ("subject_type", "subject_id", "ratings_count", "ratings_sum", "updated_at")
+		%s
+		ON CONFLICT ("subject_type", "subject_id") DO UPDATE SET
+			"ratings_count" = a."ratings_count" + EXCLUDED."ratings_count",
+			"ratings_sum" = a."ratings_sum" + EXCLUDED."ratings_sum",
+			"updated_at" = NOW()`
+
+	if feedback.CourierId != 0 && feedback.CourierRating != 0 {
+		_, err = tx.Exec(c, fmt.Sprintf(upsert, `VALUES ('courier', $1, 1, $2, NOW())`), feedback.CourierId, feedback.CourierRating)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to update courier rating: %w", err)
+		}
+	}
+
+	_, err = tx.Exec(c, fmt.Sprintf(upsert, `VALUES ('branch', $1, 1, $2, NOW())`), feedback.BranchId, feedback.FoodRating)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to update branch rating: %w", err)
+	}
+
+	_, err = tx.Exec(c, fmt.Sprintf(upsert, `
+		SELECT DISTINCT 'product', "product_id", 1, $2::int, NOW()
+		FROM "order_products"
+		WHERE "order_id" = $1`), feedback.OrderId, feedback.FoodRating)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to update product rating: %w", err)
+	}
+
+	var reasons []string
+	if feedback.CourierRating != 0 && feedback.CourierRating <= lowRating {
+		reasons = append(reasons, "low_courier_rating")
+	}
+	if feedback.FoodRating <= lowRating {
+		reasons = append(reasons, "low_food_rating")
+	}
+
+	var alerts []*order_service.FeedbackAlert
+	for _, reason := range reasons {
+
+		alert, err := scanFeedbackAlert(tx.QueryRow(c, `
+			INSERT INTO "feedback_alerts"(
+				"feedback_id",
+				"order_id",
+				"courier_id",
+				"branch_id",
+				"reason",
+				"created_at"
+			) VALUES ($1, $2, $3, $4, $5, NOW())
+			RETURNING `+feedbackAlertColumns,
+			feedback.Id, feedback.OrderId, feedback.CourierId, feedback.BranchId, reason,
+		))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create feedback alert: %w", err)
+		}
+		alerts = append(alerts, alert)
+	}
+
+	if err = tx.Commit(c); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit feedback: %w", err)
+	}
+
+	return feedback, alerts, nil
+}
+
+func (b *feedbackRepo) Get(c context.Context, req *order_service.OrderIdRequest) (*order_service.Feedback, error) {
+	query := `SELECT ` + feedbackColumns + ` FROM "order_feedback" WHERE "order_id" = $1`
+
+	feedback, err := scanFeedback(b.db.QueryRow(c, query, req.OrderId))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errs.NotFound("feedback for order %s not found", req.OrderId)
+		}
+		return nil, fmt.Errorf("failed to get feedback: %w", err)
+	}
+
+	return feedback, nil
+}
+
+func (b *feedbackRepo) GetList(c context.Context, req *order_service.ListFeedbackRequest) (*order_service.ListFeedbackResponse, error) {
+	var (
+		resp   order_service.ListFeedbackResponse
+		err    error
+		filter string = ` WHERE TRUE `
+		params        = make(map[string]interface{})
+	)
+
+	if req.CourierId != 0 {
+		filter += ` AND "courier_id" = :courier_id `
+		params["courier_id"] = req.CourierId
+	}
+	if req.BranchId != 0 {
+		filter += ` AND "branch_id" = :branch_id `
+		params["branch_id"] = req.BranchId
+	}
+	if req.ClientId != 0 {
+		filter += ` AND "client_id" = :client_id `
+		params["client_id"] = req.ClientId
+	}
+	if req.MaxRating != 0 {
+		filter += ` AND LEAST(NULLIF("courier_rating", 0), "food_rating") <= :max_rating `
+		params["max_rating"] = req.MaxRating
+	}
+
+	countQuery := `SELECT count(1) FROM "order_feedback" ` + filter
+	q, arr := helper.ReplaceQueryParams(countQuery, params)
+	err = b.db.QueryRow(c, q, arr...).Scan(&resp.Count)
+	if err != nil {
+		return nil, fmt.Errorf("error while scanning count %w", err)
+	}
+
+	query := `SELECT ` + feedbackColumns + ` FROM "order_feedback" ` + filter +
+		` ORDER BY created_at DESC LIMIT :limit OFFSET :offset`
+
+	params["limit"] = 10
+	params["offset"] = 0
+
+	if req.Limit > 0 {
+		params["limit"] = req.Limit
+	}
+	if req.Page > 0 {
+		params["offset"] = (req.Page - 1) * req.Limit
+	}
+
+	q, arr = helper.ReplaceQueryParams(query, params)
+	rows, err := b.db.Query(c, q, arr...)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting rows %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		feedback, err := scanFeedback(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning feedback err: %w", err)
+		}
+		resp.Feedbacks = append(resp.Feedbacks, feedback)
+	}
+
+	return &resp, nil
+}
+
+func (b *feedbackRepo) GetRating(c context.Context, req *order_service.RatingRequest) (*order_service.Rating, error) {
+	resp := order_service.Rating{
+		SubjectType: req.SubjectType,
+		SubjectId:   req.SubjectId,
+	}
+
+	err := b.db.QueryRow(c, `
+		SELECT "ratings_count", ROUND("ratings_sum"::numeric / NULLIF("ratings_count", 0), 2)
+		FROM "rating_aggregates"
+		WHERE "subject_type" = $1 AND "subject_id" = $2`,
+		req.SubjectType, req.SubjectId,
+	).Scan(&resp.Count, &resp.Average)
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("failed to get rating: %w", err)
+	}
+
+	return &resp, nil
+}
+
+func (b *feedbackRepo) GetAlertList(c context.Context, req *order_service.ListFeedbackAlertsRequest) (*order_service.ListFeedbackAlertsResponse, error) {
+	var (
+		resp   order_service.ListFeedbackAlertsResponse
+		err    error
+		filter string = ` WHERE TRUE `
+		params        = make(map[string]interface{})
+	)
+
+	if req.BranchId != 0 {
+		filter += ` AND "branch_id" = :branch_id `
+		params["branch_id"] = req.BranchId
+	}
+
+	countQuery := `SELECT count(1) FROM "feedback_alerts" ` + filter
+	q, arr := helper.ReplaceQueryParams(countQuery, params)
+	err = b.db.QueryRow(c, q, arr...).Scan(&resp.Count)
+	if err != nil {
+		return nil, fmt.Errorf("error while scanning count %w", err)
+	}
+
+	query := `SELECT ` + feedbackAlertColumns + ` FROM "feedback_alerts" ` + filter +
+		` ORDER BY created_at DESC LIMIT :limit OFFSET :offset`
+
+	params["limit"] = 10
+	params["offset"] = 0
+
+	if req.Limit > 0 {
+		params["limit"] = req.Limit
+	}
+	if req.Page > 0 {
+		params["offset"] = (req.Page - 1) * req.Limit
+	}
+
+	q, arr = helper.ReplaceQueryParams(query, params)
+	rows, err := b.db.Query(c, q, arr...)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting rows %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		alert, err := scanFeedbackAlert(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning feedback alert err: %w", err)
+		}
+		resp.Alerts = append(resp.Alerts, alert)
+	}
+
+	return &resp, nil
+}
+
+func scanFeedback(row pgx.Row) (*order_service.Feedback, error) {
+	var (
+		feedback  order_service.Feedback
+		createdAt sql.NullString
+	)
+
+	err := row.Scan(
+		&feedback.Id,
+		&feedback.OrderId,
+		&feedback.ClientId,
+		&feedback.CourierId,
+		&feedback.BranchId,
+		&feedback.CourierRating,
+		&feedback.FoodRating,
+		&feedback.Comment,
+		&feedback.Tags,
+		&createdAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if createdAt.Valid {
+		feedback.CreatedAt = createdAt.String
+	}
+
+	return &feedback, nil
+}
+
+func scanFeedbackAlert(row pgx.Row) (*order_service.FeedbackAlert, error) {
+	var (
+		alert     order_service.FeedbackAlert
+		createdAt sql.NullString
+	)
+
+	err := row.Scan(
+		&alert.Id,
+		&alert.FeedbackId,
+		&alert.OrderId,
+		&alert.CourierId,
+		&alert.BranchId,
+		&alert.Reason,
+		&createdAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if createdAt.Valid {
+		alert.CreatedAt = createdAt.String
+	}
+
+	return &alert, nil
+}
@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"order_service/pkg/errs"
+	"order_service/storage"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type idempotencyRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewIdempotency(db *pgxpool.Pool) *idempotencyRepo {
+	return &idempotencyRepo{
+		db: db,
+	}
+}
+
+// claimIdempotencyKey stores the key with the order inside the creating tx. A
+// concurrent create with the same key waits for tx and then gets a conflict,
+// the caller replays the winner's record for it. An expired key is taken over.
+func claimIdempotencyKey(c context.Context, tx pgx.Tx, key *storage.IdempotencyKey, orderID string) error {
+	result, err := tx.Exec(c, `
+		INSERT INTO "idempotency_keys"("client_id", "key", "request_hash", "order_id")
+		VALUES ($5, $1, $2, $3)
+		ON CONFLICT ("client_id", "key") DO UPDATE SET
+			"request_hash" = EXCLUDED."request_hash",
+			"order_id" = EXCLUDED."order_id",
+			"response" = '',
+			"created_at" = NOW()
+		WHERE "idempotency_keys"."created_at" <= NOW() - $4 * INTERVAL '1 second'`,
+		key.Key,
+		key.RequestHash,
+		orderID,
+		int64(key.TTL.Seconds()),
+		key.ClientId,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save idempotency key: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return errs.Conflict("request with idempotency key %s is already processed", key.Key)
+	}
+
+	return nil
+}
+
+// Get returns the record of a key the client claimed within the key's TTL
+func (b *idempotencyRepo) Get(c context.Context, key *storage.IdempotencyKey) (*storage.IdempotencyRecord, error) {
+	var record storage.IdempotencyRecord
+	err := b.db.QueryRow(c, `
+		SELECT "request_hash", "order_id", "response"
+		FROM "idempotency_keys"
+		WHERE "client_id" = $1 AND "key" = $2 AND "created_at" > NOW() - $3 * INTERVAL '1 second'`,
+		key.ClientId,
+		key.Key,
+		int64(key.TTL.Seconds()),
+	).Scan(&record.RequestHash, &record.OrderId, &record.Response)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errs.NotFound("idempotency key %s not found", key.Key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+
+	return &record, nil
+}
+
+// SaveResponse keeps the response of the create, replays return it as is
+func (b *idempotencyRepo) SaveResponse(c context.Context, key *storage.IdempotencyKey, response string) error {
+	_, err := b.db.Exec(c, `UPDATE "idempotency_keys" SET "response" = $3 WHERE "client_id" = $1 AND "key" = $2`, key.ClientId, key.Key, response)
+	if err != nil {
+		return fmt.Errorf("failed to save idempotency response: %w", err)
+	}
+
+	return nil
+}
+
+// Purge deletes keys older than ttl and returns how many were deleted
+func (b *idempotencyRepo) Purge(c context.Context, ttl time.Duration) (int64, error) {
+	result, err := b.db.Exec(c, `DELETE FROM "idempotency_keys" WHERE "created_at" <= NOW() - $1 * INTERVAL '1 second'`, int64(ttl.Seconds()))
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge idempotency keys: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	order_service "order_service/genproto"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type kitchenRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewKitchen(db *pgxpool.Pool) *kitchenRepo {
+	return &kitchenRepo{
+		db: db,
+	}
+}
+
+// GetQueue lists the orders a branch still has to prepare, the most urgent
+// promise first. Card orders join the queue only after they are paid.
+func (k *kitchenRepo) GetQueue(c context.Context, req *order_service.KitchenQueueRequest) (*order_service.KitchenQueue, error) {
+	query := `
+		SELECT
+			o."id",
+			o."order_id",
+			o."type",
+			o."status",
+			o."created_at"::text,
+			o."promised_at"::text,
+			(SELECT MIN(h."changed_at") FROM "order_status_history" h
+			WHERE h."order_id" = o."order_id" AND h."status" = 'preparing')::text
+		FROM "orders" o
+		WHERE o."branch_id" = $1 AND o."deleted_at" IS NULL
+			AND o."status" IN ('accepted', 'courier_accepted', 'preparing')
+			AND o."payment_status" IN ('not_required', 'paid')
+		ORDER BY o."promised_at" NULLS LAST, o."created_at"`
+
+	rows, err := k.db.Query(c, query, req.BranchId)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting kitchen queue %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		resp     = order_service.KitchenQueue{BranchId: req.BranchId}
+		byID     = make(map[string]*order_service.KitchenOrder)
+		orderIDs []string
+	)
+	for rows.Next() {
+		var (
+			order              order_service.KitchenOrder
+			createdAt          sql.NullString
+			promisedAt         sql.NullString
+			preparingStartedAt sql.NullString
+		)
+		err = rows.Scan(
+			&order.Id,
+			&order.OrderId,
+			&order.Type,
+			&order.Status,
+			&createdAt,
+			&promisedAt,
+			&preparingStartedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning kitchen order %w", err)
+		}
+
+		order.CreatedAt = createdAt.String
+		order.PromisedAt = promisedAt.String
+		order.PreparingStartedAt = preparingStartedAt.String
+
+		resp.Orders = append(resp.Orders, &order)
+		byID[order.OrderId] = &order
+		orderIDs = append(orderIDs, order.OrderId)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error while reading kitchen queue %w", err)
+	}
+	resp.Count = int32(len(resp.Orders))
+
+	if len(orderIDs) == 0 {
+		return &resp, nil
+	}
+
+	// line items of the whole queue are read at once
+	rows, err = k.db.Query(c, `
+		SELECT
+			"order_id",
+			"product_id",
+			"category_id",
+			"quantity",
+			"price"
+		FROM "order_products"
+		WHERE "order_id" = ANY($1)
+		ORDER BY "id"`, orderIDs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting order products %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			orderID string
+			p       order_service.OrderProducts
+		)
+		err = rows.Scan(
+			&orderID,
+			&p.ProductId,
+			&p.CategoryId,
+			&p.Quantity,
+			&p.Price,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning order products %w", err)
+		}
+		byID[orderID].Products = append(byID[orderID].Products, &p)
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"order_service/pkg/helper"
+
+	order_service "order_service/genproto"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// slaBreached is true when the order was finished after its promised time,
+// or is still open and the promised time has already passed
+const slaBreached = `
+			("promised_at" IS NOT NULL AND COALESCE(
+				(SELECT MAX(h."changed_at") FROM "order_status_history" h
+				WHERE h."order_id" = "orders"."order_id" AND h."status" = 'finished'),
+				NOW()
+			) > "promised_at")`
+
+func addStatusHistory(c context.Context, tx pgx.Tx, orderID, status string) error {
+	_, err := tx.Exec(c, `
+		INSERT INTO "order_status_history"(
+			"order_id",
+			"status",
+			"changed_at"
+		) VALUES ($1, $2, NOW())`,
+		orderID, status,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add status history: %w", err)
+	}
+
+	return nil
+}
+
+func (b *orderRepo) getStatusHistory(c context.Context, orderID string) ([]*order_service.OrderStatusChange, error) {
+	query := `
+		SELECT
+			"status",
+			"changed_at"::text
+		FROM "order_status_history"
+		WHERE "order_id" = $1
+		ORDER BY "changed_at", "id"`
+
+	rows, err := b.db.Query(c, query, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting order status history %w", err)
+	}
+	defer rows.Close()
+
+	var history []*order_service.OrderStatusChange
+	for rows.Next() {
+		var h order_service.OrderStatusChange
+		err = rows.Scan(
+			&h.Status,
+			&h.ChangedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning order status history %w", err)
+		}
+		history = append(history, &h)
+	}
+
+	return history, nil
+}
+
+// GetQueueLength counts the orders of the branch that are still waiting to leave it
+func (b *orderRepo) GetQueueLength(c context.Context, branchID int32) (int32, error) {
+	query := `
+		SELECT count(1) FROM "orders"
+		WHERE "branch_id" = $1 AND "deleted_at" IS NULL
+			AND "status" IN ('accepted', 'courier_accepted', 'preparing', 'ready_in_branch')`
+
+	var count int32
+	err := b.db.QueryRow(c, query, branchID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get branch queue length: %w", err)
+	}
+
+	return count, nil
+}
+
+func (b *orderRepo) GetLateList(c context.Context, req *order_service.ListLateOrdersRequest) (*order_service.ListOrderResponse, error) {
+	var (
+		resp   order_service.ListOrderResponse
+		filter = ` WHERE "deleted_at" IS NULL AND "status" <> 'finished' AND "promised_at" < NOW() `
+		params = make(map[string]interface{})
+	)
+
+	if req.BranchId != 0 {
+		filter += ` AND "branch_id" = :branch_id`
+		params["branch_id"] = req.BranchId
+	}
+
+	countQuery := `SELECT count(1) FROM "orders" ` + filter
+
+	q, arr := helper.ReplaceQueryParams(countQuery, params)
+	err := b.db.QueryRow(c, q, arr...).Scan(&resp.Count)
+	if err != nil {
+		return nil, fmt.Errorf("error while scanning count %w", err)
+	}
+
+	query := `
+		SELECT
+			"id",
+			"order_id",
+			"client_id",
+			"branch_id",
+			"type",
+			"address",
+			"courier_id",
+			"price",
+			"delivery_price",
+			"discount",
+			"status",
+			"payment_type",
+			"created_at"::text,
+			"updated_at"::text,
+			"payment_status",
+			"promised_at"::text
+		FROM "orders" ` + filter + `
+		ORDER BY "promised_at"`
+
+	q, arr = helper.ReplaceQueryParams(query, params)
+	rows, err := b.db.Query(c, q, arr...)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting rows %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			order      order_service.Order
+			createdAt  sql.NullString
+			updatedAt  sql.NullString
+			promisedAt sql.NullString
+		)
+
+		err = rows.Scan(
+			&order.Id,
+			&order.OrderId,
+			&order.ClientId,
+			&order.BranchId,
+			&order.Type,
+			&order.Address,
+			&order.CourierId,
+			&order.Price,
+			&order.DeliveryPrice,
+			&order.Discount,
+			&order.Status,
+			&order.PaymentType,
+			&createdAt,
+			&updatedAt,
+			&order.PaymentStatus,
+			&promisedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning order err: %w", err)
+		}
+
+		order.CreatedAt = createdAt.String
+		order.UpdatedAt = updatedAt.String
+		order.PromisedAt = promisedAt.String
+		order.SlaBreached = true
+
+		resp.Orders = append(resp.Orders, &order)
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,31 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// nextOrderNumber hands out the next BRANCH-YYMMDD-NNNN number of the branch.
+// It is taken outside the order's transaction, so the number of an order that
+// fails to be created is never handed out again and nothing keyed by it can be
+// picked up by a later order.
+func nextOrderNumber(c context.Context, db *pgxpool.Pool, branchID int32) (string, error) {
+	var (
+		day    string
+		number int32
+	)
+	err := db.QueryRow(c, `
+		INSERT INTO "order_number_counters"("branch_id", "day", "last_number")
+		VALUES ($1, CURRENT_DATE, 1)
+		ON CONFLICT ("branch_id", "day") DO UPDATE SET "last_number" = "order_number_counters"."last_number" + 1
+		RETURNING to_char("day", 'YYMMDD'), "last_number"`,
+		branchID,
+	).Scan(&day, &number)
+	if err != nil {
+		return "", fmt.Errorf("failed to get next order number: %w", err)
+	}
+
+	return fmt.Sprintf("%03d-%s-%04d", branchID, day, number), nil
+}
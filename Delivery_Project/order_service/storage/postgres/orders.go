@@ -2,13 +2,20 @@ package postgres
 
 import (
 	"context"
+	"crypto/subtle"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"order_service/pkg/errs"
 	"order_service/pkg/helper"
+	"order_service/storage"
+	"slices"
+	"strings"
+	"time"
 
 	order_service "order_service/genproto"
 
-	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
@@ -22,8 +29,53 @@ func NewOrder(db *pgxpool.Pool) *orderRepo {
 	}
 }
 
-func (b *orderRepo) Create(c context.Context, req *order_service.CreateOrderRequest) (string, error) {
-	order_id := helper.GenerateUniqueID()
+func (b *orderRepo) Create(c context.Context, req *order_service.CreateOrderRequest, promisedIn time.Duration, key *storage.IdempotencyKey, spend storage.SpendBonus) (string, error) {
+	order_id, err := nextOrderNumber(c, b.db, req.BranchId)
+	if err != nil {
+		return "", err
+	}
+
+	// the points are spent before the transaction, so no promo or order row is
+	// locked while user service answers. The caller gives them back when the
+	// order is not created.
+	if spend != nil && req.BonusPoints > 0 {
+		if err = spend(c, order_id); err != nil {
+			return "", err
+		}
+	}
+
+	tx, err := b.db.Begin(c)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(c)
+
+	var (
+		price     = req.Price
+		discount  = req.Discount
+		promoCode *order_service.ApplyPromoResponse
+	)
+
+	// the promo row stays locked until commit, so its limits hold under concurrent orders
+	if req.PromoCode != "" {
+		promoCode, err = checkPromo(c, tx, &order_service.ApplyPromoRequest{
+			Code:     req.PromoCode,
+			ClientId: req.ClientId,
+			BranchId: req.BranchId,
+			OrderSum: req.Price,
+			Products: req.Products,
+		}, true)
+		if err != nil {
+			return "", err
+		}
+		price -= promoCode.Discount
+		discount += promoCode.Discount
+	}
+
+	if req.BonusPoints > price {
+		return "", errs.InvalidArgument("bonus points %.2f exceed order price %.2f", req.BonusPoints, price)
+	}
+	price -= req.BonusPoints
 
 	query := `
 		INSERT INTO "orders"(
@@ -38,33 +90,148 @@ func (b *orderRepo) Create(c context.Context, req *order_service.CreateOrderRequ
 			"discount",
 			"status",
 			"payment_type",
+			"promo_code_id",
+			"bonus_points",
+			"payment_status",
+			"distance_km",
+			"promised_at",
+			"pickup_code",
+			"address_id",
+			"address_snapshot",
+			"created_by",
 			"created_at"
 			)
-		VALUES ( $1, $2, $3, $4, $5, $6, $7, $8, $9,  'accepted', $10,NOW()) RETURNING "order_id"
+		VALUES ( $1, $2, $3, $4, $5, $6, $7, $8, $9,  'accepted', $10, NULLIF($11, 0), $12, $13, $14, NOW() + $15 * INTERVAL '1 second', $16, NULLIF($17, 0), $18, $19, NOW()) RETURNING "order_id"
 	`
 
-	var orderID string
-	err := b.db.QueryRow(c, query,
+	var (
+		orderID     string
+		promoCodeId int32
+	)
+	if promoCode != nil {
+		promoCodeId = promoCode.PromoCodeId
+	}
+
+	// card orders wait for the payment webhook before they go further
+	paymentStatus := "not_required"
+	if req.PaymentType == "card" {
+		paymentStatus = "pending"
+	}
+
+	var pickupCode string
+	if req.Type == "pick_up" {
+		pickupCode, err = helper.GeneratePickupCode()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	var addressSnapshot []byte
+	if req.AddressDetails != nil {
+		addressSnapshot, err = json.Marshal(req.AddressDetails)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal order address: %w", err)
+		}
+	}
+
+	err = tx.QueryRow(c, query,
 		order_id,
 		req.ClientId,
 		req.BranchId,
 		req.Type,
 		req.Address,
 		req.CourierId,
-		req.Price,
+		price,
 		req.DeliveryPrice,
-		req.Discount,
+		discount,
 		req.PaymentType,
+		promoCodeId,
+		req.BonusPoints,
+		paymentStatus,
+		req.DistanceKm,
+		int64(promisedIn.Seconds()),
+		pickupCode,
+		req.AddressId,
+		addressSnapshot,
+		actor(c),
 	).Scan(&orderID)
 	if err != nil {
 		return "", fmt.Errorf("failed to create order: %w", err)
 	}
 
-	return fmt.Sprintf("created with orderID: %s", orderID), nil
+	if err = addStatusHistory(c, tx, orderID, "accepted"); err != nil {
+		return "", err
+	}
+
+	if key != nil {
+		if err = claimIdempotencyKey(c, tx, key, orderID); err != nil {
+			return "", err
+		}
+	}
+
+	for _, p := range req.Products {
+		_, err = tx.Exec(c, `
+			INSERT INTO "order_products"(
+				"order_id",
+				"product_id",
+				"category_id",
+				"quantity",
+				"price"
+			) VALUES ($1, $2, $3, $4, $5)`,
+			orderID,
+			p.ProductId,
+			p.CategoryId,
+			p.Quantity,
+			p.Price,
+		)
+		if err != nil {
+			return "", fmt.Errorf("failed to create order products: %w", err)
+		}
+	}
+
+	if promoCode != nil {
+		_, err = tx.Exec(c, `
+			INSERT INTO "promo_code_redemptions"(
+				"promo_code_id",
+				"client_id",
+				"order_id",
+				"discount",
+				"created_at"
+			) VALUES ($1, $2, $3, $4, NOW())`,
+			promoCode.PromoCodeId,
+			req.ClientId,
+			orderID,
+			promoCode.Discount,
+		)
+		if err != nil {
+			return "", fmt.Errorf("failed to redeem promo code: %w", err)
+		}
+
+		_, err = tx.Exec(c, `UPDATE "promo_codes" SET "used_count" = "used_count" + 1 WHERE "id" = $1`, promoCode.PromoCodeId)
+		if err != nil {
+			return "", fmt.Errorf("failed to update promo code usage: %w", err)
+		}
+	}
+
+	if err = tx.Commit(c); err != nil {
+		return "", fmt.Errorf("failed to commit order: %w", err)
+	}
+
+	return orderID, nil
+
+}
+
+func (b *orderRepo) Get(c context.Context, req *order_service.IdStrRequest) (*order_service.Order, error) {
+	return b.get(c, req, `"deleted_at" IS NULL`)
+}
 
+// GetWithDeleted gets an order even if it is deleted
+func (b *orderRepo) GetWithDeleted(c context.Context, req *order_service.IdStrRequest) (*order_service.Order, error) {
+	return b.get(c, req, `TRUE`)
 }
 
-func (b *orderRepo) Get(c context.Context, req *order_service.IdStrRequest) (resp *order_service.Order, err error) {
+// get gets the order of scope with the order_id of req
+func (b *orderRepo) get(c context.Context, req *order_service.IdStrRequest, scope string) (resp *order_service.Order, err error) {
 	query := `
 		SELECT 
 			"id",
@@ -80,13 +247,24 @@ func (b *orderRepo) Get(c context.Context, req *order_service.IdStrRequest) (res
 			"status",
 			"payment_type",
 			"created_at",
-			"updated_at" 
+			"updated_at",
+			COALESCE((SELECT "code" FROM "promo_codes" WHERE "id" = "promo_code_id"), ''),
+			"bonus_points",
+			"payment_status",
+			"distance_km",
+			"promised_at"::text,
+			` + slaBreached + `,
+			"pickup_code",
+			COALESCE("address_id", 0),
+			"address_snapshot"
 		FROM "orders" 
-		WHERE "order_id"=$1 AND "deleted_at" IS NULL `
+		WHERE "order_id"=$1 AND ` + scope
 
 	var (
-		createdAt sql.NullString
-		updatedAt sql.NullString
+		createdAt       sql.NullString
+		updatedAt       sql.NullString
+		promisedAt      sql.NullString
+		addressSnapshot []byte
 	)
 
 	order := order_service.Order{}
@@ -105,10 +283,19 @@ func (b *orderRepo) Get(c context.Context, req *order_service.IdStrRequest) (res
 		&order.PaymentType,
 		&createdAt,
 		&updatedAt,
+		&order.PromoCode,
+		&order.BonusPoints,
+		&order.PaymentStatus,
+		&order.DistanceKm,
+		&promisedAt,
+		&order.SlaBreached,
+		&order.PickupCode,
+		&order.AddressId,
+		&addressSnapshot,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("order not found")
+			return nil, errs.NotFound("order not found")
 		}
 		return nil, fmt.Errorf("failed to get order: %w", err)
 	}
@@ -119,15 +306,84 @@ func (b *orderRepo) Get(c context.Context, req *order_service.IdStrRequest) (res
 	if updatedAt.Valid {
 		order.UpdatedAt = updatedAt.String
 	}
+	if promisedAt.Valid {
+		order.PromisedAt = promisedAt.String
+	}
+	if addressSnapshot != nil {
+		order.AddressDetails = &order_service.OrderAddress{}
+		if err = json.Unmarshal(addressSnapshot, order.AddressDetails); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal order address: %w", err)
+		}
+	}
+
+	order.Products, err = b.getProducts(c, order.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	order.StatusHistory, err = b.getStatusHistory(c, order.OrderId)
+	if err != nil {
+		return nil, err
+	}
 
 	return &order, nil
 }
 
+func (b *orderRepo) getProducts(c context.Context, orderID string) ([]*order_service.OrderProducts, error) {
+	query := `
+		SELECT
+			"product_id",
+			"category_id",
+			"quantity",
+			"price"
+		FROM "order_products"
+		WHERE "order_id" = $1
+		ORDER BY "id"`
+
+	rows, err := b.db.Query(c, query, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting order products %w", err)
+	}
+	defer rows.Close()
+
+	var products []*order_service.OrderProducts
+	for rows.Next() {
+		var p order_service.OrderProducts
+		err = rows.Scan(
+			&p.ProductId,
+			&p.CategoryId,
+			&p.Quantity,
+			&p.Price,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning order products %w", err)
+		}
+		products = append(products, &p)
+	}
+
+	return products, nil
+}
+
 func (b *orderRepo) GetList(c context.Context, req *order_service.ListOrderRequest) (*order_service.ListOrderResponse, error) {
+	return b.list(c, req, `"deleted_at" IS NULL`)
+}
+
+// GetDeletedList lists deleted orders that can still be restored
+func (b *orderRepo) GetDeletedList(c context.Context, req *order_service.ListDeletedRequest) (*order_service.ListOrderResponse, error) {
+	return b.list(c, &order_service.ListOrderRequest{Limit: req.Limit, Page: req.Page}, `"deleted_at" IS NOT NULL AND "purged_at" IS NULL`)
+}
+
+// GetListWithDeleted lists the orders matching req, deleted orders included
+func (b *orderRepo) GetListWithDeleted(c context.Context, req *order_service.ListOrderRequest) (*order_service.ListOrderResponse, error) {
+	return b.list(c, req, `TRUE`)
+}
+
+// list lists the orders of scope matching req
+func (b *orderRepo) list(c context.Context, req *order_service.ListOrderRequest, scope string) (*order_service.ListOrderResponse, error) {
 	var (
 		resp   order_service.ListOrderResponse
 		err    error
-		filter string = ` WHERE deleted_at IS NULL  `
+		filter string = ` WHERE ` + scope + ` `
 		params        = make(map[string]interface{})
 	)
 
@@ -156,6 +412,11 @@ func (b *orderRepo) GetList(c context.Context, req *order_service.ListOrderReque
 		params["payment_type"] = req.PaymentType
 	}
 
+	if len(req.Statuses) > 0 {
+		filter += " AND status = ANY(:statuses)"
+		params["statuses"] = req.Statuses
+	}
+
 	if req.DeliveryType != "" {
 		filter += " AND type = :delivery_type"
 		params["delivery_type"] = req.DeliveryType
@@ -171,15 +432,29 @@ func (b *orderRepo) GetList(c context.Context, req *order_service.ListOrderReque
 		params["to_price"] = req.PriceTo
 	}
 
-	countQuery := `SELECT count(1) FROM "orders"  ` + filter
+	// a cursor skips the count unless it is asked for, the orders table is
+	// too big to count on every page
+	if req.Cursor == "" || req.WithCount {
+		countQuery := `SELECT count(1) FROM "orders"  ` + filter
 
-	q, arr := helper.ReplaceQueryParams(countQuery, params)
-	err = b.db.QueryRow(c, q, arr...).Scan(
-		&resp.Count,
-	)
+		q, arr := helper.ReplaceQueryParams(countQuery, params)
+		err = b.db.QueryRow(c, q, arr...).Scan(
+			&resp.Count,
+		)
 
-	if err != nil {
-		return nil, fmt.Errorf("error while scanning count %w", err)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning count %w", err)
+		}
+	}
+
+	if req.Cursor != "" {
+		createdAt, id, err := helper.DecodeCursor(req.Cursor)
+		if err != nil {
+			return nil, errs.InvalidArgument("cursor: %s", err)
+		}
+		filter += ` AND ("created_at", "id") < (:after_created_at, :after_id)`
+		params["after_created_at"] = createdAt
+		params["after_id"] = id
 	}
 
 	query := `
@@ -197,21 +472,24 @@ func (b *orderRepo) GetList(c context.Context, req *order_service.ListOrderReque
 			"status",
 			"payment_type",
 			"created_at",
-			"updated_at" 
+			"updated_at",
+			"payment_status"
 			FROM "orders"  ` + filter
 
-	query += " ORDER BY created_at DESC LIMIT :limit OFFSET :offset"
-	params["limit"] = 10
+	// one row more than the page tells if there is a next page
+	query += ` ORDER BY "created_at" DESC, "id" DESC LIMIT :limit OFFSET :offset`
+	limit := int32(10)
 	params["offset"] = 0
 
 	if req.Limit > 0 {
-		params["limit"] = req.Limit
+		limit = req.Limit
 	}
-	if req.Page > 0 {
+	params["limit"] = limit + 1
+	if req.Page > 0 && req.Cursor == "" {
 		params["offset"] = (req.Page - 1) * req.Limit
 	}
 
-	q, arr = helper.ReplaceQueryParams(query, params)
+	q, arr := helper.ReplaceQueryParams(query, params)
 	rows, err := b.db.Query(c, q, arr...)
 	if err != nil {
 		return nil, fmt.Errorf("error while getting rows %w", err)
@@ -239,6 +517,7 @@ func (b *orderRepo) GetList(c context.Context, req *order_service.ListOrderReque
 			&order.PaymentType,
 			&createdAt,
 			&updatedAt,
+			&order.PaymentStatus,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("error while scanning order err: %w", err)
@@ -254,6 +533,12 @@ func (b *orderRepo) GetList(c context.Context, req *order_service.ListOrderReque
 		resp.Orders = append(resp.Orders, &order)
 	}
 
+	if len(resp.Orders) > int(limit) {
+		resp.Orders = resp.Orders[:limit]
+		last := resp.Orders[limit-1]
+		resp.NextCursor = helper.EncodeCursor(last.CreatedAt, int64(last.Id))
+	}
+
 	return &resp, nil
 }
 
@@ -271,11 +556,12 @@ func (b *orderRepo) Update(c context.Context, req *order_service.UpdateOrderRequ
 				"delivery_price" = $7,
 				"discount" = $8,
 				"status" = $9,
+				"updated_by" = $12,
 				"updated_at" = NOW()
 				WHERE id = $10  AND "order_id" = $11 AND "deleted_at" IS NULL`
 
 	result, err := b.db.Exec(
-		context.Background(),
+		c,
 		query,
 		req.ClientId,
 		req.BranchId,
@@ -288,6 +574,7 @@ func (b *orderRepo) Update(c context.Context, req *order_service.UpdateOrderRequ
 		req.Status,
 		req.Id,
 		req.OrderId,
+		actor(c),
 	)
 
 	if err != nil {
@@ -295,7 +582,7 @@ func (b *orderRepo) Update(c context.Context, req *order_service.UpdateOrderRequ
 	}
 
 	if result.RowsAffected() == 0 {
-		return "", fmt.Errorf("order with ID %d not found", req.Id)
+		return "", errs.NotFound("order with ID %d not found", req.Id)
 	}
 
 	return fmt.Sprintf("order with ID %d updated", req.Id), nil
@@ -310,7 +597,7 @@ func (b *orderRepo) Delete(c context.Context, req *order_service.IdRequest) (res
 				WHERE id = $1  AND "deleted_at" IS NULL`
 
 	result, err := b.db.Exec(
-		context.Background(),
+		c,
 		query,
 		req.Id,
 	)
@@ -320,22 +607,135 @@ func (b *orderRepo) Delete(c context.Context, req *order_service.IdRequest) (res
 	}
 
 	if result.RowsAffected() == 0 {
-		return "", fmt.Errorf("order with ID %d not found", req.Id)
+		return "", errs.NotFound("order with ID %d not found", req.Id)
 	}
 
 	return "deleted", nil
 }
 
+// Restore undoes the delete of an order that is not purged yet
+func (b *orderRepo) Restore(c context.Context, req *order_service.IdRequest) (string, error) {
+	result, err := b.db.Exec(c, `
+		UPDATE "orders"
+		SET
+			"deleted_at" = NULL,
+			"updated_at" = NOW(),
+			"updated_by" = $2
+		WHERE "id" = $1 AND "deleted_at" IS NOT NULL AND "purged_at" IS NULL`,
+		req.Id,
+		actor(c),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to restore order: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return "", errs.NotFound("deleted order with ID %d not found", req.Id)
+	}
+
+	return "restored", nil
+}
+
+// Purge scrubs the delivery address of orders deleted longer than retention
+// ago. The orders stay for payments, earnings and reports.
+func (b *orderRepo) Purge(c context.Context, retention time.Duration) (int64, error) {
+	tx, err := b.db.Begin(c)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(c)
+
+	count, err := scrubOrderAddresses(c, tx, `, "purged_at" = NOW()`,
+		`"deleted_at" <= NOW() - $1 * INTERVAL '1 second' AND "purged_at" IS NULL`, int64(retention.Seconds()))
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge orders: %w", err)
+	}
+
+	if err = tx.Commit(c); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return count, nil
+}
+
+// Anonymize scrubs the delivery addresses of all orders of a client and the
+// comments of their feedback. Prices, ratings and totals are kept, so reports
+// do not change.
+func (b *orderRepo) Anonymize(c context.Context, req *order_service.ClientIdRequest) (string, error) {
+	tx, err := b.db.Begin(c)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(c)
+
+	count, err := scrubOrderAddresses(c, tx, ``, `"client_id" = $1`, req.ClientId)
+	if err != nil {
+		return "", fmt.Errorf("failed to anonymize orders: %w", err)
+	}
+
+	var feedbackIds []string
+	err = tx.QueryRow(c, `
+		WITH "scrubbed" AS (
+			UPDATE "order_feedback" SET "comment" = '' WHERE "client_id" = $1 RETURNING "id"
+		)
+		SELECT COALESCE(ARRAY_AGG("id"::TEXT), '{}') FROM "scrubbed"`,
+		req.ClientId,
+	).Scan(&feedbackIds)
+	if err != nil {
+		return "", fmt.Errorf("failed to anonymize feedback: %w", err)
+	}
+
+	if err = scrubAuditLog(c, tx, "order_feedback", feedbackIds, "comment"); err != nil {
+		return "", err
+	}
+
+	if err = tx.Commit(c); err != nil {
+		return "", fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return fmt.Sprintf("%d orders of client %d anonymized", count, req.ClientId), nil
+}
+
+// scrubOrderAddresses clears the delivery address of the orders matching
+// where, in the table and in the audit log, set adds to the SET list. It
+// returns the number of scrubbed orders.
+func scrubOrderAddresses(c context.Context, tx pgx.Tx, set, where string, args ...interface{}) (int64, error) {
+	var ids []string
+	err := tx.QueryRow(c, `
+		WITH "scrubbed" AS (
+			UPDATE "orders"
+			SET
+				"address" = '',
+				"address_id" = NULL,
+				"address_snapshot" = NULL`+set+`
+			WHERE `+where+`
+			RETURNING "id"
+		)
+		SELECT COALESCE(ARRAY_AGG("id"::TEXT), '{}') FROM "scrubbed"`,
+		args...,
+	).Scan(&ids)
+	if err != nil {
+		return 0, err
+	}
+
+	err = scrubAuditLog(c, tx, "orders", ids, "address", "address_id", "address_snapshot")
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(len(ids)), nil
+}
+
 func (b *orderRepo) GetOrderStatus(c context.Context, req *order_service.OrderIdRequest) (resp *order_service.OrderStatusResponse, err error) {
 	query := `
 		SELECT "status" FROM "orders"
 		WHERE order_id = $1 AND "deleted_at" IS NULL`
 
 	status := order_service.OrderStatusResponse{}
-	err = b.db.QueryRow(c, query, req.OrderId).Scan(&status)
+	err = b.db.QueryRow(c, query, req.OrderId).Scan(&status.Status)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("order with ID %s not found", req.OrderId)
+		if err == pgx.ErrNoRows {
+			return nil, errs.NotFound("order with ID %s not found", req.OrderId)
 		}
 		return nil, fmt.Errorf("failed to get order status: %w", err)
 	}
@@ -343,46 +743,281 @@ func (b *orderRepo) GetOrderStatus(c context.Context, req *order_service.OrderId
 	return &status, nil
 }
 
+// the kitchen and the courier work in parallel, so a delivery order can be
+// claimed by a courier before or while it is prepared
+var deliveryTransitions = map[string][]string{
+	"preparing":        {"accepted", "courier_accepted"},
+	"courier_accepted": {"accepted", "preparing"},
+	"ready_in_branch":  {"courier_accepted", "preparing"},
+	"on_way":           {"ready_in_branch"},
+	"finished":         {"on_way"},
+}
+
+// pick-up orders have no courier states and are finished only by CompletePickup
+var pickupTransitions = map[string][]string{
+	"preparing":       {"accepted"},
+	"ready_in_branch": {"accepted", "preparing"},
+}
+
+// courierActiveStatuses are the statuses of the orders a courier is busy with,
+// they count against the courier's max_order_count
+var courierActiveStatuses = []string{"courier_accepted", "preparing", "ready_in_branch", "on_way"}
+
+// checkCourierLoad refuses a claim that would give the courier more than max
+// active orders. Claims of one courier wait for each other on the lock, so two
+// of them can not both see the same count.
+func checkCourierLoad(c context.Context, tx pgx.Tx, courierID, max int32, orderID string) error {
+	// the two-key form keeps claims from waiting on the courier's cash hand-overs
+	_, err := tx.Exec(c, `SELECT pg_advisory_xact_lock(hashtext('courier_orders'), $1)`, courierID)
+	if err != nil {
+		return fmt.Errorf("failed to lock courier: %w", err)
+	}
+
+	var active int32
+	err = tx.QueryRow(c, `
+		SELECT COUNT(*)
+		FROM "orders"
+		WHERE "courier_id" = $1 AND "status" = ANY($2) AND "order_id" <> $3 AND "deleted_at" IS NULL`,
+		courierID, courierActiveStatuses, orderID,
+	).Scan(&active)
+	if err != nil {
+		return fmt.Errorf("failed to count courier orders: %w", err)
+	}
+
+	if active >= max {
+		return errs.FailedPrecondition("courier with ID %d already has %d active orders", courierID, active)
+	}
+	return nil
+}
+
 func (b *orderRepo) UpdateStatus(c context.Context, req *order_service.UpdateOrderStatusRequest) (string, error) {
 
+	tx, err := b.db.Begin(c)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(c)
+
+	var (
+		orderType     string
+		status        string
+		paymentType   string
+		paymentStatus string
+		courierId     sql.NullInt32
+		branchId      int32
+		total         float64
+	)
+	err = tx.QueryRow(c, `
+		SELECT "type", "status", "payment_type", "payment_status", "courier_id", "branch_id", "price" + "delivery_price"
+		FROM "orders"
+		WHERE "order_id" = $1 AND "deleted_at" IS NULL
+		FOR UPDATE`, req.OrderId,
+	).Scan(&orderType, &status, &paymentType, &paymentStatus, &courierId, &branchId, &total)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return "", errs.NotFound("order with ID %s not found", req.OrderId)
+		}
+		return "", fmt.Errorf("failed to get order status: %w", err)
+	}
+
 	// Check if the previous status matches
-	validTransitions := map[string]string{
-		"courier_accepted": "accepted",
-		"ready_in_branch":  "courier_accepted",
-		"on_way":           "ready_in_branch",
-		"finished":         "on_way",
+	transitions := deliveryTransitions
+	if orderType == "pick_up" {
+		transitions = pickupTransitions
 	}
 
-	requiredPrevStatus, ok := validTransitions[req.Status]
+	allowedPrevStatuses, ok := transitions[req.Status]
 	if !ok {
-		return "", fmt.Errorf("invalid status: %s", req.Status)
+		return "", errs.InvalidArgument("invalid status for %s order: %s", orderType, req.Status)
 	}
 
-	if req.Status != requiredPrevStatus {
-		return "", fmt.Errorf("previous status must be '%s' to update to '%s'", requiredPrevStatus, req.Status)
+	if !slices.Contains(allowedPrevStatuses, status) {
+		return "", errs.FailedPrecondition("previous status must be one of '%s' to update to '%s'", strings.Join(allowedPrevStatuses, "', '"), req.Status)
 	}
 
-	query := `
-		UPDATE "orders" 
-		SET "status" = $1, "updated_at" = NOW()
-		WHERE "order_id" = $2 AND "deleted_at" IS NULL`
+	// unpaid card orders stay out of the kitchen until the payment webhook arrives
+	if paymentStatus != "not_required" && paymentStatus != "paid" {
+		return "", errs.FailedPrecondition("order with ID %s is not paid", req.OrderId)
+	}
 
-	result, err := b.db.Exec(
-		context.Background(),
-		query,
-		req.Status,
-		req.OrderId,
+	if req.CourierId != 0 {
+		if req.Status != "courier_accepted" {
+			return "", errs.InvalidArgument("courier_id can only be set with status 'courier_accepted'")
+		}
+		if courierId.Valid && courierId.Int32 != req.CourierId {
+			return "", errs.FailedPrecondition("order with ID %s is taken by another courier", req.OrderId)
+		}
+		if req.MaxActiveOrders > 0 {
+			if err = checkCourierLoad(c, tx, req.CourierId, req.MaxActiveOrders, req.OrderId); err != nil {
+				return "", err
+			}
+		}
+		courierId = sql.NullInt32{Int32: req.CourierId, Valid: true}
+	}
+
+	_, err = tx.Exec(c, `
+		UPDATE "orders"
+		SET "status" = $1, "courier_id" = $4, "updated_by" = $3, "updated_at" = NOW()
+		WHERE "order_id" = $2`,
+		req.Status, req.OrderId, actor(c), courierId,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to update status: %w", err)
+	}
+
+	if err = addStatusHistory(c, tx, req.OrderId, req.Status); err != nil {
+		return "", err
+	}
+
+	// the courier now holds the cash of the order until it is handed over
+	if req.Status == "finished" && paymentType == "cash" && courierId.Int32 != 0 {
+		_, err = tx.Exec(c, `
+			INSERT INTO "courier_cash_transactions"(
+				"courier_id",
+				"branch_id",
+				"type",
+				"amount",
+				"order_id",
+				"created_at"
+			) VALUES ($1, $2, 'collected', $3, $4, NOW())
+			ON CONFLICT ("order_id") WHERE "type" = 'collected' DO NOTHING`,
+			courierId.Int32, branchId, total, req.OrderId,
+		)
+		if err != nil {
+			return "", fmt.Errorf("failed to record collected cash: %w", err)
+		}
+	}
+
+	if req.Status == "finished" && courierId.Int32 != 0 {
+		if err = recordEarning(c, tx, req.OrderId); err != nil {
+			return "", err
+		}
+	}
+
+	// user service counts the order and accrues its points after commit
+	if req.Status == "finished" {
+		if err = addOutbox(c, tx, req.OrderId, storage.OutboxFinish); err != nil {
+			return "", err
+		}
+	}
+
+	if err = tx.Commit(c); err != nil {
+		return "", fmt.Errorf("failed to commit status: %w", err)
+	}
+
+	return fmt.Sprintf("status changed from '%s' to '%s'", status, req.Status), nil
+}
+
+const (
+	// maxPickupAttempts wrong pick-up codes in a row lock the order for pickupLockout
+	maxPickupAttempts = 5
+	pickupLockout     = 15 * time.Minute
+)
+
+// CompletePickup hands a ready pick-up order over to the client who showed its code
+func (b *orderRepo) CompletePickup(c context.Context, req *order_service.CompletePickupRequest) (string, error) {
+	tx, err := b.db.Begin(c)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(c)
+
+	var (
+		orderType     string
+		status        string
+		paymentStatus string
+		pickupCode    string
+		attempts      int
+		locked        bool
 	)
+	err = tx.QueryRow(c, `
+		SELECT
+			"type", "status", "payment_status", "pickup_code", "pickup_attempts",
+			COALESCE("pickup_locked_until" > NOW(), FALSE)
+		FROM "orders"
+		WHERE "order_id" = $1 AND "deleted_at" IS NULL
+		FOR UPDATE`, req.OrderId,
+	).Scan(&orderType, &status, &paymentStatus, &pickupCode, &attempts, &locked)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return "", errs.NotFound("order with ID %s not found", req.OrderId)
+		}
+		return "", fmt.Errorf("failed to get order status: %w", err)
+	}
+
+	if orderType != "pick_up" {
+		return "", errs.FailedPrecondition("order with ID %s is not a pick-up order", req.OrderId)
+	}
+
+	if status != "ready_in_branch" {
+		return "", errs.FailedPrecondition("previous status must be 'ready_in_branch' to update to 'finished'")
+	}
+
+	if paymentStatus != "not_required" && paymentStatus != "paid" {
+		return "", errs.FailedPrecondition("order with ID %s is not paid", req.OrderId)
+	}
 
+	if locked {
+		return "", errs.FailedPrecondition("too many wrong pick-up codes for order with ID %s, try again later", req.OrderId)
+	}
+
+	if pickupCode == "" || subtle.ConstantTimeCompare([]byte(pickupCode), []byte(req.PickupCode)) != 1 {
+		return "", b.failPickupAttempt(c, tx, req.OrderId, attempts+1)
+	}
+
+	_, err = tx.Exec(c, `
+		UPDATE "orders"
+		SET "status" = 'finished', "pickup_attempts" = 0, "updated_by" = $2, "updated_at" = NOW()
+		WHERE "order_id" = $1`,
+		req.OrderId, actor(c),
+	)
 	if err != nil {
 		return "", fmt.Errorf("failed to update status: %w", err)
 	}
 
-	if result.RowsAffected() == 0 {
-		return "", fmt.Errorf("order with ID %s not found", req.OrderId)
+	if err = addStatusHistory(c, tx, req.OrderId, "finished"); err != nil {
+		return "", err
+	}
+
+	if err = addOutbox(c, tx, req.OrderId, storage.OutboxFinish); err != nil {
+		return "", err
+	}
+
+	if err = tx.Commit(c); err != nil {
+		return "", fmt.Errorf("failed to commit status: %w", err)
+	}
+
+	return "status changed from 'ready_in_branch' to 'finished'", nil
+}
+
+// failPickupAttempt counts a wrong pick-up code, the last allowed attempt
+// locks the order and starts the count again
+func (b *orderRepo) failPickupAttempt(c context.Context, tx pgx.Tx, orderID string, attempts int) error {
+	lock := attempts >= maxPickupAttempts
+	if lock {
+		attempts = 0
+	}
+
+	_, err := tx.Exec(c, `
+		UPDATE "orders"
+		SET
+			"pickup_attempts" = $2,
+			"pickup_locked_until" = CASE WHEN $3::BOOLEAN THEN NOW() + make_interval(secs => $4) END
+		WHERE "order_id" = $1`,
+		orderID, attempts, lock, pickupLockout.Seconds(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to count pick-up attempt: %w", err)
+	}
+
+	if err = tx.Commit(c); err != nil {
+		return fmt.Errorf("failed to commit pick-up attempt: %w", err)
 	}
 
-	return fmt.Sprintf("status changed from '%s' to '%s'", requiredPrevStatus, req.Status), nil
+	if lock {
+		return errs.FailedPrecondition("too many wrong pick-up codes for order with ID %s, try again later", orderID)
+	}
+	return errs.InvalidArgument("wrong pick-up code for order with ID %s, %d attempts left", orderID, maxPickupAttempts-attempts)
 }
 
 func (b *orderRepo) GetAllAcceptableOrders(c context.Context, req *order_service.IdRequest) (resp *order_service.Order, err error) {
@@ -403,7 +1038,7 @@ func (b *orderRepo) GetAllAcceptableOrders(c context.Context, req *order_service
 			"created_at",
 			"updated_at" 
 		FROM "orders" 
-		WHERE "courier"=$1 AND "deleted_at" IS NULL AND  status ='ready_in_branch'  and status ='accepted'`
+		WHERE "courier"=$1 AND "deleted_at" IS NULL AND  status ='ready_in_branch'  and status ='accepted' AND "payment_status" IN ('not_required', 'paid') AND "type" <> 'pick_up'`
 
 	var (
 		createdAt sql.NullString
@@ -429,7 +1064,7 @@ func (b *orderRepo) GetAllAcceptableOrders(c context.Context, req *order_service
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("order not found")
+			return nil, errs.NotFound("order not found")
 		}
 		return nil, fmt.Errorf("failed to get order: %w", err)
 	}
@@ -487,7 +1122,7 @@ func (b *orderRepo) GetAllAcceptedOrders(c context.Context, req *order_service.I
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("order not found")
+			return nil, errs.NotFound("order not found")
 		}
 		return nil, fmt.Errorf("failed to get order: %w", err)
 	}
@@ -501,3 +1136,26 @@ func (b *orderRepo) GetAllAcceptedOrders(c context.Context, req *order_service.I
 
 	return &order, nil
 }
+
+// CountByStatus counts the orders in every status, used by the metrics
+func (b *orderRepo) CountByStatus(c context.Context) (map[string]int64, error) {
+	rows, err := b.db.Query(c, `SELECT "status", COUNT(*) FROM "orders" WHERE "deleted_at" IS NULL GROUP BY "status"`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count orders by status: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int64)
+	for rows.Next() {
+		var (
+			status string
+			count  int64
+		)
+		if err = rows.Scan(&status, &count); err != nil {
+			return nil, fmt.Errorf("error while scanning order status count err: %w", err)
+		}
+		counts[status] = count
+	}
+
+	return counts, rows.Err()
+}
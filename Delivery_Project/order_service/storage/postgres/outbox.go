@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"order_service/storage"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type outboxRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewOutbox(db *pgxpool.Pool) *outboxRepo {
+	return &outboxRepo{
+		db: db,
+	}
+}
+
+const addOutboxQuery = `
+	INSERT INTO "order_outbox"("order_id", "kind", "run_at", "created_at")
+	VALUES ($1, $2, NOW(), NOW())
+	ON CONFLICT ("order_id", "kind") DO NOTHING`
+
+// addOutbox queues the job of the order with the change that owes it, a job
+// that is queued already is kept
+func addOutbox(c context.Context, tx pgx.Tx, orderID, kind string) error {
+	if _, err := tx.Exec(c, addOutboxQuery, orderID, kind); err != nil {
+		return fmt.Errorf("failed to queue %s of order %s: %w", kind, orderID, err)
+	}
+
+	return nil
+}
+
+// Add queues a job that is not part of a change, e.g. a refund that failed
+func (b *outboxRepo) Add(c context.Context, orderID, kind string) error {
+	if _, err := b.db.Exec(c, addOutboxQuery, orderID, kind); err != nil {
+		return fmt.Errorf("failed to queue %s of order %s: %w", kind, orderID, err)
+	}
+
+	return nil
+}
+
+// Due returns up to limit jobs whose run_at has passed, oldest first
+func (b *outboxRepo) Due(c context.Context, limit int) ([]*storage.OutboxJob, error) {
+	rows, err := b.db.Query(c, `
+		SELECT "order_id", "kind", "attempts"
+		FROM "order_outbox"
+		WHERE "run_at" <= NOW()
+		ORDER BY "run_at", "created_at"
+		LIMIT $1`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get outbox jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []*storage.OutboxJob
+	for rows.Next() {
+		var job storage.OutboxJob
+		if err = rows.Scan(&job.OrderID, &job.Kind, &job.Attempts); err != nil {
+			return nil, fmt.Errorf("failed to scan outbox job: %w", err)
+		}
+		jobs = append(jobs, &job)
+	}
+
+	return jobs, rows.Err()
+}
+
+// Done removes a job user service took
+func (b *outboxRepo) Done(c context.Context, job *storage.OutboxJob) error {
+	_, err := b.db.Exec(c, `DELETE FROM "order_outbox" WHERE "order_id" = $1 AND "kind" = $2`, job.OrderID, job.Kind)
+	if err != nil {
+		return fmt.Errorf("failed to delete outbox job: %w", err)
+	}
+
+	return nil
+}
+
+// Retry puts the job off by after and remembers why it failed
+func (b *outboxRepo) Retry(c context.Context, job *storage.OutboxJob, after time.Duration, cause error) error {
+	_, err := b.db.Exec(c, `
+		UPDATE "order_outbox"
+		SET "attempts" = "attempts" + 1, "last_error" = $3, "run_at" = NOW() + $4 * INTERVAL '1 second'
+		WHERE "order_id" = $1 AND "kind" = $2`,
+		job.OrderID, job.Kind, cause.Error(), int64(after.Seconds()),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to put off outbox job: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"order_service/pkg/errs"
+
+	order_service "order_service/genproto"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type paymentRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewPayment(db *pgxpool.Pool) *paymentRepo {
+	return &paymentRepo{
+		db: db,
+	}
+}
+
+const paymentColumns = `
+			"id",
+			"order_id",
+			"provider",
+			"external_id",
+			"amount",
+			"status",
+			"payment_url",
+			"created_at"::text,
+			"updated_at"::text`
+
+// Create stores a new intent for the order. A retry replaces an intent that
+// was not paid, a paid one is never overwritten.
+func (b *paymentRepo) Create(c context.Context, req *order_service.Payment) (*order_service.Payment, error) {
+	query := `
+		INSERT INTO "payments"(
+			"order_id",
+			"provider",
+			"external_id",
+			"amount",
+			"status",
+			"payment_url",
+			"created_at"
+		) VALUES ($1, $2, $3, $4, 'pending', $5, NOW())
+		ON CONFLICT ("order_id") DO UPDATE SET
+			"provider" = EXCLUDED."provider",
+			"external_id" = EXCLUDED."external_id",
+			"amount" = EXCLUDED."amount",
+			"status" = 'pending',
+			"payment_url" = EXCLUDED."payment_url",
+			"updated_at" = NOW()
+		WHERE "payments"."status" <> 'paid'
+		RETURNING ` + paymentColumns
+
+	resp, err := scanPayment(b.db.QueryRow(c, query,
+		req.OrderId,
+		req.Provider,
+		req.ExternalId,
+		req.Amount,
+		req.PaymentUrl,
+	))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errs.Conflict("order %s is already paid", req.OrderId)
+		}
+		return nil, fmt.Errorf("failed to create payment: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (b *paymentRepo) Get(c context.Context, req *order_service.OrderIdRequest) (*order_service.Payment, error) {
+	query := `SELECT ` + paymentColumns + ` FROM "payments" WHERE "order_id" = $1`
+
+	resp, err := scanPayment(b.db.QueryRow(c, query, req.OrderId))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errs.NotFound("payment for order %s not found", req.OrderId)
+		}
+		return nil, fmt.Errorf("failed to get payment: %w", err)
+	}
+
+	return resp, nil
+}
+
+// UpdateStatus moves the payment and the order's payment_status together, a
+// paid or cancelled payment is final and fails with FailedPrecondition
+func (b *paymentRepo) UpdateStatus(c context.Context, orderID, status string) error {
+	tx, err := b.db.Begin(c)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(c)
+
+	result, err := tx.Exec(c, `
+		UPDATE "payments" SET "status" = $1, "updated_at" = NOW()
+		WHERE "order_id" = $2 AND "status" NOT IN ('paid', 'cancelled')`,
+		status, orderID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update payment status: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		var current string
+		err = tx.QueryRow(c, `SELECT "status" FROM "payments" WHERE "order_id" = $1`, orderID).Scan(&current)
+		if err == pgx.ErrNoRows {
+			return errs.NotFound("payment for order %s not found", orderID)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get payment status: %w", err)
+		}
+		return errs.FailedPrecondition("payment for order %s is already %s", orderID, current)
+	}
+
+	_, err = tx.Exec(c, `UPDATE "orders" SET "payment_status" = $1, "updated_at" = NOW() WHERE "order_id" = $2`, status, orderID)
+	if err != nil {
+		return fmt.Errorf("failed to update order payment status: %w", err)
+	}
+
+	return tx.Commit(c)
+}
+
+func scanPayment(row pgx.Row) (*order_service.Payment, error) {
+	var (
+		payment   order_service.Payment
+		createdAt sql.NullString
+		updatedAt sql.NullString
+	)
+
+	err := row.Scan(
+		&payment.Id,
+		&payment.OrderId,
+		&payment.Provider,
+		&payment.ExternalId,
+		&payment.Amount,
+		&payment.Status,
+		&payment.PaymentUrl,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if createdAt.Valid {
+		payment.CreatedAt = createdAt.String
+	}
+	if updatedAt.Valid {
+		payment.UpdatedAt = updatedAt.String
+	}
+
+	return &payment, nil
+}
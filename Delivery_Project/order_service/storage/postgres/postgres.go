@@ -13,6 +13,16 @@ type strg struct {
 	db             *pgxpool.Pool
 	order          *orderRepo
 	deliveryTariff *tariffRepo
+	promoCode      *promoCodeRepo
+	payment        *paymentRepo
+	courierCash    *courierCashRepo
+	scheme         *compensationSchemeRepo
+	earnings       *courierEarningsRepo
+	feedback       *feedbackRepo
+	kitchen        *kitchenRepo
+	idempotency    *idempotencyRepo
+	audit          *auditRepo
+	outbox         *outboxRepo
 }
 
 func NewStorage(ctx context.Context, cfg config.Config) (storage.StorageI, error) {
@@ -32,6 +42,7 @@ func NewStorage(ctx context.Context, cfg config.Config) (storage.StorageI, error
 	}
 
 	config.MaxConns = cfg.PostgresMaxConnections
+	config.BeforeAcquire = setAuditSession
 	pool, err := pgxpool.ConnectConfig(ctx, config)
 	if err != nil {
 		fmt.Println("ConnectConfig:", err.Error())
@@ -43,6 +54,18 @@ func NewStorage(ctx context.Context, cfg config.Config) (storage.StorageI, error
 	}, nil
 }
 
+func (d *strg) Ping(ctx context.Context) error {
+	return d.db.Ping(ctx)
+}
+
+func (d *strg) Stat() *pgxpool.Stat {
+	return d.db.Stat()
+}
+
+func (d *strg) CloseDB() {
+	d.db.Close()
+}
+
 func (d *strg) Order() storage.OrderI {
 	if d.order == nil {
 		d.order = NewOrder(d.db)
@@ -56,3 +79,73 @@ func (d *strg) DeliveryTariff() storage.DeliveryTariffI {
 	}
 	return d.deliveryTariff
 }
+
+func (d *strg) PromoCode() storage.PromoCodeI {
+	if d.promoCode == nil {
+		d.promoCode = NewPromoCode(d.db)
+	}
+	return d.promoCode
+}
+
+func (d *strg) Payment() storage.PaymentI {
+	if d.payment == nil {
+		d.payment = NewPayment(d.db)
+	}
+	return d.payment
+}
+
+func (d *strg) CourierCash() storage.CourierCashI {
+	if d.courierCash == nil {
+		d.courierCash = NewCourierCash(d.db)
+	}
+	return d.courierCash
+}
+
+func (d *strg) CompensationScheme() storage.CompensationSchemeI {
+	if d.scheme == nil {
+		d.scheme = NewCompensationScheme(d.db)
+	}
+	return d.scheme
+}
+
+func (d *strg) CourierEarnings() storage.CourierEarningsI {
+	if d.earnings == nil {
+		d.earnings = NewCourierEarnings(d.db)
+	}
+	return d.earnings
+}
+
+func (d *strg) Feedback() storage.FeedbackI {
+	if d.feedback == nil {
+		d.feedback = NewFeedback(d.db)
+	}
+	return d.feedback
+}
+
+func (d *strg) Kitchen() storage.KitchenI {
+	if d.kitchen == nil {
+		d.kitchen = NewKitchen(d.db)
+	}
+	return d.kitchen
+}
+
+func (d *strg) Idempotency() storage.IdempotencyI {
+	if d.idempotency == nil {
+		d.idempotency = NewIdempotency(d.db)
+	}
+	return d.idempotency
+}
+
+func (d *strg) Audit() storage.AuditI {
+	if d.audit == nil {
+		d.audit = NewAudit(d.db)
+	}
+	return d.audit
+}
+
+func (d *strg) Outbox() storage.OutboxI {
+	if d.outbox == nil {
+		d.outbox = NewOutbox(d.db)
+	}
+	return d.outbox
+}
@@ -0,0 +1,414 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"order_service/pkg/errs"
+	"strings"
+
+	order_service "order_service/genproto"
+	"order_service/pkg/helper"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type promoCodeRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewPromoCode(db *pgxpool.Pool) *promoCodeRepo {
+	return &promoCodeRepo{
+		db: db,
+	}
+}
+
+// querier is satisfied by both *pgxpool.Pool and pgx.Tx
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+func (b *promoCodeRepo) Create(c context.Context, req *order_service.CreatePromoCodeRequest) (string, error) {
+	query := `
+		INSERT INTO "promo_codes"(
+			"code",
+			"discount_type",
+			"discount_value",
+			"min_order_sum",
+			"valid_from",
+			"valid_to",
+			"usage_limit",
+			"per_client_limit",
+			"branch_ids",
+			"category_ids",
+			"created_by",
+			"created_at"
+			)
+		VALUES ($1, $2, $3, $4, NULLIF($5, '')::timestamp, NULLIF($6, '')::timestamp, $7, $8, $9, $10, $11, NOW()) RETURNING "id"
+	`
+
+	var id int
+	err := b.db.QueryRow(c, query,
+		strings.ToUpper(req.Code),
+		req.DiscountType,
+		req.DiscountValue,
+		req.MinOrderSum,
+		req.ValidFrom,
+		req.ValidTo,
+		req.UsageLimit,
+		req.PerClientLimit,
+		nonNilInt32(req.BranchIds),
+		nonNilInt32(req.CategoryIds),
+		actor(c),
+	).Scan(&id)
+	if err != nil {
+		return "", fmt.Errorf("failed to create promo code: %w", err)
+	}
+
+	return fmt.Sprintf("created promo code with ID: %d", id), nil
+}
+
+func (b *promoCodeRepo) Get(c context.Context, req *order_service.IdRequest) (*order_service.PromoCode, error) {
+	query := `
+		SELECT
+			"id",
+			"code",
+			"discount_type",
+			"discount_value",
+			"min_order_sum",
+			"valid_from"::text,
+			"valid_to"::text,
+			"usage_limit",
+			"per_client_limit",
+			"used_count",
+			"branch_ids",
+			"category_ids",
+			"active",
+			"created_at"::text,
+			"updated_at"::text
+		FROM "promo_codes"
+		WHERE "id" = $1 AND "deleted_at" IS NULL`
+
+	promo, err := scanPromoCode(b.db.QueryRow(c, query, req.Id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errs.NotFound("promo code not found")
+		}
+		return nil, fmt.Errorf("failed to get promo code: %w", err)
+	}
+
+	return promo, nil
+}
+
+func (b *promoCodeRepo) GetList(c context.Context, req *order_service.ListPromoCodeRequest) (*order_service.ListPromoCodeResponse, error) {
+	var (
+		resp   order_service.ListPromoCodeResponse
+		err    error
+		filter string = ` WHERE "deleted_at" IS NULL `
+		params        = make(map[string]interface{})
+	)
+
+	if req.Search != "" {
+		filter += ` AND "code" ILIKE '%' || :search || '%' `
+		params["search"] = req.Search
+	}
+
+	countQuery := `SELECT count(1) FROM "promo_codes" ` + filter
+
+	q, arr := helper.ReplaceQueryParams(countQuery, params)
+	err = b.db.QueryRow(c, q, arr...).Scan(
+		&resp.Count,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error while scanning count %w", err)
+	}
+
+	query := `
+		SELECT
+			"id",
+			"code",
+			"discount_type",
+			"discount_value",
+			"min_order_sum",
+			"valid_from"::text,
+			"valid_to"::text,
+			"usage_limit",
+			"per_client_limit",
+			"used_count",
+			"branch_ids",
+			"category_ids",
+			"active",
+			"created_at"::text,
+			"updated_at"::text
+		FROM "promo_codes" ` + filter
+
+	query += " ORDER BY created_at DESC LIMIT :limit OFFSET :offset"
+	params["limit"] = 10
+	params["offset"] = 0
+
+	if req.Limit > 0 {
+		params["limit"] = req.Limit
+	}
+	if req.Page > 0 {
+		params["offset"] = (req.Page - 1) * req.Limit
+	}
+
+	q, arr = helper.ReplaceQueryParams(query, params)
+	rows, err := b.db.Query(c, q, arr...)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting rows %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		promo, err := scanPromoCode(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning promo code err: %w", err)
+		}
+		resp.PromoCodes = append(resp.PromoCodes, promo)
+	}
+
+	return &resp, nil
+}
+
+func (b *promoCodeRepo) Update(c context.Context, req *order_service.UpdatePromoCodeRequest) (string, error) {
+	query := `
+		UPDATE "promo_codes"
+		SET
+		"code" = $1,
+		"discount_type" = $2,
+		"discount_value" = $3,
+		"min_order_sum" = $4,
+		"valid_from" = NULLIF($5, '')::timestamp,
+		"valid_to" = NULLIF($6, '')::timestamp,
+		"usage_limit" = $7,
+		"per_client_limit" = $8,
+		"branch_ids" = $9,
+		"category_ids" = $10,
+		"active" = $11,
+		"updated_by" = $13,
+		"updated_at" = NOW()
+		WHERE "id" = $12 AND "deleted_at" IS NULL`
+
+	result, err := b.db.Exec(c, query,
+		strings.ToUpper(req.Code),
+		req.DiscountType,
+		req.DiscountValue,
+		req.MinOrderSum,
+		req.ValidFrom,
+		req.ValidTo,
+		req.UsageLimit,
+		req.PerClientLimit,
+		nonNilInt32(req.BranchIds),
+		nonNilInt32(req.CategoryIds),
+		req.Active,
+		req.Id,
+		actor(c),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to update promo code: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return "", errs.NotFound("promo code with ID %d not found", req.Id)
+	}
+
+	return fmt.Sprintf("promo code with ID %d updated", req.Id), nil
+}
+
+func (b *promoCodeRepo) Delete(c context.Context, req *order_service.IdRequest) (string, error) {
+	query := `
+		UPDATE "promo_codes"
+		SET
+		"deleted_at" = NOW()
+		WHERE "id" = $1 AND "deleted_at" IS NULL`
+
+	result, err := b.db.Exec(c, query, req.Id)
+	if err != nil {
+		return "", fmt.Errorf("failed to delete promo code: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return "", errs.NotFound("promo code with ID %d not found", req.Id)
+	}
+
+	return "deleted", nil
+}
+
+func (b *promoCodeRepo) Apply(c context.Context, req *order_service.ApplyPromoRequest) (*order_service.ApplyPromoResponse, error) {
+	return checkPromo(c, b.db, req, false)
+}
+
+// checkPromo validates the promo code against the order and returns the
+// discount it gives. With forUpdate the promo row is locked, so callers
+// inside a transaction can record the redemption without racing the limits.
+func checkPromo(c context.Context, db querier, req *order_service.ApplyPromoRequest, forUpdate bool) (*order_service.ApplyPromoResponse, error) {
+	query := `
+		SELECT
+			"id",
+			"code",
+			"discount_type",
+			"discount_value",
+			"min_order_sum",
+			"valid_from"::text,
+			"valid_to"::text,
+			"usage_limit",
+			"per_client_limit",
+			"used_count",
+			"branch_ids",
+			"category_ids",
+			"active",
+			"created_at"::text,
+			"updated_at"::text
+		FROM "promo_codes"
+		WHERE UPPER("code") = UPPER($1) AND "deleted_at" IS NULL`
+	if forUpdate {
+		query += ` FOR UPDATE`
+	}
+
+	promo, err := scanPromoCode(db.QueryRow(c, query, req.Code))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errs.NotFound("promo code %s not found", req.Code)
+		}
+		return nil, fmt.Errorf("failed to get promo code: %w", err)
+	}
+
+	if !promo.Active {
+		return nil, errs.FailedPrecondition("promo code %s is not active", promo.Code)
+	}
+
+	var inWindow bool
+	err = db.QueryRow(c, `
+		SELECT (NULLIF($1, '')::timestamp IS NULL OR NULLIF($1, '')::timestamp <= NOW())
+		   AND (NULLIF($2, '')::timestamp IS NULL OR NULLIF($2, '')::timestamp >= NOW())`,
+		promo.ValidFrom, promo.ValidTo,
+	).Scan(&inWindow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check promo code validity: %w", err)
+	}
+	if !inWindow {
+		return nil, errs.FailedPrecondition("promo code %s is expired or not started yet", promo.Code)
+	}
+
+	if req.OrderSum < promo.MinOrderSum {
+		return nil, errs.FailedPrecondition("promo code %s requires order sum at least %.2f", promo.Code, promo.MinOrderSum)
+	}
+
+	if promo.UsageLimit > 0 && promo.UsedCount >= promo.UsageLimit {
+		return nil, errs.FailedPrecondition("promo code %s usage limit reached", promo.Code)
+	}
+
+	if len(promo.BranchIds) > 0 && !containsInt32(promo.BranchIds, req.BranchId) {
+		return nil, errs.FailedPrecondition("promo code %s is not valid for branch %d", promo.Code, req.BranchId)
+	}
+
+	if promo.PerClientLimit > 0 {
+		var used int32
+		err = db.QueryRow(c,
+			`SELECT count(1) FROM "promo_code_redemptions" WHERE "promo_code_id" = $1 AND "client_id" = $2`,
+			promo.Id, req.ClientId,
+		).Scan(&used)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count promo code redemptions: %w", err)
+		}
+		if used >= promo.PerClientLimit {
+			return nil, errs.Conflict("promo code %s already used by client %d", promo.Code, req.ClientId)
+		}
+	}
+
+	// with category restriction only matching products are discounted
+	base := req.OrderSum
+	if len(promo.CategoryIds) > 0 {
+		base = 0
+		for _, p := range req.Products {
+			if containsInt32(promo.CategoryIds, p.CategoryId) {
+				base += p.Price * float64(p.Quantity)
+			}
+		}
+		if base == 0 {
+			return nil, errs.FailedPrecondition("promo code %s is not valid for products in the order", promo.Code)
+		}
+	}
+
+	var discount float64
+	switch promo.DiscountType {
+	case "percent":
+		discount = base * promo.DiscountValue / 100
+	case "fixed":
+		discount = promo.DiscountValue
+	}
+	discount = math.Min(discount, math.Min(base, req.OrderSum))
+
+	return &order_service.ApplyPromoResponse{
+		PromoCodeId: promo.Id,
+		Code:        promo.Code,
+		Discount:    discount,
+		Total:       req.OrderSum - discount,
+	}, nil
+}
+
+func scanPromoCode(row pgx.Row) (*order_service.PromoCode, error) {
+	var (
+		promo     order_service.PromoCode
+		validFrom sql.NullString
+		validTo   sql.NullString
+		createdAt sql.NullString
+		updatedAt sql.NullString
+	)
+
+	err := row.Scan(
+		&promo.Id,
+		&promo.Code,
+		&promo.DiscountType,
+		&promo.DiscountValue,
+		&promo.MinOrderSum,
+		&validFrom,
+		&validTo,
+		&promo.UsageLimit,
+		&promo.PerClientLimit,
+		&promo.UsedCount,
+		&promo.BranchIds,
+		&promo.CategoryIds,
+		&promo.Active,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if validFrom.Valid {
+		promo.ValidFrom = validFrom.String
+	}
+	if validTo.Valid {
+		promo.ValidTo = validTo.String
+	}
+	if createdAt.Valid {
+		promo.CreatedAt = createdAt.String
+	}
+	if updatedAt.Valid {
+		promo.UpdatedAt = updatedAt.String
+	}
+
+	return &promo, nil
+}
+
+func containsInt32(arr []int32, v int32) bool {
+	for _, a := range arr {
+		if a == v {
+			return true
+		}
+	}
+	return false
+}
+
+// nonNilInt32 keeps NOT NULL array columns happy when the list is empty
+func nonNilInt32(arr []int32) []int32 {
+	if arr == nil {
+		return []int32{}
+	}
+	return arr
+}
@@ -0,0 +1,215 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"strings"
+	"testing"
+
+	order_service "order_service/genproto"
+	"order_service/pkg/errs"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// fakePromoDB answers the queries of checkPromo
+type fakePromoDB struct {
+	promo    *order_service.PromoCode
+	inWindow bool
+	used     int32
+}
+
+func (db *fakePromoDB) QueryRow(_ context.Context, query string, _ ...interface{}) pgx.Row {
+	switch {
+	case strings.Contains(query, `"promo_code_redemptions"`):
+		return fakeRow{values: []interface{}{db.used}}
+	case strings.Contains(query, `"promo_codes"`):
+		if db.promo == nil {
+			return fakeRow{err: pgx.ErrNoRows}
+		}
+		p := db.promo
+		return fakeRow{values: []interface{}{
+			p.Id, p.Code, p.DiscountType, p.DiscountValue, p.MinOrderSum,
+			sql.NullString{}, sql.NullString{},
+			p.UsageLimit, p.PerClientLimit, p.UsedCount, p.BranchIds, p.CategoryIds, p.Active,
+			sql.NullString{}, sql.NullString{},
+		}}
+	default:
+		return fakeRow{values: []interface{}{db.inWindow}}
+	}
+}
+
+type fakeRow struct {
+	values []interface{}
+	err    error
+}
+
+func (r fakeRow) Scan(dest ...interface{}) error {
+	if r.err != nil {
+		return r.err
+	}
+	for i, d := range dest {
+		reflect.ValueOf(d).Elem().Set(reflect.ValueOf(r.values[i]))
+	}
+	return nil
+}
+
+func TestCheckPromo(t *testing.T) {
+	// 10 percent off orders of at least 100
+	promo := func(change func(p *order_service.PromoCode)) *order_service.PromoCode {
+		p := &order_service.PromoCode{
+			Id:            1,
+			Code:          "SPRING",
+			DiscountType:  "percent",
+			DiscountValue: 10,
+			MinOrderSum:   100,
+			Active:        true,
+		}
+		if change != nil {
+			change(p)
+		}
+		return p
+	}
+
+	products := []*order_service.OrderProducts{
+		{ProductId: 1, CategoryId: 5, Quantity: 2, Price: 30},
+		{ProductId: 2, CategoryId: 6, Quantity: 1, Price: 140},
+	}
+
+	tests := []struct {
+		name         string
+		db           fakePromoDB
+		orderSum     float64
+		branchID     int32
+		wantCode     errs.Code
+		wantDiscount float64
+	}{
+		{name: "percent", db: fakePromoDB{promo: promo(nil), inWindow: true}, orderSum: 200, wantDiscount: 20},
+		{name: "not found", db: fakePromoDB{}, orderSum: 200, wantCode: errs.CodeNotFound},
+		{
+			name:     "inactive",
+			db:       fakePromoDB{promo: promo(func(p *order_service.PromoCode) { p.Active = false }), inWindow: true},
+			orderSum: 200,
+			wantCode: errs.CodeFailedPrecondition,
+		},
+		{name: "outside validity window", db: fakePromoDB{promo: promo(nil)}, orderSum: 200, wantCode: errs.CodeFailedPrecondition},
+		{name: "below min order sum", db: fakePromoDB{promo: promo(nil), inWindow: true}, orderSum: 99.99, wantCode: errs.CodeFailedPrecondition},
+		{name: "exactly min order sum", db: fakePromoDB{promo: promo(nil), inWindow: true}, orderSum: 100, wantDiscount: 10},
+		{
+			name: "usage limit reached",
+			db: fakePromoDB{promo: promo(func(p *order_service.PromoCode) {
+				p.UsageLimit, p.UsedCount = 5, 5
+			}), inWindow: true},
+			orderSum: 200,
+			wantCode: errs.CodeFailedPrecondition,
+		},
+		{
+			name: "last use",
+			db: fakePromoDB{promo: promo(func(p *order_service.PromoCode) {
+				p.UsageLimit, p.UsedCount = 5, 4
+			}), inWindow: true},
+			orderSum:     200,
+			wantDiscount: 20,
+		},
+		{
+			name: "no usage limit",
+			db: fakePromoDB{promo: promo(func(p *order_service.PromoCode) {
+				p.UsedCount = 1000
+			}), inWindow: true},
+			orderSum:     200,
+			wantDiscount: 20,
+		},
+		{
+			name: "other branch",
+			db: fakePromoDB{promo: promo(func(p *order_service.PromoCode) {
+				p.BranchIds = []int32{2, 3}
+			}), inWindow: true},
+			orderSum: 200,
+			branchID: 4,
+			wantCode: errs.CodeFailedPrecondition,
+		},
+		{
+			name: "listed branch",
+			db: fakePromoDB{promo: promo(func(p *order_service.PromoCode) {
+				p.BranchIds = []int32{2, 3}
+			}), inWindow: true},
+			orderSum:     200,
+			branchID:     3,
+			wantDiscount: 20,
+		},
+		{
+			name: "per client limit reached",
+			db: fakePromoDB{promo: promo(func(p *order_service.PromoCode) {
+				p.PerClientLimit = 1
+			}), inWindow: true, used: 1},
+			orderSum: 200,
+			wantCode: errs.CodeConflict,
+		},
+		{
+			name: "per client limit not reached",
+			db: fakePromoDB{promo: promo(func(p *order_service.PromoCode) {
+				p.PerClientLimit = 2
+			}), inWindow: true, used: 1},
+			orderSum:     200,
+			wantDiscount: 20,
+		},
+		{
+			name: "category restriction",
+			db: fakePromoDB{promo: promo(func(p *order_service.PromoCode) {
+				p.CategoryIds = []int32{5}
+			}), inWindow: true},
+			orderSum:     200,
+			wantDiscount: 6,
+		},
+		{
+			name: "no product in the categories",
+			db: fakePromoDB{promo: promo(func(p *order_service.PromoCode) {
+				p.CategoryIds = []int32{7}
+			}), inWindow: true},
+			orderSum: 200,
+			wantCode: errs.CodeFailedPrecondition,
+		},
+		{
+			name: "fixed",
+			db: fakePromoDB{promo: promo(func(p *order_service.PromoCode) {
+				p.DiscountType, p.DiscountValue = "fixed", 25
+			}), inWindow: true},
+			orderSum:     200,
+			wantDiscount: 25,
+		},
+		{
+			name: "fixed capped at the discounted products",
+			db: fakePromoDB{promo: promo(func(p *order_service.PromoCode) {
+				p.DiscountType, p.DiscountValue, p.CategoryIds = "fixed", 100, []int32{5}
+			}), inWindow: true},
+			orderSum:     200,
+			wantDiscount: 60,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := checkPromo(context.Background(), &tt.db, &order_service.ApplyPromoRequest{
+				Code:     "spring",
+				ClientId: 9,
+				BranchId: tt.branchID,
+				OrderSum: tt.orderSum,
+				Products: products,
+			}, false)
+
+			if tt.wantCode != "" {
+				if !errs.Is(err, tt.wantCode) {
+					t.Errorf("checkPromo() error = %v, want %s", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("checkPromo: %v", err)
+			}
+			if resp.Discount != tt.wantDiscount || resp.Total != tt.orderSum-tt.wantDiscount {
+				t.Errorf("checkPromo() discount = %v, total = %v, want %v, %v", resp.Discount, resp.Total, tt.wantDiscount, tt.orderSum-tt.wantDiscount)
+			}
+		})
+	}
+}
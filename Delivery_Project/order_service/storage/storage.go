@@ -3,23 +3,50 @@ package storage
 import (
 	"context"
 	pb "order_service/genproto"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
 )
 
 type StorageI interface {
+	Ping(ctx context.Context) error
+	Stat() *pgxpool.Stat
+	CloseDB()
+
 	Order() OrderI
 	DeliveryTariff() DeliveryTariffI
+	PromoCode() PromoCodeI
+	Payment() PaymentI
+	CourierCash() CourierCashI
+	CompensationScheme() CompensationSchemeI
+	CourierEarnings() CourierEarningsI
+	Feedback() FeedbackI
+	Kitchen() KitchenI
+	Idempotency() IdempotencyI
+	Audit() AuditI
+	Outbox() OutboxI
 }
 
 type OrderI interface {
-	Create(context.Context, *pb.CreateOrderRequest) (string, error)
+	Create(ctx context.Context, req *pb.CreateOrderRequest, promisedIn time.Duration, key *IdempotencyKey, spend SpendBonus) (string, error)
 	Get(context.Context, *pb.IdStrRequest) (*pb.Order, error)
+	GetWithDeleted(context.Context, *pb.IdStrRequest) (*pb.Order, error)
 	GetList(context.Context, *pb.ListOrderRequest) (*pb.ListOrderResponse, error)
+	GetListWithDeleted(context.Context, *pb.ListOrderRequest) (*pb.ListOrderResponse, error)
 	Update(context.Context, *pb.UpdateOrderRequest) (string, error)
 	UpdateStatus(context.Context, *pb.UpdateOrderStatusRequest) (string, error)
 	Delete(context.Context, *pb.IdRequest) (string, error)
+	Restore(context.Context, *pb.IdRequest) (string, error)
+	GetDeletedList(context.Context, *pb.ListDeletedRequest) (*pb.ListOrderResponse, error)
+	Purge(ctx context.Context, retention time.Duration) (int64, error)
+	Anonymize(context.Context, *pb.ClientIdRequest) (string, error)
 	GetOrderStatus(context.Context, *pb.OrderIdRequest) (*pb.OrderStatusResponse, error)
 	GetAllAcceptableOrders(context.Context, *pb.IdRequest) (*pb.Order, error)
 	GetAllAcceptedOrders(context.Context, *pb.IdRequest) (*pb.Order, error)
+	GetQueueLength(ctx context.Context, branchID int32) (int32, error)
+	GetLateList(context.Context, *pb.ListLateOrdersRequest) (*pb.ListOrderResponse, error)
+	CompletePickup(context.Context, *pb.CompletePickupRequest) (string, error)
+	CountByStatus(ctx context.Context) (map[string]int64, error)
 }
 
 type DeliveryTariffI interface {
@@ -28,4 +55,106 @@ type DeliveryTariffI interface {
 	GetList(context.Context, *pb.ListDeliveryTariffRequest) (*pb.ListDeliveryTariffResponse, error)
 	Update(context.Context, *pb.UpdateDeliveryTariffRequest) (string, error)
 	Delete(context.Context, *pb.IdRequest) (string, error)
+	Restore(context.Context, *pb.IdRequest) (string, error)
+	GetDeletedList(context.Context, *pb.ListDeletedRequest) (*pb.ListDeliveryTariffResponse, error)
+	Purge(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+type PromoCodeI interface {
+	Create(context.Context, *pb.CreatePromoCodeRequest) (string, error)
+	Get(context.Context, *pb.IdRequest) (*pb.PromoCode, error)
+	GetList(context.Context, *pb.ListPromoCodeRequest) (*pb.ListPromoCodeResponse, error)
+	Update(context.Context, *pb.UpdatePromoCodeRequest) (string, error)
+	Delete(context.Context, *pb.IdRequest) (string, error)
+	Apply(context.Context, *pb.ApplyPromoRequest) (*pb.ApplyPromoResponse, error)
+}
+
+type PaymentI interface {
+	Create(context.Context, *pb.Payment) (*pb.Payment, error)
+	Get(context.Context, *pb.OrderIdRequest) (*pb.Payment, error)
+	UpdateStatus(ctx context.Context, orderID, status string) error
+}
+
+type CourierCashI interface {
+	Handover(context.Context, *pb.CashHandoverRequest) (*pb.CashTransaction, error)
+	GetBalance(context.Context, *pb.IdRequest) (*pb.CourierCashBalance, error)
+	GetList(context.Context, *pb.ListCashTransactionsRequest) (*pb.ListCashTransactionsResponse, error)
+	Reconcile(context.Context, *pb.CashReconciliationRequest) (*pb.CashReconciliation, error)
+}
+
+type CompensationSchemeI interface {
+	Create(context.Context, *pb.CreateCompensationSchemeRequest) (string, error)
+	Get(context.Context, *pb.IdRequest) (*pb.CompensationScheme, error)
+	GetList(context.Context, *pb.ListCompensationSchemeRequest) (*pb.ListCompensationSchemeResponse, error)
+	Update(context.Context, *pb.UpdateCompensationSchemeRequest) (string, error)
+	Delete(context.Context, *pb.IdRequest) (string, error)
+}
+
+type CourierEarningsI interface {
+	GetPayoutStatement(context.Context, *pb.PayoutStatementRequest) (*pb.PayoutStatement, error)
+}
+
+type FeedbackI interface {
+	Create(ctx context.Context, req *pb.CreateFeedbackRequest, lowRating int32) (*pb.Feedback, []*pb.FeedbackAlert, error)
+	Get(context.Context, *pb.OrderIdRequest) (*pb.Feedback, error)
+	GetList(context.Context, *pb.ListFeedbackRequest) (*pb.ListFeedbackResponse, error)
+	GetRating(context.Context, *pb.RatingRequest) (*pb.Rating, error)
+	GetAlertList(context.Context, *pb.ListFeedbackAlertsRequest) (*pb.ListFeedbackAlertsResponse, error)
+}
+
+type KitchenI interface {
+	GetQueue(context.Context, *pb.KitchenQueueRequest) (*pb.KitchenQueue, error)
+}
+
+// SpendBonus takes the bonus points of the order being created, it runs before
+// the order's transaction so no row is locked while user service answers
+type SpendBonus func(ctx context.Context, orderID string) error
+
+// IdempotencyKey is claimed together with the order it creates. Keys belong to
+// a client, so two clients never see each other's orders, and a key older than
+// TTL is free again.
+type IdempotencyKey struct {
+	ClientId    int32
+	Key         string
+	RequestHash string
+	TTL         time.Duration
+}
+
+// IdempotencyRecord is what a claimed key remembers about its order
+type IdempotencyRecord struct {
+	RequestHash string
+	OrderId     string
+	Response    string
+}
+
+type IdempotencyI interface {
+	Get(ctx context.Context, key *IdempotencyKey) (*IdempotencyRecord, error)
+	SaveResponse(ctx context.Context, key *IdempotencyKey, response string) error
+	Purge(ctx context.Context, ttl time.Duration) (int64, error)
+}
+
+type AuditI interface {
+	List(context.Context, *pb.ListAuditRequest) (*pb.ListAuditResponse, error)
+}
+
+// Outbox job kinds
+const (
+	// OutboxFinish adds a finished order to the client statistics and accrues its bonus points
+	OutboxFinish = "finish"
+	// OutboxRefundBonus gives back the points spent on an order that was not created
+	OutboxRefundBonus = "refund_bonus"
+)
+
+// OutboxJob is a user service call an order still owes
+type OutboxJob struct {
+	OrderID  string
+	Kind     string
+	Attempts int32
+}
+
+type OutboxI interface {
+	Add(ctx context.Context, orderID, kind string) error
+	Due(ctx context.Context, limit int) ([]*OutboxJob, error)
+	Done(ctx context.Context, job *OutboxJob) error
+	Retry(ctx context.Context, job *OutboxJob, after time.Duration, cause error) error
 }
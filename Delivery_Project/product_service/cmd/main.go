@@ -3,31 +3,63 @@ package main
 import (
 	"product_service/config"
 	"product_service/grpc"
+	"product_service/grpc/service"
 
 	"context"
-	"fmt"
 	"log"
 	"net"
+	"os/signal"
 	"product_service/pkg/logger"
+	"product_service/pkg/metrics"
 	"product_service/storage/postgres"
+	"syscall"
 )
 
 func main() {
 	cfg := config.Load()
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid config: %v", err)
+	}
 	lg := logger.NewLogger(cfg.Environment, "debug")
-	strg, err := postgres.NewStorage(context.Background(), cfg)
+	defer logger.Cleanup(lg)
+
+	// SIGTERM and SIGINT stop the background jobs and start the shutdown
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	strg, err := postgres.NewStorage(ctx, cfg)
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
+	defer strg.CloseDB()
+
+	m := metrics.New()
+	m.Register(metrics.NewPoolCollector(strg.Stat))
+	go m.Serve(ctx, cfg.MetricsPort, lg)
+
+	go service.RunTrashPurge(ctx, cfg, lg, strg)
 
-	s := grpc.SetUpServer(cfg, lg, strg)
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", 50052))
+	s, err := grpc.SetUpServer(ctx, cfg, lg, strg, m)
+	if err != nil {
+		log.Fatalf("Failed to set up server: %v", err)
+	}
+
+	lis, err := net.Listen("tcp", cfg.Port)
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	log.Printf("Server listening at %v", lis.Addr())
-	if err := s.Serve(lis); err != nil {
-		log.Fatalf("Failed to serve: %v", err)
+	serveErr := make(chan error, 1)
+	go func() {
+		log.Printf("Server listening at %v", lis.Addr())
+		serveErr <- s.Serve(lis)
+	}()
+
+	select {
+	case err := <-serveErr:
+		lg.Error("error while serving", logger.Error(err))
+	case <-ctx.Done():
+		lg.Info("shutting down", logger.Duration("timeout", cfg.ShutdownTimeout))
+		grpc.GracefulStop(s, cfg.ShutdownTimeout)
 	}
 }
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -21,7 +22,42 @@ type Config struct {
 	PostgresPassword string
 	PostgresDatabase string
 
-	Port string
+	// gRPC listen address, TLS is on when both files are set. With a CA file
+	// callers must present a certificate signed by it, issued to one of
+	// TLSAllowedClients.
+	Port              string
+	TLSCertFile       string
+	TLSKeyFile        string
+	TLSCAFile         string
+	TLSAllowedClients []string
+
+	// keepalive of client connections, see keepalive.ServerParameters; clients
+	// pinging more often than KeepaliveMinTime are disconnected
+	KeepaliveTime     time.Duration
+	KeepaliveTimeout  time.Duration
+	KeepaliveMinTime  time.Duration
+	MaxConnectionIdle time.Duration
+
+	// identities signed by the gateway with IdentitySecret are trusted for
+	// IdentityMaxAge, see pkg/identity
+	IdentitySecret string
+	IdentityMaxAge time.Duration
+
+	// rows soft deleted longer than TrashRetention ago are purged for good
+	// every TrashPurgeInterval, see service.RunTrashPurge. Purging is off
+	// until TRASH_RETENTION is set, e.g. to 720h
+	TrashRetention     time.Duration
+	TrashPurgeInterval time.Duration
+
+	// in-flight RPCs get this long to finish after SIGTERM or SIGINT
+	ShutdownTimeout time.Duration
+
+	// deadline of RPCs whose caller did not set a shorter one
+	RPCTimeout time.Duration
+
+	// /metrics listens here, the database is pinged for the health service every HealthCheckInterval
+	MetricsPort         string
+	HealthCheckInterval time.Duration
 
 	PostgresMaxConnections int32
 
@@ -58,7 +94,23 @@ func Load() Config {
 	config := Config{}
 
 	config.Environment = cast.ToString(getOrReturnDefaultValue("ENVIRONMENT", DebugMode))
-	config.Port = cast.ToString(getOrReturnDefaultValue("PORT", 8000))
+	config.Port = cast.ToString(getOrReturnDefaultValue("PORT", ":50052"))
+	config.TLSCertFile = cast.ToString(getOrReturnDefaultValue("TLS_CERT_FILE", ""))
+	config.TLSKeyFile = cast.ToString(getOrReturnDefaultValue("TLS_KEY_FILE", ""))
+	config.TLSCAFile = cast.ToString(getOrReturnDefaultValue("TLS_CA_FILE", ""))
+	config.TLSAllowedClients = splitList(cast.ToString(getOrReturnDefaultValue("TLS_ALLOWED_CLIENTS", "api-gateway")))
+	config.KeepaliveTime = cast.ToDuration(getOrReturnDefaultValue("KEEPALIVE_TIME", "2h"))
+	config.KeepaliveTimeout = cast.ToDuration(getOrReturnDefaultValue("KEEPALIVE_TIMEOUT", "20s"))
+	config.KeepaliveMinTime = cast.ToDuration(getOrReturnDefaultValue("KEEPALIVE_MIN_TIME", "10s"))
+	config.MaxConnectionIdle = cast.ToDuration(getOrReturnDefaultValue("MAX_CONNECTION_IDLE", "0s"))
+	config.IdentitySecret = cast.ToString(getOrReturnDefaultValue("IDENTITY_SECRET", devIdentitySecret))
+	config.IdentityMaxAge = cast.ToDuration(getOrReturnDefaultValue("IDENTITY_MAX_AGE", "1m"))
+	config.TrashRetention = cast.ToDuration(getOrReturnDefaultValue("TRASH_RETENTION", "0s"))
+	config.TrashPurgeInterval = cast.ToDuration(getOrReturnDefaultValue("TRASH_PURGE_INTERVAL", "1h"))
+	config.ShutdownTimeout = cast.ToDuration(getOrReturnDefaultValue("SHUTDOWN_TIMEOUT", "15s"))
+	config.RPCTimeout = cast.ToDuration(getOrReturnDefaultValue("RPC_TIMEOUT", "10s"))
+	config.MetricsPort = cast.ToString(getOrReturnDefaultValue("METRICS_PORT", ":9102"))
+	config.HealthCheckInterval = cast.ToDuration(getOrReturnDefaultValue("HEALTH_CHECK_INTERVAL", "10s"))
 
 	config.PostgresHost = cast.ToString(getOrReturnDefaultValue("POSTGRES_HOST", "localhost"))
 	config.PostgresPort = cast.ToInt(getOrReturnDefaultValue("POSTGRES_PORT", 5432))
@@ -72,6 +124,19 @@ func Load() Config {
 
 }
 
+// devIdentitySecret is the default IDENTITY_SECRET. It is public, so Validate
+// only accepts it in debug and test mode.
+const devIdentitySecret = "MyIdentitySecret"
+
+// Validate rejects settings that are only safe in development
+func (c Config) Validate() error {
+	if c.IdentitySecret == devIdentitySecret && c.Environment != DebugMode && c.Environment != TestMode {
+		return fmt.Errorf("IDENTITY_SECRET must be set in %s mode", c.Environment)
+	}
+
+	return nil
+}
+
 func getOrReturnDefaultValue(key string, defaultValue interface{}) interface{} {
 	val, exists := os.LookupEnv(key)
 	if exists {
@@ -79,3 +144,14 @@ func getOrReturnDefaultValue(key string, defaultValue interface{}) interface{} {
 	}
 	return defaultValue
 }
+
+// splitList splits a comma separated value, blank items are dropped
+func splitList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
@@ -1,23 +1,64 @@
 package grpc
 
 import (
+	"context"
+
 	"product_service/config"
 	product_service "product_service/genproto"
 	"product_service/grpc/service"
+	"product_service/pkg/errs"
+	"product_service/pkg/identity"
+	"product_service/pkg/interceptor"
 
 	"product_service/pkg/logger"
+	"product_service/pkg/metrics"
 	"product_service/storage"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
-func SetUpServer(cfg config.Config, log logger.LoggerI, strg storage.StorageI) (grpcServer *grpc.Server) {
-	grpcServer = grpc.NewServer()
+func SetUpServer(ctx context.Context, cfg config.Config, log logger.LoggerI, strg storage.StorageI, m *metrics.Metrics) (grpcServer *grpc.Server, err error) {
+	opts, err := serverOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// the caller's identity is checked after errs, so a rejected one is a typed error too
+	verifier := identity.NewVerifier(cfg.IdentitySecret, cfg.IdentityMaxAge)
+
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(
+			interceptor.UnaryRequestID(),
+			m.UnaryServerInterceptor(),
+			interceptor.UnaryLogging(log),
+			interceptor.UnaryRecovery(log),
+			errs.UnaryServerInterceptor(),
+			verifier.UnaryServerInterceptor(),
+			interceptor.UnaryDeadline(cfg.RPCTimeout),
+			validationRules().UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			interceptor.StreamRequestID(),
+			m.StreamServerInterceptor(),
+			interceptor.StreamLogging(log),
+			interceptor.StreamRecovery(log),
+			errs.StreamServerInterceptor(),
+			verifier.StreamServerInterceptor(),
+		),
+	)
+	grpcServer = grpc.NewServer(opts...)
 
 	product_service.RegisterCategoryServiceServer(grpcServer, service.NewCategoryService(cfg, log, strg))
 	product_service.RegisterProductServiceServer(grpcServer, service.NewProductService(cfg, log, strg))
+	product_service.RegisterAuditServiceServer(grpcServer, service.NewAuditService(cfg, log, strg))
+
+	hs := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, hs)
+	go watchHealth(ctx, hs, strg, log, cfg.HealthCheckInterval)
 
 	reflection.Register(grpcServer)
-	return
+	return grpcServer, nil
 }
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"product_service/config"
+	product_service "product_service/genproto"
+	"product_service/pkg/errs"
+	"product_service/pkg/identity"
+	"product_service/pkg/logger"
+	"product_service/storage"
+)
+
+// auditRole is the role allowed to read the audit log, staff users log in with it
+const auditRole = "user"
+
+type AuditService struct {
+	cfg     config.Config
+	log     logger.LoggerI
+	storage storage.StorageI
+	product_service.UnimplementedAuditServiceServer
+}
+
+func NewAuditService(cfg config.Config, log logger.LoggerI, strg storage.StorageI) *AuditService {
+	return &AuditService{
+		cfg:     cfg,
+		log:     log,
+		storage: strg,
+	}
+}
+
+func (b *AuditService) List(ctx context.Context, req *product_service.ListAuditRequest) (*product_service.ListAuditResponse, error) {
+	caller, ok := identity.FromContext(ctx)
+	if !ok || caller.Role != auditRole {
+		return nil, errs.PermissionDenied("only staff users can read the audit log")
+	}
+
+	resp, err := b.storage.Audit().List(ctx, req)
+	if err != nil {
+		b.log.Error("error while getting audit log", logger.Error(err))
+		return nil, err
+	}
+
+	return resp, nil
+}
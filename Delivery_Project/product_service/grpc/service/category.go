@@ -6,9 +6,6 @@ import (
 	product_service "product_service/genproto"
 	"product_service/pkg/logger"
 	"product_service/storage"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type CategoryService struct {
@@ -27,17 +24,17 @@ func NewCategoryService(cfg config.Config, log logger.LoggerI, strg storage.Stor
 }
 
 func (b *CategoryService) Create(ctx context.Context, req *product_service.CreateCategoryRequest) (*product_service.Response, error) {
-	resp, err := b.storage.Category().Create(context.Background(), req)
+	resp, err := b.storage.Category().Create(ctx, req)
 	if err != nil {
 		b.log.Error("error while creating product", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
 	return resp, nil
 }
 
 func (b *CategoryService) Get(ctx context.Context, req *product_service.IdRequest) (*product_service.Category, error) {
-	resp, err := b.storage.Category().Get(context.Background(), req)
+	resp, err := b.storage.Category().Get(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -46,7 +43,7 @@ func (b *CategoryService) Get(ctx context.Context, req *product_service.IdReques
 }
 
 func (b *CategoryService) List(ctx context.Context, req *product_service.ListCategoryRequest) (*product_service.ListCategoryResponse, error) {
-	Categorys, err := b.storage.Category().GetList(context.Background(), req)
+	Categorys, err := b.storage.Category().GetList(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +53,7 @@ func (b *CategoryService) List(ctx context.Context, req *product_service.ListCat
 }
 
 func (s *CategoryService) Update(ctx context.Context, req *product_service.UpdateCategoryRequest) (*product_service.Response, error) {
-	resp, err := s.storage.Category().Update(context.Background(), req)
+	resp, err := s.storage.Category().Update(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -65,10 +62,28 @@ func (s *CategoryService) Update(ctx context.Context, req *product_service.Updat
 }
 
 func (s *CategoryService) Delete(ctx context.Context, req *product_service.IdRequest) (*product_service.Response, error) {
-	resp, err := s.storage.Category().Delete(context.Background(), req)
+	resp, err := s.storage.Category().Delete(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.Response{Message: resp}, nil
+}
+
+func (s *CategoryService) Restore(ctx context.Context, req *product_service.IdRequest) (*product_service.Response, error) {
+	resp, err := s.storage.Category().Restore(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
 	return &product_service.Response{Message: resp}, nil
 }
+
+func (s *CategoryService) ListDeleted(ctx context.Context, req *product_service.ListDeletedRequest) (*product_service.ListCategoryResponse, error) {
+	resp, err := s.storage.Category().GetDeletedList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -6,9 +6,6 @@ import (
 	product_service "product_service/genproto"
 	"product_service/pkg/logger"
 	"product_service/storage"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type ProductService struct {
@@ -27,17 +24,17 @@ func NewProductService(cfg config.Config, log logger.LoggerI, strg storage.Stora
 }
 
 func (b *ProductService) Create(ctx context.Context, req *product_service.CreateProductRequest) (*product_service.Response, error) {
-	resp, err := b.storage.Product().Create(context.Background(), req)
+	resp, err := b.storage.Product().Create(ctx, req)
 	if err != nil {
 		b.log.Error("error while creating product", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
 	return resp, nil
 }
 
 func (b *ProductService) Get(ctx context.Context, req *product_service.IdRequest) (*product_service.Product, error) {
-	resp, err := b.storage.Product().Get(context.Background(), req)
+	resp, err := b.storage.Product().Get(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -46,7 +43,7 @@ func (b *ProductService) Get(ctx context.Context, req *product_service.IdRequest
 }
 
 func (b *ProductService) List(ctx context.Context, req *product_service.ListProductRequest) (*product_service.ListProductResponse, error) {
-	Products, err := b.storage.Product().GetList(context.Background(), req)
+	Products, err := b.storage.Product().GetList(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +53,7 @@ func (b *ProductService) List(ctx context.Context, req *product_service.ListProd
 }
 
 func (s *ProductService) Update(ctx context.Context, req *product_service.UpdateProductRequest) (*product_service.Response, error) {
-	resp, err := s.storage.Product().Update(context.Background(), req)
+	resp, err := s.storage.Product().Update(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -65,10 +62,28 @@ func (s *ProductService) Update(ctx context.Context, req *product_service.Update
 }
 
 func (s *ProductService) Delete(ctx context.Context, req *product_service.IdRequest) (*product_service.Response, error) {
-	resp, err := s.storage.Product().Delete(context.Background(), req)
+	resp, err := s.storage.Product().Delete(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.Response{Message: resp}, nil
+}
+
+func (s *ProductService) Restore(ctx context.Context, req *product_service.IdRequest) (*product_service.Response, error) {
+	resp, err := s.storage.Product().Restore(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
 	return &product_service.Response{Message: resp}, nil
 }
+
+func (s *ProductService) ListDeleted(ctx context.Context, req *product_service.ListDeletedRequest) (*product_service.ListProductResponse, error) {
+	resp, err := s.storage.Product().GetDeletedList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,75 @@
+package grpc
+
+import (
+	"time"
+
+	product_service "product_service/genproto"
+	"product_service/pkg/helper"
+	"product_service/pkg/validator"
+)
+
+// the audit log is filtered by a day or an exact time
+var auditLayouts = []string{time.DateOnly, time.DateTime}
+
+// validationRules declares what a valid create/update request looks like,
+// the interceptor rejects anything else before it reaches storage
+func validationRules() *validator.Registry {
+	r := validator.New()
+
+	validator.Register(r, func(req *product_service.CreateCategoryRequest, v *validator.Violations) {
+		v.Required("title", req.Title)
+		v.NotNegative("parent_id", float64(req.ParentId))
+		v.NotNegative("order_number", float64(req.OrderNumber))
+	})
+
+	validator.Register(r, func(req *product_service.UpdateCategoryRequest, v *validator.Violations) {
+		v.RequiredID("id", req.Id)
+		v.Required("title", req.Title)
+		v.NotNegative("parent_id", float64(req.ParentId))
+		if req.ParentId == req.Id {
+			v.Add("parent_id", "category can not be its own parent")
+		}
+	})
+
+	validator.Register(r, func(req *product_service.CreateProductRequest, v *validator.Violations) {
+		product(v, req.Title, req.Price, req.CategoryId, req.OrderNumber)
+	})
+
+	validator.Register(r, func(req *product_service.UpdateProductRequest, v *validator.Violations) {
+		v.RequiredID("id", req.Id)
+		product(v, req.Title, req.Price, req.CategoryId, req.OrderNumber)
+	})
+
+	validator.Register(r, func(req *product_service.ListAuditRequest, v *validator.Violations) {
+		v.Range("limit", float64(req.Limit), 0, 1000)
+		v.NotNegative("page", float64(req.Page))
+		from, okFrom := v.Time("from", req.From, auditLayouts...)
+		to, okTo := v.Time("to", req.To, auditLayouts...)
+		if okFrom && okTo && to.Before(from) {
+			v.Add("to", "must not be before from")
+		}
+	})
+
+	validator.Register(r, func(req *product_service.ListProductRequest, v *validator.Violations) {
+		listCursor(v, req.Cursor)
+	})
+
+	return r
+}
+
+func product(v *validator.Violations, title string, price float64, categoryId, orderNumber int32) {
+	v.Required("title", title)
+	v.Positive("price", price)
+	v.RequiredID("category_id", categoryId)
+	v.NotNegative("order_number", float64(orderNumber))
+}
+
+// listCursor checks that cursor is empty or a next_cursor of a list
+func listCursor(v *validator.Violations, cursor string) {
+	if cursor == "" {
+		return
+	}
+	if _, _, err := helper.DecodeCursor(cursor); err != nil {
+		v.Add("cursor", "must be the next_cursor of a previous page")
+	}
+}
@@ -0,0 +1,2 @@
+ALTER TABLE "categories" DROP COLUMN IF EXISTS "updated_by", DROP COLUMN IF EXISTS "created_by";
+ALTER TABLE "products" DROP COLUMN IF EXISTS "updated_by", DROP COLUMN IF EXISTS "created_by";
@@ -0,0 +1,8 @@
+-- who created and last updated a row, as role:id of the caller, see pkg/identity
+ALTER TABLE "products"
+    ADD COLUMN IF NOT EXISTS "created_by" VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS "updated_by" VARCHAR(64);
+
+ALTER TABLE "categories"
+    ADD COLUMN IF NOT EXISTS "created_by" VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS "updated_by" VARCHAR(64);
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS "categories_audit" ON "categories";
+DROP TRIGGER IF EXISTS "products_audit" ON "products";
+DROP FUNCTION IF EXISTS audit_changes();
+DROP TABLE IF EXISTS "audit_log";
@@ -0,0 +1,2 @@
+ALTER TABLE "categories" DROP COLUMN IF EXISTS "purged_at";
+ALTER TABLE "products" DROP COLUMN IF EXISTS "purged_at";
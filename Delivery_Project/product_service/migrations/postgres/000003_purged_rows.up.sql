@@ -0,0 +1,5 @@
+-- rows deleted longer than the retention ago are purged. Products and
+-- categories are referenced by order products of order_service, so they are
+-- kept and marked by purged_at instead of deleted
+ALTER TABLE "products" ADD COLUMN IF NOT EXISTS "purged_at" TIMESTAMP;
+ALTER TABLE "categories" ADD COLUMN IF NOT EXISTS "purged_at" TIMESTAMP;
@@ -0,0 +1,2 @@
+-- lists page by ("created_at", "id") newest first, see helper.EncodeCursor
+CREATE INDEX IF NOT EXISTS "products_created_at_id_idx" ON "products" ("created_at" DESC, "id" DESC) WHERE "deleted_at" IS NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE "categories" ADD COLUMN IF NOT EXISTS "purged_at" TIMESTAMP;
+ALTER TABLE "products" ADD COLUMN IF NOT EXISTS "purged_at" TIMESTAMP;
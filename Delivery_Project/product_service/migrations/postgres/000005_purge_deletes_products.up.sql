@@ -0,0 +1,4 @@
+-- purged products and categories are deleted now, order products keep the id
+-- and the price, see productRepo.Purge and categoryRepo.Purge
+ALTER TABLE "products" DROP COLUMN IF EXISTS "purged_at";
+ALTER TABLE "categories" DROP COLUMN IF EXISTS "purged_at";
@@ -0,0 +1,158 @@
+package errs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgconn"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// domain is sent in the error details, so clients can tell which service failed
+const domain = "product_service"
+
+// Code is a machine-readable error code, clients get it as the reason of
+// the ErrorInfo detail of the gRPC status
+type Code string
+
+const (
+	CodeNotFound           Code = "NOT_FOUND"
+	CodeConflict           Code = "CONFLICT"
+	CodeInvalidArgument    Code = "INVALID_ARGUMENT"
+	CodeFailedPrecondition Code = "FAILED_PRECONDITION"
+	CodeUnauthenticated    Code = "UNAUTHENTICATED"
+	CodePermissionDenied   Code = "PERMISSION_DENIED"
+	CodeInternal           Code = "INTERNAL"
+)
+
+var grpcCodes = map[Code]codes.Code{
+	CodeNotFound:           codes.NotFound,
+	CodeConflict:           codes.AlreadyExists,
+	CodeInvalidArgument:    codes.InvalidArgument,
+	CodeFailedPrecondition: codes.FailedPrecondition,
+	CodeUnauthenticated:    codes.Unauthenticated,
+	CodePermissionDenied:   codes.PermissionDenied,
+	CodeInternal:           codes.Internal,
+}
+
+// Error is a typed error returned by storage and services
+type Error struct {
+	Code    Code
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// NotFound is returned when the requested entity does not exist
+func NotFound(format string, args ...interface{}) error {
+	return &Error{Code: CodeNotFound, Message: fmt.Sprintf(format, args...)}
+}
+
+// Conflict is returned when the entity already exists or was already processed
+func Conflict(format string, args ...interface{}) error {
+	return &Error{Code: CodeConflict, Message: fmt.Sprintf(format, args...)}
+}
+
+// InvalidArgument is returned when the request itself is wrong
+func InvalidArgument(format string, args ...interface{}) error {
+	return &Error{Code: CodeInvalidArgument, Message: fmt.Sprintf(format, args...)}
+}
+
+// FailedPrecondition is returned when the request is valid but the current
+// state does not allow it, e.g. an order in the wrong status
+func FailedPrecondition(format string, args ...interface{}) error {
+	return &Error{Code: CodeFailedPrecondition, Message: fmt.Sprintf(format, args...)}
+}
+
+// Unauthenticated is returned when the identity of the caller can not be trusted
+func Unauthenticated(format string, args ...interface{}) error {
+	return &Error{Code: CodeUnauthenticated, Message: fmt.Sprintf(format, args...)}
+}
+
+// PermissionDenied is returned when the caller is known but may not do the call
+func PermissionDenied(format string, args ...interface{}) error {
+	return &Error{Code: CodePermissionDenied, Message: fmt.Sprintf(format, args...)}
+}
+
+// BadRequest is returned by request validation, every violation is sent as
+// a field violation of the BadRequest detail
+func BadRequest(violations []*errdetails.BadRequest_FieldViolation) error {
+	messages := make([]string, 0, len(violations))
+	for _, v := range violations {
+		messages = append(messages, v.Field+": "+v.Description)
+	}
+
+	st := status.New(codes.InvalidArgument, "invalid request: "+strings.Join(messages, "; "))
+	detailed, err := st.WithDetails(
+		&errdetails.ErrorInfo{Reason: string(CodeInvalidArgument), Domain: domain},
+		&errdetails.BadRequest{FieldViolations: violations},
+	)
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// Is reports whether any error in err's chain has the code
+func Is(err error, code Code) bool {
+	var e *Error
+	return errors.As(err, &e) && e.Code == code
+}
+
+// ToStatus converts err to a gRPC status with an ErrorInfo detail. Typed
+// errors keep their code, postgres constraint violations are mapped to the
+// closest code and anything else becomes Internal.
+func ToStatus(err error) *status.Status {
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+
+	code := CodeInternal
+
+	var (
+		typed *Error
+		pgErr *pgconn.PgError
+	)
+	switch {
+	case errors.As(err, &typed):
+		code = typed.Code
+	case errors.As(err, &pgErr):
+		code = pgCode(pgErr)
+	case errors.Is(err, context.Canceled):
+		return status.New(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.New(codes.DeadlineExceeded, err.Error())
+	}
+
+	st := status.New(grpcCodes[code], err.Error())
+	detailed, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: string(code),
+		Domain: domain,
+	})
+	if detailErr != nil {
+		return st
+	}
+
+	return detailed
+}
+
+// pgCode maps postgres error classes, see https://www.postgresql.org/docs/current/errcodes-appendix.html
+func pgCode(err *pgconn.PgError) Code {
+	switch {
+	case err.Code == "23505":
+		return CodeConflict
+	case err.Code == "23503":
+		return CodeFailedPrecondition
+	case err.Code == "23502", err.Code == "23514", strings.HasPrefix(err.Code, "22"):
+		return CodeInvalidArgument
+	}
+
+	return CodeInternal
+}
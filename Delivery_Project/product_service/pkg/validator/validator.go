@@ -0,0 +1,147 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"product_service/pkg/errs"
+	"product_service/pkg/helper"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+)
+
+// Violations collects the field violations of one request
+type Violations struct {
+	list []*errdetails.BadRequest_FieldViolation
+}
+
+// Add records a violation of field
+func (v *Violations) Add(field, format string, args ...interface{}) {
+	v.list = append(v.list, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+// Required checks that a string field is set
+func (v *Violations) Required(field, value string) {
+	if value == "" {
+		v.Add(field, "is required")
+	}
+}
+
+// RequiredID checks that a reference to another entity is set
+func (v *Violations) RequiredID(field string, value int32) {
+	if value <= 0 {
+		v.Add(field, "is required")
+	}
+}
+
+// Positive checks that value is greater than zero
+func (v *Violations) Positive(field string, value float64) {
+	if value <= 0 {
+		v.Add(field, "must be positive")
+	}
+}
+
+// NotNegative checks that value is zero or greater
+func (v *Violations) NotNegative(field string, value float64) {
+	if value < 0 {
+		v.Add(field, "must not be negative")
+	}
+}
+
+// Range checks that value is within [min, max]
+func (v *Violations) Range(field string, value, min, max float64) {
+	if value < min || value > max {
+		v.Add(field, "must be from %g to %g", min, max)
+	}
+}
+
+// OneOf checks that value is one of allowed
+func (v *Violations) OneOf(field, value string, allowed ...string) {
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+
+	v.Add(field, "must be one of %v", allowed)
+}
+
+// Phone checks the phone format, empty phones are left to Required
+func (v *Violations) Phone(field, value string) {
+	if value != "" && !helper.IsValidPhone(value) {
+		v.Add(field, "must be in +998XXXXXXXXX format")
+	}
+}
+
+// Time parses value with the first matching layout and records a violation
+// if none matches, empty values are left to Required
+func (v *Violations) Time(field, value string, layouts ...string) (time.Time, bool) {
+	if value == "" {
+		return time.Time{}, false
+	}
+
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+
+	v.Add(field, "must be in %s format", layouts[0])
+	return time.Time{}, false
+}
+
+// Err returns the collected violations as an InvalidArgument status, or nil
+func (v *Violations) Err() error {
+	if len(v.list) == 0 {
+		return nil
+	}
+
+	return errs.BadRequest(v.list)
+}
+
+// Registry keeps the validation rules of request messages by their type
+type Registry struct {
+	rules map[reflect.Type]func(req interface{}, v *Violations)
+}
+
+func New() *Registry {
+	return &Registry{rules: make(map[reflect.Type]func(req interface{}, v *Violations))}
+}
+
+// Register adds the rule of request type T
+func Register[T any](r *Registry, rule func(req T, v *Violations)) {
+	var zero T
+	r.rules[reflect.TypeOf(zero)] = func(req interface{}, v *Violations) {
+		rule(req.(T), v)
+	}
+}
+
+// Validate runs the rule of req, requests without a rule are always valid
+func (r *Registry) Validate(req interface{}) error {
+	rule, ok := r.rules[reflect.TypeOf(req)]
+	if !ok {
+		return nil
+	}
+
+	var v Violations
+	rule(req, &v)
+
+	return v.Err()
+}
+
+// UnaryServerInterceptor rejects requests that break their rule before the handler runs
+func (r *Registry) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := r.Validate(req); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
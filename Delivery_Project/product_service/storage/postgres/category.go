@@ -4,11 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"product_service/pkg/errs"
 	"product_service/pkg/helper"
+	"time"
 
 	product_service "product_service/genproto"
 
-	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
@@ -32,8 +34,9 @@ func (b *categoryRepo) Create(c context.Context, req *product_service.CreateCate
 			"title", 
 			"image",   
 			"order_number", 
+			"created_by",
 			"created_at")
-		VALUES ($1, $2, $3, NOW()) RETURNING "id"
+		VALUES ($1, $2, $3, $4, NOW()) RETURNING "id"
 	`
 
 		var id int
@@ -41,6 +44,7 @@ func (b *categoryRepo) Create(c context.Context, req *product_service.CreateCate
 			req.Title,
 			req.Image,
 			req.OrderNumber,
+			actor(c),
 		).Scan(&id)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create category: %w", err)
@@ -55,8 +59,9 @@ func (b *categoryRepo) Create(c context.Context, req *product_service.CreateCate
 			"image", 
 			"parent_id",    
 			"order_number", 
+			"created_by",
 			"created_at")
-		VALUES ($1, $2, $3, $4, NOW()) RETURNING "id"
+		VALUES ($1, $2, $3, $4, $5, NOW()) RETURNING "id"
 	`
 
 		var id int
@@ -65,6 +70,7 @@ func (b *categoryRepo) Create(c context.Context, req *product_service.CreateCate
 			req.Image,
 			req.ParentId,
 			req.OrderNumber,
+			actor(c),
 		).Scan(&id)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create category: %w", err)
@@ -108,7 +114,7 @@ func (b *categoryRepo) Get(c context.Context, req *product_service.IdRequest) (r
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("category not found")
+			return nil, errs.NotFound("category not found")
 		}
 		return nil, fmt.Errorf("failed to get category: %w", err)
 	}
@@ -126,6 +132,16 @@ func (b *categoryRepo) Get(c context.Context, req *product_service.IdRequest) (r
 }
 
 func (b *categoryRepo) GetList(c context.Context, req *product_service.ListCategoryRequest) (*product_service.ListCategoryResponse, error) {
+	return b.list(c, req, `"active"`)
+}
+
+// GetDeletedList lists deleted categories that can still be restored
+func (b *categoryRepo) GetDeletedList(c context.Context, req *product_service.ListDeletedRequest) (*product_service.ListCategoryResponse, error) {
+	return b.list(c, &product_service.ListCategoryRequest{Limit: req.Limit, Page: req.Page}, `"deleted_at" IS NOT NULL`)
+}
+
+// list lists the categories of scope matching req
+func (b *categoryRepo) list(c context.Context, req *product_service.ListCategoryRequest, scope string) (*product_service.ListCategoryResponse, error) {
 	var (
 		resp   product_service.ListCategoryResponse
 		err    error
@@ -138,7 +154,7 @@ func (b *categoryRepo) GetList(c context.Context, req *product_service.ListCateg
 		params["search"] = req.Search
 	}
 
-	countQuery := `SELECT count(1) FROM "categories" WHERE "active" ` + filter
+	countQuery := `SELECT count(1) FROM "categories" WHERE ` + scope + ` ` + filter
 
 	q, arr := helper.ReplaceQueryParams(countQuery, params)
 	err = b.db.QueryRow(c, q, arr...).Scan(
@@ -160,7 +176,7 @@ func (b *categoryRepo) GetList(c context.Context, req *product_service.ListCateg
 			"created_at",
 			"updated_at" 
 			FROM "categories" 
-		    WHERE "active" ` + filter
+		    WHERE ` + scope + ` ` + filter
 
 	query += " ORDER BY created_at DESC LIMIT :limit OFFSET :offset"
 
@@ -226,17 +242,19 @@ func (b *categoryRepo) Update(c context.Context, req *product_service.UpdateCate
 				"image" = $2,
 				"parent_id" = $3,  
 				"order_number" = $4,
+				"updated_by" = $6,
 				"updated_at" = NOW() 
 				WHERE id = $5 AND "active" `
 
 	result, err := b.db.Exec(
-		context.Background(),
+		c,
 		query,
 		req.Title,
 		req.Image,
 		req.ParentId,
 		req.OrderNumber,
 		req.Id,
+		actor(c),
 	)
 
 	if err != nil {
@@ -244,7 +262,7 @@ func (b *categoryRepo) Update(c context.Context, req *product_service.UpdateCate
 	}
 
 	if result.RowsAffected() == 0 {
-		return "", fmt.Errorf("category with ID %d not found", req.Id)
+		return "", errs.NotFound("category with ID %d not found", req.Id)
 	}
 
 	return fmt.Sprintf("category with ID %d updated", req.Id), nil
@@ -260,7 +278,7 @@ func (b *categoryRepo) Delete(c context.Context, req *product_service.IdRequest)
 				WHERE id = $1 AND "active"`
 
 	result, err := b.db.Exec(
-		context.Background(),
+		c,
 		query,
 		req.Id,
 	)
@@ -270,8 +288,50 @@ func (b *categoryRepo) Delete(c context.Context, req *product_service.IdRequest)
 	}
 
 	if result.RowsAffected() == 0 {
-		return "", fmt.Errorf("category with ID %s not found", req.Id)
+		return "", errs.NotFound("category with ID %s not found", req.Id)
 	}
 
 	return "deleted", nil
 }
+
+// Restore undoes the delete of a category that is not purged yet
+func (b *categoryRepo) Restore(c context.Context, req *product_service.IdRequest) (string, error) {
+	result, err := b.db.Exec(c, `
+		UPDATE "categories"
+		SET
+			"active" = true,
+			"deleted_at" = NULL,
+			"updated_at" = NOW(),
+			"updated_by" = $2
+		WHERE "id" = $1 AND "deleted_at" IS NOT NULL`,
+		req.Id,
+		actor(c),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to restore category: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return "", errs.NotFound("deleted category with ID %s not found", req.Id)
+	}
+
+	return "restored", nil
+}
+
+// Purge deletes categories deleted longer than retention ago that no product
+// or subcategory refers to anymore. Order products of order_service keep only
+// the id of their category.
+func (b *categoryRepo) Purge(c context.Context, retention time.Duration) (int64, error) {
+	result, err := b.db.Exec(c, `
+		DELETE FROM "categories" AS c
+		WHERE c."deleted_at" <= NOW() - $1 * INTERVAL '1 second'
+			AND NOT EXISTS (SELECT 1 FROM "products" WHERE "category_id" = c."id")
+			AND NOT EXISTS (SELECT 1 FROM "categories" WHERE "parent_id" = c."id")`,
+		int64(retention.Seconds()),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge categories: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
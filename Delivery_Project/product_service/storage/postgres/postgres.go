@@ -13,6 +13,7 @@ type strg struct {
 	db       *pgxpool.Pool
 	category *categoryRepo
 	product  *productRepo
+	audit    *auditRepo
 }
 
 func NewStorage(ctx context.Context, cfg config.Config) (storage.StorageI, error) {
@@ -32,6 +33,7 @@ func NewStorage(ctx context.Context, cfg config.Config) (storage.StorageI, error
 	}
 
 	config.MaxConns = cfg.PostgresMaxConnections
+	config.BeforeAcquire = setAuditSession
 	pool, err := pgxpool.ConnectConfig(ctx, config)
 	if err != nil {
 		fmt.Println("ConnectConfig:", err.Error())
@@ -43,6 +45,18 @@ func NewStorage(ctx context.Context, cfg config.Config) (storage.StorageI, error
 	}, nil
 }
 
+func (d *strg) Ping(ctx context.Context) error {
+	return d.db.Ping(ctx)
+}
+
+func (d *strg) Stat() *pgxpool.Stat {
+	return d.db.Stat()
+}
+
+func (d *strg) CloseDB() {
+	d.db.Close()
+}
+
 func (d *strg) Category() storage.CategoryI {
 	if d.category == nil {
 		d.category = NewCategory(d.db)
@@ -56,3 +70,10 @@ func (d *strg) Product() storage.ProductI {
 	}
 	return d.product
 }
+
+func (d *strg) Audit() storage.AuditI {
+	if d.audit == nil {
+		d.audit = NewAudit(d.db)
+	}
+	return d.audit
+}
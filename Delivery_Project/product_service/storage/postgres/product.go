@@ -4,11 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"product_service/pkg/errs"
 	"product_service/pkg/helper"
+	"time"
 
 	product_service "product_service/genproto"
 
-	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
@@ -33,9 +35,10 @@ func (b *productRepo) Create(c context.Context, req *product_service.CreateProdu
 			"type",
 			"price",
 			"category_id",
+			"created_by",
 			"created_at"
 			)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW()) RETURNING "id"
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW()) RETURNING "id"
 	`
 
 	var id int
@@ -47,6 +50,7 @@ func (b *productRepo) Create(c context.Context, req *product_service.CreateProdu
 		req.ProductType,
 		req.Price,
 		req.CategoryId,
+		actor(c),
 	).Scan(&id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create product: %w", err)
@@ -94,7 +98,7 @@ func (b *productRepo) Get(c context.Context, req *product_service.IdRequest) (re
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("product not found")
+			return nil, errs.NotFound("product not found")
 		}
 		return nil, fmt.Errorf("failed to get product: %w", err)
 	}
@@ -110,6 +114,16 @@ func (b *productRepo) Get(c context.Context, req *product_service.IdRequest) (re
 }
 
 func (b *productRepo) GetList(c context.Context, req *product_service.ListProductRequest) (*product_service.ListProductResponse, error) {
+	return b.list(c, req, `"deleted_at" IS NULL AND "active"`)
+}
+
+// GetDeletedList lists deleted products that can still be restored
+func (b *productRepo) GetDeletedList(c context.Context, req *product_service.ListDeletedRequest) (*product_service.ListProductResponse, error) {
+	return b.list(c, &product_service.ListProductRequest{Limit: req.Limit, Page: req.Page}, `"deleted_at" IS NOT NULL`)
+}
+
+// list lists the products of scope matching req
+func (b *productRepo) list(c context.Context, req *product_service.ListProductRequest, scope string) (*product_service.ListProductResponse, error) {
 	var (
 		resp   product_service.ListProductResponse
 		err    error
@@ -132,15 +146,28 @@ func (b *productRepo) GetList(c context.Context, req *product_service.ListProduc
 		params["category_id"] = req.Category
 	}
 
-	countQuery := `SELECT count(1) FROM "products" WHERE "deleted_at" IS NULL AND "active"` + filter
+	// a cursor skips the count unless it is asked for
+	if req.Cursor == "" || req.WithCount {
+		countQuery := `SELECT count(1) FROM "products" WHERE ` + scope + filter
 
-	q, arr := helper.ReplaceQueryParams(countQuery, params)
-	err = b.db.QueryRow(c, q, arr...).Scan(
-		&resp.Count,
-	)
+		q, arr := helper.ReplaceQueryParams(countQuery, params)
+		err = b.db.QueryRow(c, q, arr...).Scan(
+			&resp.Count,
+		)
 
-	if err != nil {
-		return nil, fmt.Errorf("error while scanning count %w", err)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning count %w", err)
+		}
+	}
+
+	if req.Cursor != "" {
+		createdAt, id, err := helper.DecodeCursor(req.Cursor)
+		if err != nil {
+			return nil, errs.InvalidArgument("cursor: %s", err)
+		}
+		filter += ` AND ("created_at", "id") < (:after_created_at, :after_id)`
+		params["after_created_at"] = createdAt
+		params["after_id"] = id
 	}
 
 	query := `
@@ -157,20 +184,22 @@ func (b *productRepo) GetList(c context.Context, req *product_service.ListProduc
 			"created_at",
 			"updated_at" 
 			FROM "products" 
-		    WHERE "active" AND "deleted_at" IS NULL ` + filter
+		    WHERE ` + scope + ` ` + filter
 
-	query += " ORDER BY created_at DESC LIMIT :limit OFFSET :offset"
-	params["limit"] = 10
+	// one row more than the page tells if there is a next page
+	query += ` ORDER BY "created_at" DESC, "id" DESC LIMIT :limit OFFSET :offset`
+	limit := int32(10)
 	params["offset"] = 0
 
 	if req.Limit > 0 {
-		params["limit"] = req.Limit
+		limit = req.Limit
 	}
-	if req.Page >= 0 {
+	params["limit"] = limit + 1
+	if req.Page >= 0 && req.Cursor == "" {
 		params["offset"] = (req.Page - 1) * req.Limit
 	}
 
-	q, arr = helper.ReplaceQueryParams(query, params)
+	q, arr := helper.ReplaceQueryParams(query, params)
 	rows, err := b.db.Query(c, q, arr...)
 	if err != nil {
 		return nil, fmt.Errorf("error while getting rows %w", err)
@@ -210,6 +239,12 @@ func (b *productRepo) GetList(c context.Context, req *product_service.ListProduc
 		resp.Products = append(resp.Products, &product)
 	}
 
+	if len(resp.Products) > int(limit) {
+		resp.Products = resp.Products[:limit]
+		last := resp.Products[limit-1]
+		resp.NextCursor = helper.EncodeCursor(last.CreatedAt, int64(last.Id))
+	}
+
 	return &resp, nil
 }
 
@@ -225,11 +260,12 @@ func (b *productRepo) Update(c context.Context, req *product_service.UpdateProdu
 				"type" = $5,
 				"price" = $6,
 				"category_id" = $7,
+				"updated_by" = $9,
 				"updated_at" = NOW()
 				WHERE id = $8 AND "active" AND "deleted_at" IS NULL`
 
 	result, err := b.db.Exec(
-		context.Background(),
+		c,
 		query,
 		req.Title,
 		req.Description,
@@ -239,6 +275,7 @@ func (b *productRepo) Update(c context.Context, req *product_service.UpdateProdu
 		req.Price,
 		req.CategoryId,
 		req.Id,
+		actor(c),
 	)
 
 	if err != nil {
@@ -246,7 +283,7 @@ func (b *productRepo) Update(c context.Context, req *product_service.UpdateProdu
 	}
 
 	if result.RowsAffected() == 0 {
-		return "", fmt.Errorf("product with ID %d not found", req.Id)
+		return "", errs.NotFound("product with ID %d not found", req.Id)
 	}
 
 	return fmt.Sprintf("product with ID %d updated", req.Id), nil
@@ -262,7 +299,7 @@ func (b *productRepo) Delete(c context.Context, req *product_service.IdRequest)
 				WHERE id = $1 AND "active" AND "deleted_at" IS NULL`
 
 	result, err := b.db.Exec(
-		context.Background(),
+		c,
 		query,
 		req.Id,
 	)
@@ -272,8 +309,47 @@ func (b *productRepo) Delete(c context.Context, req *product_service.IdRequest)
 	}
 
 	if result.RowsAffected() == 0 {
-		return "", fmt.Errorf("product with ID %s not found", req.Id)
+		return "", errs.NotFound("product with ID %s not found", req.Id)
 	}
 
 	return "deleted", nil
 }
+
+// Restore undoes the delete of a product that is not purged yet
+func (b *productRepo) Restore(c context.Context, req *product_service.IdRequest) (string, error) {
+	result, err := b.db.Exec(c, `
+		UPDATE "products"
+		SET
+			"active" = true,
+			"deleted_at" = NULL,
+			"updated_at" = NOW(),
+			"updated_by" = $2
+		WHERE "id" = $1 AND "deleted_at" IS NOT NULL`,
+		req.Id,
+		actor(c),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to restore product: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return "", errs.NotFound("deleted product with ID %s not found", req.Id)
+	}
+
+	return "restored", nil
+}
+
+// Purge deletes products deleted longer than retention ago. Order products of
+// order_service keep the id and the price they were sold for.
+func (b *productRepo) Purge(c context.Context, retention time.Duration) (int64, error) {
+	result, err := b.db.Exec(c, `
+		DELETE FROM "products"
+		WHERE "deleted_at" <= NOW() - $1 * INTERVAL '1 second'`,
+		int64(retention.Seconds()),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge products: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
@@ -3,11 +3,19 @@ package storage
 import (
 	"context"
 	pb "product_service/genproto"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
 )
 
 type StorageI interface {
+	Ping(ctx context.Context) error
+	Stat() *pgxpool.Stat
+	CloseDB()
+
 	Category() CategoryI
 	Product() ProductI
+	Audit() AuditI
 }
 
 type CategoryI interface {
@@ -16,6 +24,9 @@ type CategoryI interface {
 	GetList(context.Context, *pb.ListCategoryRequest) (*pb.ListCategoryResponse, error)
 	Update(context.Context, *pb.UpdateCategoryRequest) (string, error)
 	Delete(context.Context, *pb.IdRequest) (string, error)
+	Restore(context.Context, *pb.IdRequest) (string, error)
+	GetDeletedList(context.Context, *pb.ListDeletedRequest) (*pb.ListCategoryResponse, error)
+	Purge(ctx context.Context, retention time.Duration) (int64, error)
 }
 
 type ProductI interface {
@@ -24,4 +35,11 @@ type ProductI interface {
 	GetList(context.Context, *pb.ListProductRequest) (*pb.ListProductResponse, error)
 	Update(context.Context, *pb.UpdateProductRequest) (string, error)
 	Delete(context.Context, *pb.IdRequest) (string, error)
+	Restore(context.Context, *pb.IdRequest) (string, error)
+	GetDeletedList(context.Context, *pb.ListDeletedRequest) (*pb.ListProductResponse, error)
+	Purge(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+type AuditI interface {
+	List(context.Context, *pb.ListAuditRequest) (*pb.ListAuditResponse, error)
 }
@@ -5,29 +5,63 @@ import (
 	"user_service/grpc"
 
 	"context"
-	"fmt"
 	"log"
 	"net"
+	"os/signal"
+	"syscall"
+	"user_service/grpc/service"
 	"user_service/pkg/logger"
+	"user_service/pkg/metrics"
 	"user_service/storage/postgres"
 )
 
 func main() {
 	cfg := config.Load()
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid config: %v", err)
+	}
 	lg := logger.NewLogger(cfg.Environment, "debug")
-	strg, err := postgres.NewStorage(context.Background(), cfg)
+	defer logger.Cleanup(lg)
+
+	// SIGTERM and SIGINT stop the background jobs and start the shutdown
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	strg, err := postgres.NewStorage(ctx, cfg)
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
+	defer strg.CloseDB()
+
+	go service.NewBonusService(cfg, lg, strg).RunExpiry(ctx)
+	go service.RunTrashPurge(ctx, cfg, lg, strg)
+
+	m := metrics.New()
+	m.Register(metrics.NewPoolCollector(strg.Stat))
+	m.Register(metrics.NewCountCollector("couriers_by_availability", "Active couriers by availability, online ones are on shift.", "availability", strg.CourierShift().CountByAvailability, lg))
+	go m.Serve(ctx, cfg.MetricsPort, lg)
 
-	s := grpc.SetUpServer(cfg, lg, strg)
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", 50051))
+	s, err := grpc.SetUpServer(ctx, cfg, lg, strg, m)
+	if err != nil {
+		log.Fatalf("Failed to set up server: %v", err)
+	}
+
+	lis, err := net.Listen("tcp", cfg.Port)
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	log.Printf("Server listening at %v", lis.Addr())
-	if err := s.Serve(lis); err != nil {
-		log.Fatalf("Failed to serve: %v", err)
+	serveErr := make(chan error, 1)
+	go func() {
+		log.Printf("Server listening at %v", lis.Addr())
+		serveErr <- s.Serve(lis)
+	}()
+
+	select {
+	case err := <-serveErr:
+		lg.Error("error while serving", logger.Error(err))
+	case <-ctx.Done():
+		lg.Info("shutting down", logger.Duration("timeout", cfg.ShutdownTimeout))
+		grpc.GracefulStop(s, cfg.ShutdownTimeout)
 	}
 }
@@ -0,0 +1,215 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: client_data.proto
+
+package user_service
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// everything user_service keeps about a client, deleted clients are exported
+// until they are purged
+type ClientDataExport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Client            *Clients            `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Addresses         []*ClientAddress    `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	BonusBalance      *BonusBalance       `protobuf:"bytes,3,opt,name=bonus_balance,json=bonusBalance,proto3" json:"bonus_balance,omitempty"`
+	BonusTransactions []*BonusTransaction `protobuf:"bytes,4,rep,name=bonus_transactions,json=bonusTransactions,proto3" json:"bonus_transactions,omitempty"`
+}
+
+func (x *ClientDataExport) Reset() {
+	*x = ClientDataExport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_data_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientDataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientDataExport) ProtoMessage() {}
+
+func (x *ClientDataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_client_data_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientDataExport.ProtoReflect.Descriptor instead.
+func (*ClientDataExport) Descriptor() ([]byte, []int) {
+	return file_client_data_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ClientDataExport) GetClient() *Clients {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *ClientDataExport) GetAddresses() []*ClientAddress {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *ClientDataExport) GetBonusBalance() *BonusBalance {
+	if x != nil {
+		return x.BonusBalance
+	}
+	return nil
+}
+
+func (x *ClientDataExport) GetBonusTransactions() []*BonusTransaction {
+	if x != nil {
+		return x.BonusTransactions
+	}
+	return nil
+}
+
+var File_client_data_proto protoreflect.FileDescriptor
+
+var file_client_data_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x1a, 0x0c, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x8c, 0x02, 0x0a, 0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x06, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x12, 0x3f, 0x0a, 0x0d, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x0c, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x4d, 0x0a, 0x12, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x6f, 0x6e,
+	0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x62,
+	0x6f, 0x6e, 0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x32, 0x98, 0x01, 0x0a, 0x11, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x17, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x09, 0x41,
+	0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x12, 0x17, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x17, 0x5a, 0x15, 0x67,
+	0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_client_data_proto_rawDescOnce sync.Once
+	file_client_data_proto_rawDescData = file_client_data_proto_rawDesc
+)
+
+func file_client_data_proto_rawDescGZIP() []byte {
+	file_client_data_proto_rawDescOnce.Do(func() {
+		file_client_data_proto_rawDescData = protoimpl.X.CompressGZIP(file_client_data_proto_rawDescData)
+	})
+	return file_client_data_proto_rawDescData
+}
+
+var file_client_data_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_client_data_proto_goTypes = []interface{}{
+	(*ClientDataExport)(nil), // 0: user_service.ClientDataExport
+	(*Clients)(nil),          // 1: user_service.Clients
+	(*ClientAddress)(nil),    // 2: user_service.ClientAddress
+	(*BonusBalance)(nil),     // 3: user_service.BonusBalance
+	(*BonusTransaction)(nil), // 4: user_service.BonusTransaction
+	(*IdRequest)(nil),        // 5: user_service.IdRequest
+	(*Response)(nil),         // 6: user_service.Response
+}
+var file_client_data_proto_depIdxs = []int32{
+	1, // 0: user_service.ClientDataExport.client:type_name -> user_service.Clients
+	2, // 1: user_service.ClientDataExport.addresses:type_name -> user_service.ClientAddress
+	3, // 2: user_service.ClientDataExport.bonus_balance:type_name -> user_service.BonusBalance
+	4, // 3: user_service.ClientDataExport.bonus_transactions:type_name -> user_service.BonusTransaction
+	5, // 4: user_service.ClientDataService.Export:input_type -> user_service.IdRequest
+	5, // 5: user_service.ClientDataService.Anonymize:input_type -> user_service.IdRequest
+	0, // 6: user_service.ClientDataService.Export:output_type -> user_service.ClientDataExport
+	6, // 7: user_service.ClientDataService.Anonymize:output_type -> user_service.Response
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_client_data_proto_init() }
+func file_client_data_proto_init() {
+	if File_client_data_proto != nil {
+		return
+	}
+	file_branch_proto_init()
+	file_clients_proto_init()
+	file_client_address_proto_init()
+	file_bonus_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_client_data_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientDataExport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_client_data_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_client_data_proto_goTypes,
+		DependencyIndexes: file_client_data_proto_depIdxs,
+		MessageInfos:      file_client_data_proto_msgTypes,
+	}.Build()
+	File_client_data_proto = out.File
+	file_client_data_proto_rawDesc = nil
+	file_client_data_proto_goTypes = nil
+	file_client_data_proto_depIdxs = nil
+}
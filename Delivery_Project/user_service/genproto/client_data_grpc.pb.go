@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: client_data.proto
+
+package user_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ClientDataServiceClient is the client API for ClientDataService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ClientDataServiceClient interface {
+	Export(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*ClientDataExport, error)
+	Anonymize(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Response, error)
+}
+
+type clientDataServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClientDataServiceClient(cc grpc.ClientConnInterface) ClientDataServiceClient {
+	return &clientDataServiceClient{cc}
+}
+
+func (c *clientDataServiceClient) Export(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*ClientDataExport, error) {
+	out := new(ClientDataExport)
+	err := c.cc.Invoke(ctx, "/user_service.ClientDataService/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientDataServiceClient) Anonymize(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/user_service.ClientDataService/Anonymize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClientDataServiceServer is the server API for ClientDataService service.
+// All implementations must embed UnimplementedClientDataServiceServer
+// for forward compatibility
+type ClientDataServiceServer interface {
+	Export(context.Context, *IdRequest) (*ClientDataExport, error)
+	Anonymize(context.Context, *IdRequest) (*Response, error)
+	mustEmbedUnimplementedClientDataServiceServer()
+}
+
+// UnimplementedClientDataServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedClientDataServiceServer struct {
+}
+
+func (UnimplementedClientDataServiceServer) Export(context.Context, *IdRequest) (*ClientDataExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedClientDataServiceServer) Anonymize(context.Context, *IdRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Anonymize not implemented")
+}
+func (UnimplementedClientDataServiceServer) mustEmbedUnimplementedClientDataServiceServer() {}
+
+// UnsafeClientDataServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClientDataServiceServer will
+// result in compilation errors.
+type UnsafeClientDataServiceServer interface {
+	mustEmbedUnimplementedClientDataServiceServer()
+}
+
+func RegisterClientDataServiceServer(s grpc.ServiceRegistrar, srv ClientDataServiceServer) {
+	s.RegisterService(&ClientDataService_ServiceDesc, srv)
+}
+
+func _ClientDataService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientDataServiceServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.ClientDataService/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientDataServiceServer).Export(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientDataService_Anonymize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientDataServiceServer).Anonymize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.ClientDataService/Anonymize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientDataServiceServer).Anonymize(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClientDataService_ServiceDesc is the grpc.ServiceDesc for ClientDataService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClientDataService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user_service.ClientDataService",
+	HandlerType: (*ClientDataServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Export",
+			Handler:    _ClientDataService_Export_Handler,
+		},
+		{
+			MethodName: "Anonymize",
+			Handler:    _ClientDataService_Anonymize_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "client_data.proto",
+}
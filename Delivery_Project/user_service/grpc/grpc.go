@@ -1,25 +1,70 @@
 package grpc
 
 import (
+	"context"
+
 	"user_service/config"
 	user_service "user_service/genproto"
+	"user_service/pkg/errs"
+	"user_service/pkg/identity"
+	"user_service/pkg/interceptor"
 
 	"user_service/grpc/service"
 	"user_service/pkg/logger"
+	"user_service/pkg/metrics"
 	"user_service/storage"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
-func SetUpServer(cfg config.Config, log logger.LoggerI, strg storage.StorageI) (grpcServer *grpc.Server) {
-	grpcServer = grpc.NewServer()
+func SetUpServer(ctx context.Context, cfg config.Config, log logger.LoggerI, strg storage.StorageI, m *metrics.Metrics) (grpcServer *grpc.Server, err error) {
+	opts, err := serverOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// the caller's identity is checked after errs, so a rejected one is a typed error too
+	verifier := identity.NewVerifier(cfg.IdentitySecret, cfg.IdentityMaxAge)
+
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(
+			interceptor.UnaryRequestID(),
+			m.UnaryServerInterceptor(),
+			interceptor.UnaryLogging(log),
+			interceptor.UnaryRecovery(log),
+			errs.UnaryServerInterceptor(),
+			verifier.UnaryServerInterceptor(),
+			interceptor.UnaryDeadline(cfg.RPCTimeout),
+			validationRules().UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			interceptor.StreamRequestID(),
+			m.StreamServerInterceptor(),
+			interceptor.StreamLogging(log),
+			interceptor.StreamRecovery(log),
+			errs.StreamServerInterceptor(),
+			verifier.StreamServerInterceptor(),
+		),
+	)
+	grpcServer = grpc.NewServer(opts...)
 
 	user_service.RegisterBranchServiceServer(grpcServer, service.NewBranchService(cfg, log, strg))
 	user_service.RegisterUserServiceServer(grpcServer, service.NewUserService(cfg, log, strg))
 	user_service.RegisterCourierServiceServer(grpcServer, service.NewCourierService(cfg, log, strg))
 	user_service.RegisterClientServiceServer(grpcServer, service.NewClientService(cfg, log, strg))
+	user_service.RegisterBonusServiceServer(grpcServer, service.NewBonusService(cfg, log, strg))
+	user_service.RegisterCourierShiftServiceServer(grpcServer, service.NewCourierShiftService(cfg, log, strg))
+	user_service.RegisterClientAddressServiceServer(grpcServer, service.NewClientAddressService(cfg, log, strg))
+	user_service.RegisterAuditServiceServer(grpcServer, service.NewAuditService(cfg, log, strg))
+	user_service.RegisterClientDataServiceServer(grpcServer, service.NewClientDataService(cfg, log, strg))
+
+	hs := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, hs)
+	go watchHealth(ctx, hs, strg, log, cfg.HealthCheckInterval)
 
 	reflection.Register(grpcServer)
-	return
+	return grpcServer, nil
 }
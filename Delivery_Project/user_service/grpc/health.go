@@ -0,0 +1,40 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"user_service/pkg/logger"
+	"user_service/storage"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// watchHealth pings the database every interval and reports the result
+// through the standard gRPC health service. Once ctx is done every service is
+// reported NOT_SERVING, so the gateway stops sending new requests.
+func watchHealth(ctx context.Context, hs *health.Server, strg storage.StorageI, log logger.LoggerI, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		servingStatus := healthpb.HealthCheckResponse_SERVING
+
+		pingCtx, cancel := context.WithTimeout(ctx, interval)
+		if err := strg.Ping(pingCtx); err != nil {
+			log.Error("error while pinging database", logger.Error(err))
+			servingStatus = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		cancel()
+
+		hs.SetServingStatus("", servingStatus)
+
+		select {
+		case <-ctx.Done():
+			hs.Shutdown()
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"user_service/config"
+	user_service "user_service/genproto"
+	"user_service/pkg/errs"
+	"user_service/pkg/identity"
+	"user_service/pkg/logger"
+	"user_service/storage"
+)
+
+// auditRole is the role allowed to read the audit log, staff users log in with it
+const auditRole = "user"
+
+type AuditService struct {
+	cfg     config.Config
+	log     logger.LoggerI
+	storage storage.StorageI
+	user_service.UnimplementedAuditServiceServer
+}
+
+func NewAuditService(cfg config.Config, log logger.LoggerI, strg storage.StorageI) *AuditService {
+	return &AuditService{
+		cfg:     cfg,
+		log:     log,
+		storage: strg,
+	}
+}
+
+func (b *AuditService) List(ctx context.Context, req *user_service.ListAuditRequest) (*user_service.ListAuditResponse, error) {
+	caller, ok := identity.FromContext(ctx)
+	if !ok || caller.Role != auditRole {
+		return nil, errs.PermissionDenied("only staff users can read the audit log")
+	}
+
+	resp, err := b.storage.Audit().List(ctx, req)
+	if err != nil {
+		b.log.Error("error while getting audit log", logger.Error(err))
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+	"user_service/config"
+	user_service "user_service/genproto"
+	"user_service/pkg/logger"
+	"user_service/storage"
+)
+
+type BonusService struct {
+	cfg     config.Config
+	log     logger.LoggerI
+	storage storage.StorageI
+	user_service.UnimplementedBonusServiceServer
+}
+
+func NewBonusService(cfg config.Config, log logger.LoggerI, strg storage.StorageI) *BonusService {
+	return &BonusService{
+		cfg:     cfg,
+		log:     log,
+		storage: strg,
+	}
+}
+
+func (b *BonusService) Accrue(ctx context.Context, req *user_service.AccrueBonusRequest) (*user_service.BonusTransaction, error) {
+	amount := math.Round(req.OrderSum*b.cfg.BonusPercent) / 100
+
+	resp, err := b.storage.Bonus().Accrue(ctx, req, amount, time.Now().Add(b.cfg.BonusLifetime))
+	if err != nil {
+		b.log.Error("error while accruing bonus", logger.Error(err))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (b *BonusService) Spend(ctx context.Context, req *user_service.SpendBonusRequest) (*user_service.BonusTransaction, error) {
+	resp, err := b.storage.Bonus().Spend(ctx, req)
+	if err != nil {
+		b.log.Error("error while spending bonus", logger.Error(err))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (b *BonusService) Refund(ctx context.Context, req *user_service.IdRequest) (*user_service.BonusTransaction, error) {
+	resp, err := b.storage.Bonus().Refund(ctx, req, time.Now().Add(b.cfg.BonusLifetime))
+	if err != nil {
+		b.log.Error("error while refunding bonus", logger.Error(err))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (b *BonusService) RefundOrder(ctx context.Context, req *user_service.RefundOrderRequest) (*user_service.BonusTransaction, error) {
+	resp, err := b.storage.Bonus().RefundOrder(ctx, req, time.Now().Add(b.cfg.BonusLifetime))
+	if err != nil {
+		b.log.Error("error while refunding order bonus", logger.Error(err))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (b *BonusService) GetBalance(ctx context.Context, req *user_service.IdRequest) (*user_service.BonusBalance, error) {
+	resp, err := b.storage.Bonus().GetBalance(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (b *BonusService) GetStatement(ctx context.Context, req *user_service.BonusStatementRequest) (*user_service.BonusStatementResponse, error) {
+	resp, err := b.storage.Bonus().GetStatement(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// RunExpiry sweeps expired points every cfg.BonusExpireInterval until ctx is done.
+func (b *BonusService) RunExpiry(ctx context.Context) {
+	ticker := time.NewTicker(b.cfg.BonusExpireInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			count, err := b.storage.Bonus().ExpirePoints(ctx)
+			if err != nil {
+				b.log.Error("error while expiring bonus points", logger.Error(err))
+				continue
+			}
+			if count > 0 {
+				b.log.Info("bonus points expired", logger.Any("wallets", count))
+			}
+		}
+	}
+}
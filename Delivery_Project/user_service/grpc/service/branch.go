@@ -6,9 +6,6 @@ import (
 	user_service "user_service/genproto"
 	"user_service/pkg/logger"
 	"user_service/storage"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type BranchService struct {
@@ -27,10 +24,10 @@ func NewBranchService(cfg config.Config, log logger.LoggerI, strg storage.Storag
 }
 
 func (b *BranchService) Create(ctx context.Context, req *user_service.CreateBranchRequest) (*user_service.Response, error) {
-	resp, err := b.storage.Branch().Create(context.Background(), req)
+	resp, err := b.storage.Branch().Create(ctx, req)
 	if err != nil {
 		b.log.Error("error while creating branch", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
 	return resp, nil
@@ -38,7 +35,7 @@ func (b *BranchService) Create(ctx context.Context, req *user_service.CreateBran
 }
 
 func (b *BranchService) Get(ctx context.Context, req *user_service.IdRequest) (*user_service.Branch, error) {
-	resp, err := b.storage.Branch().Get(context.Background(), req)
+	resp, err := b.storage.Branch().Get(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -47,7 +44,7 @@ func (b *BranchService) Get(ctx context.Context, req *user_service.IdRequest) (*
 }
 
 func (b *BranchService) List(ctx context.Context, req *user_service.ListBranchRequest) (*user_service.ListBranchResponse, error) {
-	Branchs, err := b.storage.Branch().GetList(context.Background(), req)
+	Branchs, err := b.storage.Branch().GetList(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +54,7 @@ func (b *BranchService) List(ctx context.Context, req *user_service.ListBranchRe
 }
 
 func (s *BranchService) Update(ctx context.Context, req *user_service.UpdateBranchRequest) (*user_service.Response, error) {
-	resp, err := s.storage.Branch().Update(context.Background(), req)
+	resp, err := s.storage.Branch().Update(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -66,15 +63,33 @@ func (s *BranchService) Update(ctx context.Context, req *user_service.UpdateBran
 }
 
 func (s *BranchService) Delete(ctx context.Context, req *user_service.IdRequest) (*user_service.Response, error) {
-	resp, err := s.storage.Branch().Delete(context.Background(), req)
+	resp, err := s.storage.Branch().Delete(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user_service.Response{Message: resp}, nil
+}
+
+func (s *BranchService) Restore(ctx context.Context, req *user_service.IdRequest) (*user_service.Response, error) {
+	resp, err := s.storage.Branch().Restore(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
 	return &user_service.Response{Message: resp}, nil
 }
+
+func (s *BranchService) ListDeleted(ctx context.Context, req *user_service.ListDeletedRequest) (*user_service.ListBranchResponse, error) {
+	resp, err := s.storage.Branch().GetDeletedList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
 func (b *BranchService) ListActive(ctx context.Context, req *user_service.ListActiveBranchRequest) (*user_service.ListBranchResponse, error) {
-	Branchs, err := b.storage.Branch().GetListActive(context.Background(), req)
+	Branchs, err := b.storage.Branch().GetListActive(ctx, req)
 	if err != nil {
 		return nil, err
 	}
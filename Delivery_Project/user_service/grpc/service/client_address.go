@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"user_service/config"
+	user_service "user_service/genproto"
+	"user_service/pkg/logger"
+	"user_service/storage"
+)
+
+type ClientAddressService struct {
+	cfg     config.Config
+	log     logger.LoggerI
+	storage storage.StorageI
+	user_service.UnimplementedClientAddressServiceServer
+}
+
+func NewClientAddressService(cfg config.Config, log logger.LoggerI, strg storage.StorageI) *ClientAddressService {
+	return &ClientAddressService{
+		cfg:     cfg,
+		log:     log,
+		storage: strg,
+	}
+}
+
+func (b *ClientAddressService) Create(ctx context.Context, req *user_service.CreateClientAddressRequest) (*user_service.ClientAddress, error) {
+	resp, err := b.storage.ClientAddress().Create(ctx, req)
+	if err != nil {
+		b.log.Error("error while creating client address", logger.Error(err))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (b *ClientAddressService) Get(ctx context.Context, req *user_service.IdRequest) (*user_service.ClientAddress, error) {
+	resp, err := b.storage.ClientAddress().Get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (b *ClientAddressService) List(ctx context.Context, req *user_service.ListClientAddressRequest) (*user_service.ListClientAddressResponse, error) {
+	resp, err := b.storage.ClientAddress().GetList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (b *ClientAddressService) Update(ctx context.Context, req *user_service.UpdateClientAddressRequest) (*user_service.ClientAddress, error) {
+	resp, err := b.storage.ClientAddress().Update(ctx, req)
+	if err != nil {
+		b.log.Error("error while updating client address", logger.Error(err))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (b *ClientAddressService) Delete(ctx context.Context, req *user_service.IdRequest) (*user_service.Response, error) {
+	resp, err := b.storage.ClientAddress().Delete(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user_service.Response{Message: resp}, nil
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"user_service/config"
+	user_service "user_service/genproto"
+	"user_service/pkg/errs"
+	"user_service/pkg/identity"
+	"user_service/pkg/logger"
+	"user_service/storage"
+)
+
+const (
+	// clientDataRole is the role allowed to export and anonymize client data
+	clientDataRole = "user"
+	// exportPageSize is the page size used to collect the lists of an export
+	exportPageSize = 100
+)
+
+type ClientDataService struct {
+	cfg     config.Config
+	log     logger.LoggerI
+	storage storage.StorageI
+	user_service.UnimplementedClientDataServiceServer
+}
+
+func NewClientDataService(cfg config.Config, log logger.LoggerI, strg storage.StorageI) *ClientDataService {
+	return &ClientDataService{
+		cfg:     cfg,
+		log:     log,
+		storage: strg,
+	}
+}
+
+func (b *ClientDataService) Export(ctx context.Context, req *user_service.IdRequest) (*user_service.ClientDataExport, error) {
+	caller, ok := identity.FromContext(ctx)
+	if !ok || caller.Role != clientDataRole {
+		return nil, errs.PermissionDenied("only staff users can export client data")
+	}
+
+	client, err := b.storage.Clients().GetWithDeleted(ctx, req)
+	if err != nil {
+		b.log.Error("error while getting client for export", logger.Error(err))
+		return nil, err
+	}
+	resp := &user_service.ClientDataExport{Client: client}
+
+	for page := int32(1); ; page++ {
+		addresses, err := b.storage.ClientAddress().GetList(ctx, &user_service.ListClientAddressRequest{
+			ClientId: req.Id,
+			Limit:    exportPageSize,
+			Page:     page,
+		})
+		if err != nil {
+			b.log.Error("error while getting client addresses for export", logger.Error(err))
+			return nil, err
+		}
+		resp.Addresses = append(resp.Addresses, addresses.Addresses...)
+		if len(addresses.Addresses) < exportPageSize {
+			break
+		}
+	}
+
+	resp.BonusBalance, err = b.storage.Bonus().GetBalance(ctx, req)
+	if err != nil {
+		b.log.Error("error while getting bonus balance for export", logger.Error(err))
+		return nil, err
+	}
+
+	for page := int32(1); ; page++ {
+		statement, err := b.storage.Bonus().GetStatement(ctx, &user_service.BonusStatementRequest{
+			ClientId: req.Id,
+			Limit:    exportPageSize,
+			Page:     page,
+		})
+		if err != nil {
+			b.log.Error("error while getting bonus statement for export", logger.Error(err))
+			return nil, err
+		}
+		resp.BonusTransactions = append(resp.BonusTransactions, statement.Transactions...)
+		if len(statement.Transactions) < exportPageSize {
+			break
+		}
+	}
+
+	return resp, nil
+}
+
+func (b *ClientDataService) Anonymize(ctx context.Context, req *user_service.IdRequest) (*user_service.Response, error) {
+	caller, ok := identity.FromContext(ctx)
+	if !ok || caller.Role != clientDataRole {
+		return nil, errs.PermissionDenied("only staff users can anonymize client data")
+	}
+
+	msg, err := b.storage.Clients().Anonymize(ctx, req)
+	if err != nil {
+		b.log.Error("error while anonymizing client", logger.Error(err))
+		return nil, err
+	}
+
+	return &user_service.Response{Message: msg}, nil
+}
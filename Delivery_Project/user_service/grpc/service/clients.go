@@ -6,9 +6,6 @@ import (
 	user_service "user_service/genproto"
 	"user_service/pkg/logger"
 	"user_service/storage"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type ClientsService struct {
@@ -27,10 +24,10 @@ func NewClientService(cfg config.Config, log logger.LoggerI, strg storage.Storag
 }
 
 func (b *ClientsService) Create(ctx context.Context, req *user_service.CreateClientsRequest) (*user_service.Response, error) {
-	resp, err := b.storage.Clients().Create(context.Background(), req)
+	resp, err := b.storage.Clients().Create(ctx, req)
 	if err != nil {
 		b.log.Error("error while creating clients", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
 	return resp, nil
@@ -38,7 +35,7 @@ func (b *ClientsService) Create(ctx context.Context, req *user_service.CreateCli
 }
 
 func (b *ClientsService) Get(ctx context.Context, req *user_service.IdRequest) (*user_service.Clients, error) {
-	reso, err := b.storage.Clients().Get(context.Background(), req)
+	reso, err := b.storage.Clients().Get(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -47,7 +44,7 @@ func (b *ClientsService) Get(ctx context.Context, req *user_service.IdRequest) (
 }
 
 func (b *ClientsService) List(ctx context.Context, req *user_service.ListClientsRequest) (*user_service.ListClientsResponse, error) {
-	Clients, err := b.storage.Clients().GetList(context.Background(), req)
+	Clients, err := b.storage.Clients().GetList(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +54,7 @@ func (b *ClientsService) List(ctx context.Context, req *user_service.ListClients
 }
 
 func (s *ClientsService) Update(ctx context.Context, req *user_service.UpdateClientsRequest) (*user_service.Response, error) {
-	resp, err := s.storage.Clients().Update(context.Background(), req)
+	resp, err := s.storage.Clients().Update(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +63,16 @@ func (s *ClientsService) Update(ctx context.Context, req *user_service.UpdateCli
 }
 
 func (s *ClientsService) Delete(ctx context.Context, req *user_service.IdRequest) (*user_service.Response, error) {
-	resp, err := s.storage.Clients().Delete(context.Background(), req)
+	resp, err := s.storage.Clients().Delete(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user_service.Response{Message: resp}, nil
+}
+
+func (s *ClientsService) Restore(ctx context.Context, req *user_service.IdRequest) (*user_service.Response, error) {
+	resp, err := s.storage.Clients().Restore(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -74,8 +80,17 @@ func (s *ClientsService) Delete(ctx context.Context, req *user_service.IdRequest
 	return &user_service.Response{Message: resp}, nil
 }
 
+func (s *ClientsService) ListDeleted(ctx context.Context, req *user_service.ListDeletedRequest) (*user_service.ListClientsResponse, error) {
+	resp, err := s.storage.Clients().GetDeletedList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 func (s *ClientsService) UpdateOrder(ctx context.Context, req *user_service.UpdateClientsOrderRequest) (*user_service.Response, error) {
-	resp, err := s.storage.Clients().UpdateOrder(context.Background(), req)
+	resp, err := s.storage.Clients().UpdateOrder(ctx, req)
 	if err != nil {
 		return nil, err
 	}
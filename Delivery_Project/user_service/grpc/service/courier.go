@@ -6,9 +6,6 @@ import (
 	user_service "user_service/genproto"
 	"user_service/pkg/logger"
 	"user_service/storage"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type CourierService struct {
@@ -26,10 +23,10 @@ func NewCourierService(cfg config.Config, log logger.LoggerI, strg storage.Stora
 	}
 }
 func (b *CourierService) Create(ctx context.Context, req *user_service.CreateCouriersRequest) (*user_service.Response, error) {
-	resp, err := b.storage.Couriers().Create(context.Background(), req)
+	resp, err := b.storage.Couriers().Create(ctx, req)
 	if err != nil {
 		b.log.Error("error while creating courier", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
 	return resp, nil
@@ -37,7 +34,7 @@ func (b *CourierService) Create(ctx context.Context, req *user_service.CreateCou
 }
 
 func (b *CourierService) Get(ctx context.Context, req *user_service.IdRequest) (resp *user_service.Couriers, err error) {
-	resp, err = b.storage.Couriers().Get(context.Background(), req)
+	resp, err = b.storage.Couriers().Get(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -46,7 +43,7 @@ func (b *CourierService) Get(ctx context.Context, req *user_service.IdRequest) (
 }
 
 func (b *CourierService) List(ctx context.Context, req *user_service.ListCouriersRequest) (*user_service.ListCouriersResponse, error) {
-	Couriers, err := b.storage.Couriers().GetList(context.Background(), req)
+	Couriers, err := b.storage.Couriers().GetList(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +53,7 @@ func (b *CourierService) List(ctx context.Context, req *user_service.ListCourier
 }
 
 func (s *CourierService) Update(ctx context.Context, req *user_service.UpdateCouriersRequest) (*user_service.Response, error) {
-	resp, err := s.storage.Couriers().Update(context.Background(), req)
+	resp, err := s.storage.Couriers().Update(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -65,10 +62,28 @@ func (s *CourierService) Update(ctx context.Context, req *user_service.UpdateCou
 }
 
 func (s *CourierService) Delete(ctx context.Context, req *user_service.IdRequest) (*user_service.Response, error) {
-	resp, err := s.storage.Couriers().Delete(context.Background(), req)
+	resp, err := s.storage.Couriers().Delete(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user_service.Response{Message: resp}, nil
+}
+
+func (s *CourierService) Restore(ctx context.Context, req *user_service.IdRequest) (*user_service.Response, error) {
+	resp, err := s.storage.Couriers().Restore(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
 	return &user_service.Response{Message: resp}, nil
 }
+
+func (s *CourierService) ListDeleted(ctx context.Context, req *user_service.ListDeletedRequest) (*user_service.ListCouriersResponse, error) {
+	resp, err := s.storage.Couriers().GetDeletedList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
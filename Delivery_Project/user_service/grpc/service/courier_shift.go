@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"user_service/config"
+	user_service "user_service/genproto"
+	"user_service/pkg/errs"
+	"user_service/pkg/logger"
+	"user_service/storage"
+)
+
+type CourierShiftService struct {
+	cfg     config.Config
+	log     logger.LoggerI
+	storage storage.StorageI
+	user_service.UnimplementedCourierShiftServiceServer
+}
+
+func NewCourierShiftService(cfg config.Config, log logger.LoggerI, strg storage.StorageI) *CourierShiftService {
+	return &CourierShiftService{
+		cfg:     cfg,
+		log:     log,
+		storage: strg,
+	}
+}
+
+func (b *CourierShiftService) StartShift(ctx context.Context, req *user_service.IdRequest) (*user_service.CourierShift, error) {
+	resp, err := b.storage.CourierShift().StartShift(ctx, req)
+	if err != nil {
+		b.log.Error("error while starting shift", logger.Error(err))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (b *CourierShiftService) EndShift(ctx context.Context, req *user_service.IdRequest) (*user_service.CourierShift, error) {
+	resp, err := b.storage.CourierShift().EndShift(ctx, req)
+	if err != nil {
+		b.log.Error("error while ending shift", logger.Error(err))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (b *CourierShiftService) SetAvailability(ctx context.Context, req *user_service.SetAvailabilityRequest) (*user_service.Couriers, error) {
+	var err error
+
+	switch req.Availability {
+	case "offline":
+		_, err = b.storage.CourierShift().EndShift(ctx, &user_service.IdRequest{Id: req.CourierId})
+	case "online", "break":
+		err = b.storage.CourierShift().SetAvailability(ctx, req)
+	default:
+		return nil, errs.InvalidArgument("availability must be online, offline or break")
+	}
+	if err != nil {
+		b.log.Error("error while setting courier availability", logger.Error(err))
+		return nil, err
+	}
+
+	return b.storage.Couriers().Get(ctx, &user_service.IdRequest{Id: req.CourierId})
+}
+
+func (b *CourierShiftService) GetShift(ctx context.Context, req *user_service.IdRequest) (*user_service.CourierShift, error) {
+	resp, err := b.storage.CourierShift().Get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (b *CourierShiftService) ListShifts(ctx context.Context, req *user_service.ListShiftsRequest) (*user_service.ListShiftsResponse, error) {
+	resp, err := b.storage.CourierShift().GetList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (b *CourierShiftService) ListOnlineCouriers(ctx context.Context, req *user_service.ListOnlineCouriersRequest) (*user_service.ListCouriersResponse, error) {
+	resp, err := b.storage.CourierShift().ListOnlineCouriers(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -6,9 +6,6 @@ import (
 	user_service "user_service/genproto"
 	"user_service/pkg/logger"
 	"user_service/storage"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type UserService struct {
@@ -27,10 +24,10 @@ func NewUserService(cfg config.Config, log logger.LoggerI, strg storage.StorageI
 }
 
 func (b *UserService) Create(ctx context.Context, req *user_service.CreateUsersRequest) (*user_service.Response, error) {
-	resp, err := b.storage.Users().Create(context.Background(), req)
+	resp, err := b.storage.Users().Create(ctx, req)
 	if err != nil {
 		b.log.Error("error while creating users", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
 	return resp, nil
@@ -38,7 +35,7 @@ func (b *UserService) Create(ctx context.Context, req *user_service.CreateUsersR
 }
 
 func (b *UserService) Get(ctx context.Context, req *user_service.IdRequest) (*user_service.Users, error) {
-	reso, err := b.storage.Users().Get(context.Background(), req)
+	reso, err := b.storage.Users().Get(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -47,7 +44,7 @@ func (b *UserService) Get(ctx context.Context, req *user_service.IdRequest) (*us
 }
 
 func (b *UserService) List(ctx context.Context, req *user_service.ListUsersRequest) (*user_service.ListUsersResponse, error) {
-	Users, err := b.storage.Users().GetList(context.Background(), req)
+	Users, err := b.storage.Users().GetList(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +54,7 @@ func (b *UserService) List(ctx context.Context, req *user_service.ListUsersReque
 }
 
 func (s *UserService) Update(ctx context.Context, req *user_service.UpdateUsersRequest) (*user_service.Response, error) {
-	resp, err := s.storage.Users().Update(context.Background(), req)
+	resp, err := s.storage.Users().Update(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -66,13 +63,31 @@ func (s *UserService) Update(ctx context.Context, req *user_service.UpdateUsersR
 }
 
 func (s *UserService) Delete(ctx context.Context, req *user_service.IdRequest) (*user_service.Response, error) {
-	resp, err := s.storage.Users().Delete(context.Background(), req)
+	resp, err := s.storage.Users().Delete(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user_service.Response{Message: resp}, nil
+}
+
+func (s *UserService) Restore(ctx context.Context, req *user_service.IdRequest) (*user_service.Response, error) {
+	resp, err := s.storage.Users().Restore(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
 	return &user_service.Response{Message: resp}, nil
 }
+
+func (s *UserService) ListDeleted(ctx context.Context, req *user_service.ListDeletedRequest) (*user_service.ListUsersResponse, error) {
+	resp, err := s.storage.Users().GetDeletedList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
 func (s *UserService) GetUserByUserName(ctx context.Context, req *user_service.GetByUserName) (*user_service.Users, error) {
 	user, err := s.storage.Users().GetUserByUserName(ctx, req)
 	if err != nil {
@@ -0,0 +1,184 @@
+package grpc
+
+import (
+	"time"
+
+	user_service "user_service/genproto"
+	"user_service/pkg/helper"
+	"user_service/pkg/validator"
+)
+
+var (
+	discountTypes  = []string{"percent", "fixed"}
+	availabilities = []string{"online", "offline", "break"}
+
+	clockLayouts = []string{"15:04", time.TimeOnly}
+
+	// the audit log is filtered by a day or an exact time
+	auditLayouts = []string{time.DateOnly, time.DateTime}
+)
+
+// validationRules declares what a valid create/update request looks like,
+// the interceptor rejects anything else before it reaches storage
+func validationRules() *validator.Registry {
+	r := validator.New()
+
+	validator.Register(r, func(req *user_service.CreateBranchRequest, v *validator.Violations) {
+		branch(v, req.Name, req.Phone, req.WorkHourStart, req.WorkHourEnd, req.DeliveryTarifId, req.PreparationMinutes)
+	})
+
+	validator.Register(r, func(req *user_service.UpdateBranchRequest, v *validator.Violations) {
+		v.RequiredID("id", req.Id)
+		branch(v, req.Name, req.Phone, req.WorkHourStart, req.WorkHourEnd, req.DeliveryTarifId, req.PreparationMinutes)
+	})
+
+	validator.Register(r, func(req *user_service.CreateClientsRequest, v *validator.Violations) {
+		client(v, req.Firstname, req.Phone, req.BirthDate, req.DiscountType, req.DiscountAmount)
+	})
+
+	validator.Register(r, func(req *user_service.UpdateClientsRequest, v *validator.Violations) {
+		v.RequiredID("id", req.Id)
+		client(v, req.Firstname, req.Phone, req.BirthDate, req.DiscountType, req.DiscountAmount)
+	})
+
+	validator.Register(r, func(req *user_service.UpdateClientsOrderRequest, v *validator.Violations) {
+		v.RequiredID("id", req.Id)
+		v.Required("order_id", req.OrderId)
+		v.NotNegative("total_orders_count", float64(req.TotalOrdersCount))
+		v.NotNegative("total_orders_sum", req.TotalOrdersSum)
+	})
+
+	validator.Register(r, func(req *user_service.CreateClientAddressRequest, v *validator.Violations) {
+		v.RequiredID("client_id", req.ClientId)
+		clientAddress(v, req.Address, req.Latitude, req.Longitude)
+	})
+
+	validator.Register(r, func(req *user_service.UpdateClientAddressRequest, v *validator.Violations) {
+		v.RequiredID("id", req.Id)
+		clientAddress(v, req.Address, req.Latitude, req.Longitude)
+	})
+
+	validator.Register(r, func(req *user_service.CreateCouriersRequest, v *validator.Violations) {
+		staff(v, req.Firstname, req.BranchId, req.Phone, req.Login, req.Password)
+		v.Positive("max_order_count", float64(req.MaxOrderCount))
+	})
+
+	validator.Register(r, func(req *user_service.UpdateCouriersRequest, v *validator.Violations) {
+		v.RequiredID("id", req.Id)
+		staff(v, req.Firstname, req.BranchId, req.Phone, req.Login, req.Password)
+		v.Positive("max_order_count", float64(req.MaxOrderCount))
+	})
+
+	validator.Register(r, func(req *user_service.CreateUsersRequest, v *validator.Violations) {
+		staff(v, req.Firstname, req.BranchId, req.Phone, req.Login, req.Password)
+	})
+
+	validator.Register(r, func(req *user_service.UpdateUsersRequest, v *validator.Violations) {
+		v.RequiredID("id", req.Id)
+		staff(v, req.Firstname, req.BranchId, req.Phone, req.Login, req.Password)
+	})
+
+	validator.Register(r, func(req *user_service.AccrueBonusRequest, v *validator.Violations) {
+		v.RequiredID("client_id", req.ClientId)
+		v.Required("order_id", req.OrderId)
+		v.Positive("order_sum", req.OrderSum)
+	})
+
+	validator.Register(r, func(req *user_service.SpendBonusRequest, v *validator.Violations) {
+		v.RequiredID("client_id", req.ClientId)
+		v.Positive("amount", req.Amount)
+		v.Required("order_id", req.OrderId)
+	})
+
+	validator.Register(r, func(req *user_service.RefundOrderRequest, v *validator.Violations) {
+		v.Required("order_id", req.OrderId)
+	})
+
+	validator.Register(r, func(req *user_service.SetAvailabilityRequest, v *validator.Violations) {
+		v.RequiredID("courier_id", req.CourierId)
+		v.OneOf("availability", req.Availability, availabilities...)
+	})
+
+	validator.Register(r, func(req *user_service.ListAuditRequest, v *validator.Violations) {
+		v.Range("limit", float64(req.Limit), 0, 1000)
+		v.NotNegative("page", float64(req.Page))
+		from, okFrom := v.Time("from", req.From, auditLayouts...)
+		to, okTo := v.Time("to", req.To, auditLayouts...)
+		if okFrom && okTo && to.Before(from) {
+			v.Add("to", "must not be before from")
+		}
+	})
+
+	validator.Register(r, func(req *user_service.ListClientsRequest, v *validator.Violations) {
+		listCursor(v, req.Cursor)
+	})
+
+	return r
+}
+
+func branch(v *validator.Violations, name, phone, workHourStart, workHourEnd string, deliveryTarifId, preparationMinutes int32) {
+	v.Required("name", name)
+	v.Required("phone", phone)
+	v.Phone("phone", phone)
+	v.NotNegative("delivery_tarif_id", float64(deliveryTarifId))
+	v.NotNegative("preparation_minutes", float64(preparationMinutes))
+
+	v.Required("work_hour_start", workHourStart)
+	v.Required("work_hour_end", workHourEnd)
+	start, okStart := v.Time("work_hour_start", workHourStart, clockLayouts...)
+	end, okEnd := v.Time("work_hour_end", workHourEnd, clockLayouts...)
+	// an end before the start closes the branch after midnight, e.g. 18:00-02:00
+	if okStart && okEnd && end.Equal(start) {
+		v.Add("work_hour_end", "must differ from work_hour_start")
+	}
+}
+
+func client(v *validator.Violations, firstname, phone, birthDate, discountType string, discountAmount float64) {
+	v.Required("firstname", firstname)
+	v.Required("phone", phone)
+	v.Phone("phone", phone)
+	if birth, ok := v.Time("birth_date", birthDate, time.DateOnly); ok && birth.After(time.Now()) {
+		v.Add("birth_date", "must be in the past")
+	}
+
+	if discountType == "" {
+		if discountAmount != 0 {
+			v.Add("discount_type", "is required when discount_amount is set")
+		}
+		return
+	}
+	v.OneOf("discount_type", discountType, discountTypes...)
+	v.NotNegative("discount_amount", discountAmount)
+	// a percent discount is a fraction of the price, 0.15 is 15 percent
+	if discountType == "percent" && discountAmount > 1 {
+		v.Add("discount_amount", "must be a fraction of the price between 0 and 1")
+	}
+}
+
+func clientAddress(v *validator.Violations, address string, latitude, longitude float64) {
+	v.Required("address", address)
+	v.Range("latitude", latitude, -90, 90)
+	v.Range("longitude", longitude, -180, 180)
+}
+
+// staff covers users and couriers, passwords arrive already hashed by the gateway
+func staff(v *validator.Violations, firstname string, branchId int32, phone, login, password string) {
+	v.Required("firstname", firstname)
+	v.RequiredID("branch_id", branchId)
+	v.Required("phone", phone)
+	v.Phone("phone", phone)
+	if !helper.IsValidLogin(login) {
+		v.Add("login", "must start with a letter and contain 6-30 letters, digits or underscores")
+	}
+	v.Required("password", password)
+}
+
+// listCursor checks that cursor is empty or a next_cursor of a list
+func listCursor(v *validator.Violations, cursor string) {
+	if cursor == "" {
+		return
+	}
+	if _, _, err := helper.DecodeCursor(cursor); err != nil {
+		v.Add("cursor", "must be the next_cursor of a previous page")
+	}
+}
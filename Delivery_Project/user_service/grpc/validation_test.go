@@ -0,0 +1,84 @@
+package grpc
+
+import (
+	"reflect"
+	"testing"
+
+	user_service "user_service/genproto"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+// violatedFields lists the fields a validation error complains about
+func violatedFields(err error) []string {
+	var fields []string
+	for _, d := range status.Convert(err).Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+
+	return fields
+}
+
+func TestValidationRules(t *testing.T) {
+	branch := func(start, end string) *user_service.CreateBranchRequest {
+		return &user_service.CreateBranchRequest{Name: "Chilonzor", Phone: "+998901234567", WorkHourStart: start, WorkHourEnd: end}
+	}
+	client := func(discountType string, amount float64) *user_service.CreateClientsRequest {
+		return &user_service.CreateClientsRequest{
+			Firstname: "Aziz", Phone: "+998901234567", BirthDate: "1995-04-12",
+			DiscountType: discountType, DiscountAmount: amount,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		req        interface{}
+		wantFields []string
+	}{
+		{name: "branch daytime hours", req: branch("09:00", "22:00")},
+		{name: "branch closes after midnight", req: branch("18:00", "02:00")},
+		{name: "branch hours with seconds", req: branch("08:00:00", "20:30:00")},
+		{name: "branch same start and end", req: branch("10:00", "10:00"), wantFields: []string{"work_hour_end"}},
+		{name: "branch bad clock", req: branch("9am", "22:00"), wantFields: []string{"work_hour_start"}},
+		{name: "branch missing hours", req: branch("", ""), wantFields: []string{"work_hour_start", "work_hour_end"}},
+		{
+			name:       "branch bad phone",
+			req:        &user_service.CreateBranchRequest{Name: "Chilonzor", Phone: "901234567", WorkHourStart: "09:00", WorkHourEnd: "22:00"},
+			wantFields: []string{"phone"},
+		},
+		{name: "client without discount", req: client("", 0)},
+		{name: "client percent fraction", req: client("percent", 0.15)},
+		{name: "client full percent", req: client("percent", 1)},
+		{name: "client percent above one", req: client("percent", 15), wantFields: []string{"discount_amount"}},
+		{name: "client fixed above one", req: client("fixed", 15000)},
+		{name: "client negative discount", req: client("fixed", -1), wantFields: []string{"discount_amount"}},
+		{name: "client amount without type", req: client("", 0.1), wantFields: []string{"discount_type"}},
+		{name: "client unknown type", req: client("bonus", 0.1), wantFields: []string{"discount_type"}},
+		{
+			name:       "client born in the future",
+			req:        &user_service.CreateClientsRequest{Firstname: "Aziz", Phone: "+998901234567", BirthDate: "2999-01-01"},
+			wantFields: []string{"birth_date"},
+		},
+		{name: "client order", req: &user_service.UpdateClientsOrderRequest{Id: 9, TotalOrdersCount: 1, TotalOrdersSum: 120000, OrderId: "003-240301-0001"}},
+		{
+			name:       "client order without order id",
+			req:        &user_service.UpdateClientsOrderRequest{Id: 9, TotalOrdersCount: 1, TotalOrdersSum: 120000},
+			wantFields: []string{"order_id"},
+		},
+	}
+
+	rules := validationRules()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := rules.Validate(tt.req)
+			if got := violatedFields(err); !reflect.DeepEqual(got, tt.wantFields) {
+				t.Errorf("Validate() = %v, want violations of %v", err, tt.wantFields)
+			}
+		})
+	}
+}
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "bonus_transactions";
+DROP FUNCTION IF EXISTS "bonus_transactions_append_only"();
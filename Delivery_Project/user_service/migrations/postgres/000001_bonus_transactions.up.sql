@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS "bonus_transactions" (
+    "id" SERIAL PRIMARY KEY,
+    "client_id" INT NOT NULL REFERENCES "clients" ("id"),
+    "type" VARCHAR(16) NOT NULL CHECK ("type" IN ('accrual', 'spend', 'refund', 'expire')),
+    "amount" NUMERIC NOT NULL,
+    "order_id" VARCHAR(64) NOT NULL DEFAULT '',
+    "ref_id" INT REFERENCES "bonus_transactions" ("id"),
+    "expires_at" TIMESTAMP,
+    "created_at" TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS "bonus_transactions_client_idx" ON "bonus_transactions" ("client_id", "created_at");
+
+-- one accrual per order and one refund per spend
+CREATE UNIQUE INDEX IF NOT EXISTS "bonus_transactions_accrual_uidx" ON "bonus_transactions" ("order_id") WHERE "type" = 'accrual';
+CREATE UNIQUE INDEX IF NOT EXISTS "bonus_transactions_refund_uidx" ON "bonus_transactions" ("ref_id") WHERE "type" = 'refund';
+
+-- the ledger is append-only
+CREATE OR REPLACE FUNCTION "bonus_transactions_append_only"() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'bonus_transactions is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "bonus_transactions_append_only"
+    BEFORE UPDATE OR DELETE ON "bonus_transactions"
+    FOR EACH ROW EXECUTE FUNCTION "bonus_transactions_append_only"();
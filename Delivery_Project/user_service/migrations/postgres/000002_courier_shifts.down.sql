@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "courier_shifts";
+
+ALTER TABLE "couriers" DROP COLUMN IF EXISTS "availability_changed_at";
+ALTER TABLE "couriers" DROP COLUMN IF EXISTS "availability";
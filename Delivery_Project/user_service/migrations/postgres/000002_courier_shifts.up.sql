@@ -0,0 +1,19 @@
+ALTER TABLE "couriers" ADD COLUMN IF NOT EXISTS "availability" VARCHAR(16) NOT NULL DEFAULT 'offline'
+    CHECK ("availability" IN ('online', 'offline', 'break'));
+ALTER TABLE "couriers" ADD COLUMN IF NOT EXISTS "availability_changed_at" TIMESTAMP;
+
+-- durations are filled when the shift ends, break time is added whenever a break is over
+CREATE TABLE IF NOT EXISTS "courier_shifts" (
+    "id" SERIAL PRIMARY KEY,
+    "courier_id" INT NOT NULL REFERENCES "couriers" ("id"),
+    "branch_id" INT NOT NULL,
+    "started_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+    "ended_at" TIMESTAMP,
+    "break_seconds" INT NOT NULL DEFAULT 0,
+    "duration_seconds" INT
+);
+
+CREATE INDEX IF NOT EXISTS "courier_shifts_courier_idx" ON "courier_shifts" ("courier_id", "started_at");
+
+-- a courier has at most one open shift
+CREATE UNIQUE INDEX IF NOT EXISTS "courier_shifts_open_uidx" ON "courier_shifts" ("courier_id") WHERE "ended_at" IS NULL;
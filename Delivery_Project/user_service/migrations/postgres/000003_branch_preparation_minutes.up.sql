@@ -0,0 +1,3 @@
+-- 0 means the branch uses the default preparation time of the order service
+ALTER TABLE "branches" ADD COLUMN IF NOT EXISTS "preparation_minutes" INT NOT NULL DEFAULT 0
+    CHECK ("preparation_minutes" >= 0);
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS "client_addresses" (
+    "id" SERIAL PRIMARY KEY,
+    "client_id" INT NOT NULL REFERENCES "clients" ("id"),
+    "label" VARCHAR(64) NOT NULL DEFAULT '',
+    "address" TEXT NOT NULL,
+    "latitude" NUMERIC(9, 6) NOT NULL DEFAULT 0,
+    "longitude" NUMERIC(9, 6) NOT NULL DEFAULT 0,
+    "entrance" VARCHAR(16) NOT NULL DEFAULT '',
+    "floor" VARCHAR(16) NOT NULL DEFAULT '',
+    "apartment" VARCHAR(16) NOT NULL DEFAULT '',
+    "courier_comment" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+    "updated_at" TIMESTAMP,
+    "deleted_at" TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "client_addresses_client_idx" ON "client_addresses" ("client_id") WHERE "deleted_at" IS NULL;
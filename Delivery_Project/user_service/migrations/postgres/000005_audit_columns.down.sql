@@ -0,0 +1,5 @@
+ALTER TABLE "client_addresses" DROP COLUMN IF EXISTS "updated_by", DROP COLUMN IF EXISTS "created_by";
+ALTER TABLE "clients" DROP COLUMN IF EXISTS "updated_by", DROP COLUMN IF EXISTS "created_by";
+ALTER TABLE "couriers" DROP COLUMN IF EXISTS "updated_by", DROP COLUMN IF EXISTS "created_by";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "updated_by", DROP COLUMN IF EXISTS "created_by";
+ALTER TABLE "branches" DROP COLUMN IF EXISTS "updated_by", DROP COLUMN IF EXISTS "created_by";
@@ -0,0 +1,20 @@
+-- who created and last updated a row, as role:id of the caller, see pkg/identity
+ALTER TABLE "branches"
+    ADD COLUMN IF NOT EXISTS "created_by" VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS "updated_by" VARCHAR(64);
+
+ALTER TABLE "users"
+    ADD COLUMN IF NOT EXISTS "created_by" VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS "updated_by" VARCHAR(64);
+
+ALTER TABLE "couriers"
+    ADD COLUMN IF NOT EXISTS "created_by" VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS "updated_by" VARCHAR(64);
+
+ALTER TABLE "clients"
+    ADD COLUMN IF NOT EXISTS "created_by" VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS "updated_by" VARCHAR(64);
+
+ALTER TABLE "client_addresses"
+    ADD COLUMN IF NOT EXISTS "created_by" VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS "updated_by" VARCHAR(64);
@@ -0,0 +1,9 @@
+DROP TRIGGER IF EXISTS "branches_audit" ON "branches";
+DROP TRIGGER IF EXISTS "users_audit" ON "users";
+DROP TRIGGER IF EXISTS "couriers_audit" ON "couriers";
+DROP TRIGGER IF EXISTS "clients_audit" ON "clients";
+DROP TRIGGER IF EXISTS "client_addresses_audit" ON "client_addresses";
+DROP TRIGGER IF EXISTS "courier_shifts_audit" ON "courier_shifts";
+DROP TRIGGER IF EXISTS "bonus_transactions_audit" ON "bonus_transactions";
+DROP FUNCTION IF EXISTS audit_changes();
+DROP TABLE IF EXISTS "audit_log";
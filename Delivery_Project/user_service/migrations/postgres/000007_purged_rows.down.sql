@@ -0,0 +1,3 @@
+ALTER TABLE "couriers" DROP COLUMN IF EXISTS "purged_at";
+ALTER TABLE "clients" DROP COLUMN IF EXISTS "purged_at";
+ALTER TABLE "branches" DROP COLUMN IF EXISTS "purged_at";
@@ -0,0 +1,6 @@
+-- rows deleted longer than the retention ago are purged. Branches, clients
+-- and couriers are referenced by orders of order_service, so they are kept
+-- with their personal data scrubbed and marked by purged_at, users are deleted
+ALTER TABLE "branches" ADD COLUMN IF NOT EXISTS "purged_at" TIMESTAMP;
+ALTER TABLE "clients" ADD COLUMN IF NOT EXISTS "purged_at" TIMESTAMP;
+ALTER TABLE "couriers" ADD COLUMN IF NOT EXISTS "purged_at" TIMESTAMP;
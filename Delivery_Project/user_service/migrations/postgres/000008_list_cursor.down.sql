@@ -0,0 +1 @@
+DROP INDEX IF EXISTS "clients_created_at_id_idx";
@@ -0,0 +1,2 @@
+-- lists page by ("created_at", "id") newest first, see helper.EncodeCursor
+CREATE INDEX IF NOT EXISTS "clients_created_at_id_idx" ON "clients" ("created_at" DESC, "id" DESC) WHERE "deleted_at" IS NULL;
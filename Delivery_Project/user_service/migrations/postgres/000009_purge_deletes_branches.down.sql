@@ -0,0 +1 @@
+ALTER TABLE "branches" ADD COLUMN IF NOT EXISTS "purged_at" TIMESTAMP;
@@ -0,0 +1,3 @@
+-- purged branches are deleted now once no user or courier belongs to them,
+-- orders keep only the branch id, see branchRepo.Purge
+ALTER TABLE "branches" DROP COLUMN IF EXISTS "purged_at";
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS "bonus_transactions_spend_uidx";
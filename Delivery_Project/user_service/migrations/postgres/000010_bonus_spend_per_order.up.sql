@@ -0,0 +1,3 @@
+-- one spend per order, order service retries a spend with the same order id.
+-- Older spends were made before the order existed and have no order id.
+CREATE UNIQUE INDEX IF NOT EXISTS "bonus_transactions_spend_uidx" ON "bonus_transactions" ("order_id") WHERE "type" = 'spend' AND "order_id" <> '';
@@ -0,0 +1,7 @@
+-- orders already counted in the client statistics, order service retries the
+-- update of a finished order until it goes through
+CREATE TABLE IF NOT EXISTS "client_order_stats" (
+    "order_id" VARCHAR PRIMARY KEY,
+    "client_id" INT NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT NOW()
+);
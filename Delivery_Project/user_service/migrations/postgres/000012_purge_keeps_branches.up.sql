@@ -0,0 +1,3 @@
+-- orders of order_service refer to branches by id, so purged branches are
+-- kept with their contacts scrubbed and marked by purged_at, see branchRepo.Purge
+ALTER TABLE "branches" ADD COLUMN IF NOT EXISTS "purged_at" TIMESTAMP;
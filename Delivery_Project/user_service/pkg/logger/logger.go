@@ -19,6 +19,8 @@ var (
 	Bool = zap.Bool
 	// Any ...
 	Any = zap.Any
+	// Duration ...
+	Duration = zap.Duration
 )
 
 // Logger ...
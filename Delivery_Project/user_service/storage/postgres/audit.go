@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"user_service/pkg/identity"
+	"user_service/pkg/interceptor"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// actor is the caller written to the created_by and updated_by columns, NULL
+// for anonymous calls
+func actor(c context.Context) *string {
+	id, ok := identity.FromContext(c)
+	if !ok {
+		return nil
+	}
+
+	subject := id.Subject()
+	return &subject
+}
+
+// auditSession is the caller and request id set on a pooled connection for
+// the audit_changes trigger, the zero value is a connection with none set
+type auditSession struct {
+	actor     string
+	requestID string
+}
+
+// sweepAuditSessionsAt is the number of remembered connections from which the
+// closed ones are forgotten, the pool has no hook for closed connections
+const sweepAuditSessionsAt = 256
+
+// auditSessions remembers the session of each pooled connection, so the
+// settings are only sent when they change
+var auditSessions = struct {
+	sync.Mutex
+	values map[*pgx.Conn]auditSession
+}{values: make(map[*pgx.Conn]auditSession)}
+
+// setAuditSession runs before every acquire of a pooled connection and hands
+// the caller and request id of c to the audit_changes trigger. Calls without
+// an identity only clear what a past request left on the connection, so they
+// cost no round trip on a clean one.
+func setAuditSession(c context.Context, conn *pgx.Conn) bool {
+	var want auditSession
+	if by := actor(c); by != nil {
+		want = auditSession{actor: *by, requestID: interceptor.RequestID(c)}
+	}
+
+	auditSessions.Lock()
+	have := auditSessions.values[conn]
+	auditSessions.Unlock()
+	if have == want {
+		return true
+	}
+
+	_, err := conn.Exec(c,
+		`SELECT set_config('app.actor', $1, false), set_config('app.request_id', $2, false)`,
+		want.actor,
+		want.requestID,
+	)
+	if err != nil {
+		return false
+	}
+
+	auditSessions.Lock()
+	defer auditSessions.Unlock()
+	if want == (auditSession{}) {
+		delete(auditSessions.values, conn)
+		return true
+	}
+
+	auditSessions.values[conn] = want
+	if len(auditSessions.values) >= sweepAuditSessionsAt {
+		for known := range auditSessions.values {
+			if known.IsClosed() {
+				delete(auditSessions.values, known)
+			}
+		}
+	}
+
+	return true
+}
+
+// scrubAuditLog drops columns from the logged values of the entity rows with
+// ids, so personal data scrubbed from a table does not live on in the log
+func scrubAuditLog(c context.Context, tx pgx.Tx, entity string, ids []string, columns ...string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := tx.Exec(c, `
+		UPDATE "audit_log"
+		SET
+			"before" = "before" - $3::TEXT[],
+			"after" = "after" - $3::TEXT[]
+		WHERE "entity" = $1 AND "entity_id" = ANY($2)`,
+		entity,
+		ids,
+		columns,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to scrub %s audit log: %w", entity, err)
+	}
+
+	return nil
+}
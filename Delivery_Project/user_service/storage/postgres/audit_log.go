@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	pb "user_service/genproto"
+	"user_service/pkg/helper"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// auditDateLayout is a whole day, "to" in this layout takes the day in
+const (
+	auditDateLayout = "2006-01-02"
+	auditTimeLayout = "2006-01-02 15:04:05"
+)
+
+type auditRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewAudit(db *pgxpool.Pool) *auditRepo {
+	return &auditRepo{
+		db: db,
+	}
+}
+
+// parseAuditTime reads a from/to bound of the list, a date "to" is moved to the
+// start of the next day
+func parseAuditTime(value string, upper bool) (time.Time, error) {
+	if t, err := time.Parse(auditTimeLayout, value); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse(auditDateLayout, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if upper {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t, nil
+}
+
+func (b *auditRepo) List(c context.Context, req *pb.ListAuditRequest) (*pb.ListAuditResponse, error) {
+	var (
+		filter = ` WHERE TRUE `
+		params = make(map[string]interface{})
+	)
+	resp := pb.ListAuditResponse{
+		Entries: make([]*pb.AuditEntry, 0),
+	}
+
+	if req.Entity != "" {
+		filter += ` AND "entity" = :entity `
+		params["entity"] = req.Entity
+	}
+	if req.EntityId != "" {
+		filter += ` AND "entity_id" = :row_id `
+		params["row_id"] = req.EntityId
+	}
+	if req.Actor != "" {
+		filter += ` AND "actor" = :actor `
+		params["actor"] = req.Actor
+	}
+	if req.From != "" {
+		from, err := parseAuditTime(req.From, false)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from %w", err)
+		}
+		filter += ` AND "created_at" >= :from_time `
+		params["from_time"] = from
+	}
+	if req.To != "" {
+		to, err := parseAuditTime(req.To, true)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to %w", err)
+		}
+		filter += ` AND "created_at" < :to_time `
+		params["to_time"] = to
+	}
+
+	q, arr := helper.ReplaceQueryParams(`SELECT count(1) FROM "audit_log" `+filter, params)
+	if err := b.db.QueryRow(c, q, arr...).Scan(&resp.Count); err != nil {
+		return nil, fmt.Errorf("error while scanning audit count %w", err)
+	}
+
+	query := `
+		SELECT
+			"id",
+			"entity",
+			"entity_id",
+			"action",
+			COALESCE("actor", ''),
+			COALESCE("request_id", ''),
+			COALESCE("before"::TEXT, ''),
+			COALESCE("after"::TEXT, ''),
+			"created_at"
+		FROM "audit_log" ` + filter + `
+		ORDER BY "created_at" DESC, "id" DESC
+		LIMIT :limit OFFSET :offset`
+
+	params["limit"] = 10
+	params["offset"] = 0
+	if req.Limit > 0 {
+		params["limit"] = req.Limit
+	}
+	if req.Page > 0 {
+		params["offset"] = (req.Page - 1) * req.Limit
+	}
+
+	q, arr = helper.ReplaceQueryParams(query, params)
+	rows, err := b.db.Query(c, q, arr...)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting audit rows %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			entry     pb.AuditEntry
+			createdAt sql.NullTime
+		)
+		err = rows.Scan(
+			&entry.Id,
+			&entry.Entity,
+			&entry.EntityId,
+			&entry.Action,
+			&entry.Actor,
+			&entry.RequestId,
+			&entry.Before,
+			&entry.After,
+			&createdAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning audit entry %w", err)
+		}
+		if createdAt.Valid {
+			entry.CreatedAt = createdAt.Time.Format(auditTimeLayout)
+		}
+
+		resp.Entries = append(resp.Entries, &entry)
+	}
+
+	return &resp, rows.Err()
+}
@@ -0,0 +1,319 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+	"user_service/pkg/errs"
+
+	user_service "user_service/genproto"
+	"user_service/pkg/helper"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type bonusRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewBonus(db *pgxpool.Pool) *bonusRepo {
+	return &bonusRepo{
+		db: db,
+	}
+}
+
+const bonusTransactionColumns = `
+			"id",
+			"client_id",
+			"type",
+			"amount",
+			"order_id",
+			"expires_at"::text,
+			"created_at"::text`
+
+// Accrue credits the points for a finished order. Accruing the same order twice
+// returns the first transaction instead of crediting again.
+func (b *bonusRepo) Accrue(c context.Context, req *user_service.AccrueBonusRequest, amount float64, expiresAt time.Time) (*user_service.BonusTransaction, error) {
+	query := `
+		INSERT INTO "bonus_transactions"(
+			"client_id",
+			"type",
+			"amount",
+			"order_id",
+			"expires_at",
+			"created_at"
+		) VALUES ($1, 'accrual', $2, $3, $4, NOW())
+		ON CONFLICT ("order_id") WHERE "type" = 'accrual' DO NOTHING
+		RETURNING ` + bonusTransactionColumns
+
+	resp, err := scanBonusTransaction(b.db.QueryRow(c, query, req.ClientId, amount, req.OrderId, expiresAt))
+	if err == pgx.ErrNoRows {
+		query = `SELECT ` + bonusTransactionColumns + ` FROM "bonus_transactions" WHERE "order_id" = $1 AND "type" = 'accrual'`
+		resp, err = scanBonusTransaction(b.db.QueryRow(c, query, req.OrderId))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to accrue bonus: %w", err)
+	}
+
+	return resp, nil
+}
+
+// Spend debits the points used on an order. Spending on the same order twice
+// returns the first transaction instead of debiting again.
+func (b *bonusRepo) Spend(c context.Context, req *user_service.SpendBonusRequest) (*user_service.BonusTransaction, error) {
+	tx, err := b.db.Begin(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(c)
+
+	// the client row lock serializes concurrent spends of one wallet
+	var clientId int32
+	err = tx.QueryRow(c, `SELECT "id" FROM "clients" WHERE "id" = $1 AND "deleted_at" IS NULL FOR UPDATE`, req.ClientId).Scan(&clientId)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errs.NotFound("client not found")
+		}
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	query := `SELECT ` + bonusTransactionColumns + ` FROM "bonus_transactions" WHERE "order_id" = $1 AND "type" = 'spend'`
+	resp, err := scanBonusTransaction(tx.QueryRow(c, query, req.OrderId))
+	if err == nil {
+		return resp, nil
+	}
+	if err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("failed to get order spend: %w", err)
+	}
+
+	var balance float64
+	err = tx.QueryRow(c, `SELECT COALESCE(SUM("amount"), 0) FROM "bonus_transactions" WHERE "client_id" = $1`, req.ClientId).Scan(&balance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bonus balance: %w", err)
+	}
+
+	if req.Amount > balance {
+		return nil, errs.FailedPrecondition("not enough bonus points: balance %.2f, requested %.2f", balance, req.Amount)
+	}
+
+	query = `
+		INSERT INTO "bonus_transactions"(
+			"client_id",
+			"type",
+			"amount",
+			"order_id",
+			"created_at"
+		) VALUES ($1, 'spend', $2, $3, NOW())
+		RETURNING ` + bonusTransactionColumns
+
+	resp, err = scanBonusTransaction(tx.QueryRow(c, query, req.ClientId, -req.Amount, req.OrderId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to spend bonus: %w", err)
+	}
+
+	if err = tx.Commit(c); err != nil {
+		return nil, fmt.Errorf("failed to commit bonus spend: %w", err)
+	}
+
+	return resp, nil
+}
+
+// Refund gives back the points of a spend transaction, e.g. when the order
+// they were spent on could not be created.
+func (b *bonusRepo) Refund(c context.Context, req *user_service.IdRequest, expiresAt time.Time) (*user_service.BonusTransaction, error) {
+	query := `
+		INSERT INTO "bonus_transactions"(
+			"client_id",
+			"type",
+			"amount",
+			"order_id",
+			"ref_id",
+			"expires_at",
+			"created_at"
+		)
+		SELECT "client_id", 'refund', -"amount", "order_id", "id", $2, NOW()
+		FROM "bonus_transactions"
+		WHERE "id" = $1 AND "type" = 'spend'
+		ON CONFLICT ("ref_id") WHERE "type" = 'refund' DO NOTHING
+		RETURNING ` + bonusTransactionColumns
+
+	resp, err := scanBonusTransaction(b.db.QueryRow(c, query, req.Id, expiresAt))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errs.NotFound("spend transaction with ID %d not found or already refunded", req.Id)
+		}
+		return nil, fmt.Errorf("failed to refund bonus: %w", err)
+	}
+
+	return resp, nil
+}
+
+// RefundOrder gives back the points spent on an order that could not be
+// created. Refunding the same order twice returns the first refund.
+func (b *bonusRepo) RefundOrder(c context.Context, req *user_service.RefundOrderRequest, expiresAt time.Time) (*user_service.BonusTransaction, error) {
+	query := `
+		INSERT INTO "bonus_transactions"(
+			"client_id",
+			"type",
+			"amount",
+			"order_id",
+			"ref_id",
+			"expires_at",
+			"created_at"
+		)
+		SELECT "client_id", 'refund', -"amount", "order_id", "id", $2, NOW()
+		FROM "bonus_transactions"
+		WHERE "order_id" = $1 AND "type" = 'spend'
+		ON CONFLICT ("ref_id") WHERE "type" = 'refund' DO NOTHING
+		RETURNING ` + bonusTransactionColumns
+
+	resp, err := scanBonusTransaction(b.db.QueryRow(c, query, req.OrderId, expiresAt))
+	if err == pgx.ErrNoRows {
+		query = `SELECT ` + bonusTransactionColumns + ` FROM "bonus_transactions" WHERE "order_id" = $1 AND "type" = 'refund'`
+		resp, err = scanBonusTransaction(b.db.QueryRow(c, query, req.OrderId))
+	}
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errs.NotFound("no bonus points were spent on order %s", req.OrderId)
+		}
+		return nil, fmt.Errorf("failed to refund order bonus: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (b *bonusRepo) GetBalance(c context.Context, req *user_service.IdRequest) (*user_service.BonusBalance, error) {
+	resp := user_service.BonusBalance{ClientId: req.Id}
+
+	err := b.db.QueryRow(c, `SELECT COALESCE(SUM("amount"), 0) FROM "bonus_transactions" WHERE "client_id" = $1`, req.Id).Scan(&resp.Balance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bonus balance: %w", err)
+	}
+
+	return &resp, nil
+}
+
+func (b *bonusRepo) GetStatement(c context.Context, req *user_service.BonusStatementRequest) (*user_service.BonusStatementResponse, error) {
+	var (
+		resp   user_service.BonusStatementResponse
+		err    error
+		filter string = ` WHERE "client_id" = :client_id `
+		params        = make(map[string]interface{})
+	)
+	params["client_id"] = req.ClientId
+
+	if req.CreatedAtFrom != "" {
+		filter += " AND created_at >= :created_at_from"
+		params["created_at_from"] = req.CreatedAtFrom
+	}
+
+	if req.CreatedAtTo != "" {
+		filter += " AND created_at <= :created_at_to"
+		params["created_at_to"] = req.CreatedAtTo
+	}
+
+	countQuery := `SELECT count(1) FROM "bonus_transactions" ` + filter
+	q, arr := helper.ReplaceQueryParams(countQuery, params)
+	err = b.db.QueryRow(c, q, arr...).Scan(&resp.Count)
+	if err != nil {
+		return nil, fmt.Errorf("error while scanning count %w", err)
+	}
+
+	query := `SELECT ` + bonusTransactionColumns + ` FROM "bonus_transactions" ` + filter +
+		` ORDER BY created_at DESC, id DESC LIMIT :limit OFFSET :offset`
+
+	params["limit"] = 10
+	params["offset"] = 0
+
+	if req.Limit > 0 {
+		params["limit"] = req.Limit
+	}
+	if req.Page > 0 {
+		params["offset"] = (req.Page - 1) * req.Limit
+	}
+
+	q, arr = helper.ReplaceQueryParams(query, params)
+	rows, err := b.db.Query(c, q, arr...)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting rows %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		transaction, err := scanBonusTransaction(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning bonus transaction err: %w", err)
+		}
+		resp.Transactions = append(resp.Transactions, transaction)
+	}
+
+	balance, err := b.GetBalance(c, &user_service.IdRequest{Id: req.ClientId})
+	if err != nil {
+		return nil, err
+	}
+	resp.Balance = balance.Balance
+
+	return &resp, nil
+}
+
+// ExpirePoints writes off credits whose expires_at has passed. Debits are taken
+// from the oldest credits first, so whatever the debits did not cover of the
+// expired credits is still on the wallet and gets expired now.
+func (b *bonusRepo) ExpirePoints(c context.Context) (int64, error) {
+	query := `
+		INSERT INTO "bonus_transactions"(
+			"client_id",
+			"type",
+			"amount",
+			"created_at"
+		)
+		SELECT "client_id", 'expire', -"expired", NOW()
+		FROM (
+			SELECT
+				"client_id",
+				COALESCE(SUM("amount") FILTER (WHERE "amount" > 0 AND "expires_at" <= NOW()), 0)
+				+ COALESCE(SUM("amount") FILTER (WHERE "amount" < 0), 0) AS "expired"
+			FROM "bonus_transactions"
+			GROUP BY "client_id"
+		) t
+		WHERE "expired" > 0`
+
+	result, err := b.db.Exec(c, query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire bonus points: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func scanBonusTransaction(row pgx.Row) (*user_service.BonusTransaction, error) {
+	var (
+		transaction user_service.BonusTransaction
+		expiresAt   sql.NullString
+		createdAt   sql.NullString
+	)
+
+	err := row.Scan(
+		&transaction.Id,
+		&transaction.ClientId,
+		&transaction.Type,
+		&transaction.Amount,
+		&transaction.OrderId,
+		&expiresAt,
+		&createdAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if expiresAt.Valid {
+		transaction.ExpiresAt = expiresAt.String
+	}
+	if createdAt.Valid {
+		transaction.CreatedAt = createdAt.String
+	}
+
+	return &transaction, nil
+}
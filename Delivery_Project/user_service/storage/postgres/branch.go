@@ -5,11 +5,12 @@ import (
 	"database/sql"
 	"fmt"
 	"time"
+	"user_service/pkg/errs"
 
 	user_service "user_service/genproto"
 	"user_service/pkg/helper"
 
-	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
@@ -35,9 +36,11 @@ func (b *branchRepo) Create(c context.Context, req *user_service.CreateBranchReq
 		work_hour_end,
 		address,
 		destination,
-		created_at
+		preparation_minutes,
+		created_at,
+		created_by
 	  ) VALUES (
-		$1, $2, $3, $4, $5, $6, $7, $8, $9
+		$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 	  ) RETURNING id`
 
 	var id int
@@ -50,7 +53,9 @@ func (b *branchRepo) Create(c context.Context, req *user_service.CreateBranchReq
 		req.WorkHourEnd,
 		req.Address,
 		req.Destination,
+		req.PreparationMinutes,
 		time.Now(),
+		actor(c),
 	).Scan(&id)
 
 	if err != nil {
@@ -76,6 +81,7 @@ func (b *branchRepo) Get(c context.Context, req *user_service.IdRequest) (resp *
 		address,
 		active,
 		destination,
+		preparation_minutes,
 		created_at::TEXT,
 		updated_at::TEXT 
     FROM branches 
@@ -94,12 +100,13 @@ func (b *branchRepo) Get(c context.Context, req *user_service.IdRequest) (resp *
 		&branch.Address,
 		&branch.Active,
 		&branch.Destination,
+		&branch.PreparationMinutes,
 		&branch.CreatedAt,
 		&updatedAt,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("branch not found")
+			return nil, errs.NotFound("branch not found")
 		}
 		return nil, fmt.Errorf("failed to get branch: %w", err)
 	}
@@ -115,6 +122,16 @@ func (b *branchRepo) Get(c context.Context, req *user_service.IdRequest) (resp *
 }
 
 func (b *branchRepo) GetList(c context.Context, req *user_service.ListBranchRequest) (*user_service.ListBranchResponse, error) {
+	return b.list(c, req, `"deleted_at" IS NULL AND "active"`)
+}
+
+// GetDeletedList lists deleted branchs that can still be restored
+func (b *branchRepo) GetDeletedList(c context.Context, req *user_service.ListDeletedRequest) (*user_service.ListBranchResponse, error) {
+	return b.list(c, &user_service.ListBranchRequest{Limit: req.Limit, Page: req.Page}, `"deleted_at" IS NOT NULL AND "purged_at" IS NULL`)
+}
+
+// list lists the branchs of scope matching req
+func (b *branchRepo) list(c context.Context, req *user_service.ListBranchRequest, scope string) (*user_service.ListBranchResponse, error) {
 
 	var (
 		updatedAt sql.NullString
@@ -139,7 +156,7 @@ func (b *branchRepo) GetList(c context.Context, req *user_service.ListBranchRequ
 		filter += " AND created_at <= :created_at_to"
 		params["created_at_to"] = req.CreatedAtTo
 	}
-	countQuery := `SELECT count(1) FROM "branches" WHERE "deleted_at" IS NULL AND "active"` + filter
+	countQuery := `SELECT count(1) FROM "branches" WHERE ` + scope + filter
 	q, arr := helper.ReplaceQueryParams(countQuery, params)
 	err = b.db.QueryRow(c, q, arr...).Scan(
 		&resp.Count,
@@ -161,9 +178,10 @@ func (b *branchRepo) GetList(c context.Context, req *user_service.ListBranchRequ
 		address,
 		active,
 		destination,
+		preparation_minutes,
 		created_at,
 		updated_at 
-	FROM branches  where "active" and "deleted_at" is null` + filter
+	FROM branches  where ` + scope + filter
 
 	query += " ORDER BY created_at DESC LIMIT :limit OFFSET :offset"
 	params["limit"] = 10
@@ -196,6 +214,7 @@ func (b *branchRepo) GetList(c context.Context, req *user_service.ListBranchRequ
 			&branch.Address,
 			&branch.Active,
 			&branch.Destination,
+			&branch.PreparationMinutes,
 			&createdAt,
 			&updatedAt,
 		)
@@ -229,11 +248,13 @@ func (b *branchRepo) Update(c context.Context, req *user_service.UpdateBranchReq
 				"work_hour_end"=$6,
 				"address"=$7,
 				"destination"=$8,
+				"preparation_minutes"=$9,
+				"updated_by"=$11,
 				"updated_at" = NOW() 
-				WHERE id = $9`
+				WHERE id = $10`
 
 	result, err := b.db.Exec(
-		context.Background(),
+		c,
 		query,
 		req.Name,
 		req.Phone,
@@ -243,7 +264,9 @@ func (b *branchRepo) Update(c context.Context, req *user_service.UpdateBranchReq
 		req.WorkHourEnd,
 		req.Address,
 		req.Destination,
+		req.PreparationMinutes,
 		req.Id,
+		actor(c),
 	)
 
 	if err != nil {
@@ -251,7 +274,7 @@ func (b *branchRepo) Update(c context.Context, req *user_service.UpdateBranchReq
 	}
 
 	if result.RowsAffected() == 0 {
-		return "", fmt.Errorf("branch with ID %d not found", req.Id)
+		return "", errs.NotFound("branch with ID %d not found", req.Id)
 	}
 
 	return fmt.Sprintf("branch with ID %d updated", req.Id), nil
@@ -267,7 +290,7 @@ func (b *branchRepo) Delete(c context.Context, req *user_service.IdRequest) (res
 				WHERE id = $1 AND "deleted_at" IS NULL and "active"`
 
 	result, err := b.db.Exec(
-		context.Background(),
+		c,
 		query,
 		req.Id,
 	)
@@ -277,7 +300,7 @@ func (b *branchRepo) Delete(c context.Context, req *user_service.IdRequest) (res
 	}
 
 	if result.RowsAffected() == 0 {
-		return "", fmt.Errorf("branch with ID %d not found", req.Id)
+		return "", errs.NotFound("branch with ID %d not found", req.Id)
 	}
 
 	return "deleted", nil
@@ -319,6 +342,7 @@ func (b *branchRepo) GetListActive(c context.Context, req *user_service.ListActi
 		address,
 		active,
 		destination,
+		preparation_minutes,
 		created_at,
 		updated_at 
 	FROM branches  where "active" and "deleted_at" is null` + filter
@@ -352,6 +376,7 @@ func (b *branchRepo) GetListActive(c context.Context, req *user_service.ListActi
 			&branch.Address,
 			&branch.Active,
 			&branch.Destination,
+			&branch.PreparationMinutes,
 			&createdAt,
 			&updatedAt,
 		)
@@ -371,3 +396,49 @@ func (b *branchRepo) GetListActive(c context.Context, req *user_service.ListActi
 
 	return &resp, nil
 }
+
+// Restore undoes the delete of a branch that is not purged yet
+func (b *branchRepo) Restore(c context.Context, req *user_service.IdRequest) (string, error) {
+	result, err := b.db.Exec(c, `
+		UPDATE "branches"
+		SET
+			"active" = true,
+			"deleted_at" = NULL,
+			"updated_at" = NOW(),
+			"updated_by" = $2
+		WHERE "id" = $1 AND "deleted_at" IS NOT NULL AND "purged_at" IS NULL`,
+		req.Id,
+		actor(c),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to restore branch: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return "", errs.NotFound("deleted branch with ID %d not found", req.Id)
+	}
+
+	return "restored", nil
+}
+
+// Purge scrubs the contacts of branches deleted longer than retention ago.
+// Orders of order_service keep the id of their branch and users and couriers
+// may still belong to it, so the rows and their names are kept.
+func (b *branchRepo) Purge(c context.Context, retention time.Duration) (int64, error) {
+	result, err := b.db.Exec(c, `
+		UPDATE "branches"
+		SET
+			"phone" = '',
+			"photo" = '',
+			"address" = '',
+			"destination" = '',
+			"purged_at" = NOW()
+		WHERE "deleted_at" <= NOW() - $1 * INTERVAL '1 second' AND "purged_at" IS NULL`,
+		int64(retention.Seconds()),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge branches: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
+	"user_service/pkg/errs"
 
 	user_service "user_service/genproto"
 	"user_service/pkg/helper"
 
-	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
@@ -33,9 +35,10 @@ func (b *clientRepo) Create(c context.Context, req *user_service.CreateClientsRe
             discount_type,
             discount_amount,
 			last_ordered_date,
+			created_by,
 			created_at
         ) VALUES (
-            $1, $2, $3, $4, $5, $6, $7, now(), now()
+            $1, $2, $3, $4, $5, $6, $7, now(), $8, now()
         ) RETURNING id`
 
 	var id int
@@ -47,6 +50,7 @@ func (b *clientRepo) Create(c context.Context, req *user_service.CreateClientsRe
 		req.BirthDate,
 		req.DiscountType,
 		req.DiscountAmount,
+		actor(c),
 	).Scan(&id)
 
 	if err != nil {
@@ -55,7 +59,18 @@ func (b *clientRepo) Create(c context.Context, req *user_service.CreateClientsRe
 
 	return &user_service.Response{Message: fmt.Sprintf("%d", id)}, nil
 }
-func (b *clientRepo) Get(c context.Context, req *user_service.IdRequest) (resp *user_service.Clients, err error) {
+func (b *clientRepo) Get(c context.Context, req *user_service.IdRequest) (*user_service.Clients, error) {
+	return b.get(c, req, `"deleted_at" IS NULL`)
+}
+
+// GetWithDeleted gets a client even if it is deleted, as long as it is not
+// purged yet
+func (b *clientRepo) GetWithDeleted(c context.Context, req *user_service.IdRequest) (*user_service.Clients, error) {
+	return b.get(c, req, `"purged_at" IS NULL`)
+}
+
+// get gets the client of scope with the id of req
+func (b *clientRepo) get(c context.Context, req *user_service.IdRequest, scope string) (resp *user_service.Clients, err error) {
 	var (
 		createdAt sql.NullString
 		updatedAt sql.NullString
@@ -76,7 +91,7 @@ func (b *clientRepo) Get(c context.Context, req *user_service.IdRequest) (resp *
 		created_at,
 		updated_at 
     FROM clients 
-    WHERE id = $1 AND "deleted_at" IS NULL;`
+    WHERE id = $1 AND ` + scope
 
 	client := user_service.Clients{}
 
@@ -97,7 +112,7 @@ func (b *clientRepo) Get(c context.Context, req *user_service.IdRequest) (resp *
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("client not found")
+			return nil, errs.NotFound("client not found")
 		}
 		return nil, fmt.Errorf("failed to get client: %w", err)
 	}
@@ -112,6 +127,16 @@ func (b *clientRepo) Get(c context.Context, req *user_service.IdRequest) (resp *
 	return &client, nil
 }
 func (b *clientRepo) GetList(c context.Context, req *user_service.ListClientsRequest) (*user_service.ListClientsResponse, error) {
+	return b.list(c, req, `"deleted_at" IS NULL`)
+}
+
+// GetDeletedList lists deleted clients that can still be restored
+func (b *clientRepo) GetDeletedList(c context.Context, req *user_service.ListDeletedRequest) (*user_service.ListClientsResponse, error) {
+	return b.list(c, &user_service.ListClientsRequest{Limit: req.Limit, Page: req.Page}, `"deleted_at" IS NOT NULL AND "purged_at" IS NULL`)
+}
+
+// list lists the clients of scope matching req
+func (b *clientRepo) list(c context.Context, req *user_service.ListClientsRequest, scope string) (*user_service.ListClientsResponse, error) {
 
 	var (
 		resp   user_service.ListClientsResponse
@@ -187,11 +212,24 @@ func (b *clientRepo) GetList(c context.Context, req *user_service.ListClientsReq
 		params["created_at_to"] = req.CreatedAtTo
 	}
 
-	countQuery := `SELECT count(1) FROM clients WHERE deleted_at IS NULL` + filter
-	q, arr := helper.ReplaceQueryParams(countQuery, params)
-	err = b.db.QueryRow(c, q, arr...).Scan(&resp.Count)
-	if err != nil {
-		return nil, fmt.Errorf("error while scanning count: %w", err)
+	// a cursor skips the count unless it is asked for
+	if req.Cursor == "" || req.WithCount {
+		countQuery := `SELECT count(1) FROM clients WHERE ` + scope + filter
+		q, arr := helper.ReplaceQueryParams(countQuery, params)
+		err = b.db.QueryRow(c, q, arr...).Scan(&resp.Count)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning count: %w", err)
+		}
+	}
+
+	if req.Cursor != "" {
+		createdAt, id, err := helper.DecodeCursor(req.Cursor)
+		if err != nil {
+			return nil, errs.InvalidArgument("cursor: %s", err)
+		}
+		filter += ` AND ("created_at", "id") < (:after_created_at, :after_id)`
+		params["after_created_at"] = createdAt
+		params["after_id"] = id
 	}
 
 	query := `
@@ -210,20 +248,22 @@ func (b *clientRepo) GetList(c context.Context, req *user_service.ListClientsReq
 			created_at,
 			updated_at
 		FROM clients
-		WHERE deleted_at IS NULL` + filter +
-		` ORDER BY created_at DESC LIMIT :limit OFFSET :offset`
+		WHERE ` + scope + filter +
+		` ORDER BY "created_at" DESC, "id" DESC LIMIT :limit OFFSET :offset`
 
-	params["limit"] = 10
+	// one row more than the page tells if there is a next page
+	limit := int32(10)
 	params["offset"] = 0
 
 	if req.Limit > 0 {
-		params["limit"] = req.Limit
+		limit = req.Limit
 	}
-	if req.Page >= 0 {
+	params["limit"] = limit + 1
+	if req.Page >= 0 && req.Cursor == "" {
 		params["offset"] = (req.Page - 1) * req.Limit
 	}
 
-	q, arr = helper.ReplaceQueryParams(query, params)
+	q, arr := helper.ReplaceQueryParams(query, params)
 	rows, err := b.db.Query(c, q, arr...)
 	if err != nil {
 		return nil, fmt.Errorf("error while getting rows: %w", err)
@@ -266,6 +306,12 @@ func (b *clientRepo) GetList(c context.Context, req *user_service.ListClientsReq
 		resp.Clients = append(resp.Clients, &client)
 	}
 
+	if len(resp.Clients) > int(limit) {
+		resp.Clients = resp.Clients[:limit]
+		last := resp.Clients[limit-1]
+		resp.NextCursor = helper.EncodeCursor(last.CreatedAt, int64(last.Id))
+	}
+
 	return &resp, nil
 }
 
@@ -281,11 +327,12 @@ func (b *clientRepo) Update(c context.Context, req *user_service.UpdateClientsRe
 				"birth_date"=$5,
 				"discount_type"=$6,
 				"discount_amount"=$7,
+				"updated_by"=$9,
 				"updated_at" = NOW() 
 				WHERE id = $8`
 
 	result, err := b.db.Exec(
-		context.Background(),
+		c,
 		query,
 		req.Firstname,
 		req.Lastname,
@@ -295,6 +342,7 @@ func (b *clientRepo) Update(c context.Context, req *user_service.UpdateClientsRe
 		req.DiscountType,
 		req.DiscountAmount,
 		req.Id,
+		actor(c),
 	)
 
 	if err != nil {
@@ -302,7 +350,7 @@ func (b *clientRepo) Update(c context.Context, req *user_service.UpdateClientsRe
 	}
 
 	if result.RowsAffected() == 0 {
-		return "", fmt.Errorf("client with ID %d not found", req.Id)
+		return "", errs.NotFound("client with ID %d not found", req.Id)
 	}
 
 	return fmt.Sprintf("client with ID %d updated", req.Id), nil
@@ -317,7 +365,7 @@ func (b *clientRepo) Delete(c context.Context, req *user_service.IdRequest) (res
 				WHERE id = $1 AND "deleted_at" IS NULL`
 
 	result, err := b.db.Exec(
-		context.Background(),
+		c,
 		query,
 		req.Id,
 	)
@@ -327,13 +375,34 @@ func (b *clientRepo) Delete(c context.Context, req *user_service.IdRequest) (res
 	}
 
 	if result.RowsAffected() == 0 {
-		return "", fmt.Errorf("client with ID %d not found", req.Id)
+		return "", errs.NotFound("client with ID %d not found", req.Id)
 	}
 
 	return "deleted", nil
 }
 
+// UpdateOrder adds a finished order to the client statistics. The order is
+// counted once, updating it again returns without changing the client.
 func (b *clientRepo) UpdateOrder(c context.Context, req *user_service.UpdateClientsOrderRequest) (string, error) {
+	tx, err := b.db.Begin(c)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(c)
+
+	result, err := tx.Exec(c, `
+		INSERT INTO "client_order_stats"("order_id", "client_id", "created_at")
+		VALUES ($1, $2, NOW())
+		ON CONFLICT ("order_id") DO NOTHING`,
+		req.OrderId, req.Id,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to record client order: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Sprintf("order %s already counted", req.OrderId), nil
+	}
+
 	query := `
 		UPDATE "clients" 
 		SET 
@@ -343,7 +412,7 @@ func (b *clientRepo) UpdateOrder(c context.Context, req *user_service.UpdateClie
 		"updated_at" = NOW() 
 		WHERE id = $3`
 
-	result, err := b.db.Exec(
+	result, err = tx.Exec(
 		c,
 		query,
 		req.TotalOrdersSum,
@@ -355,10 +424,131 @@ func (b *clientRepo) UpdateOrder(c context.Context, req *user_service.UpdateClie
 		return "", fmt.Errorf("failed to update client order: %w", err)
 	}
 
-	rowsAffected := result.RowsAffected()
-	if rowsAffected == -1 {
-		return "", fmt.Errorf("client with ID %d not found", req.Id)
+	if result.RowsAffected() == 0 {
+		return "", errs.NotFound("client with ID %d not found", req.Id)
+	}
+
+	if err = tx.Commit(c); err != nil {
+		return "", fmt.Errorf("failed to commit client order: %w", err)
 	}
 
 	return fmt.Sprintf("client with ID %d updated", req.Id), nil
 }
+
+// Restore undoes the delete of a client that is not purged yet
+func (b *clientRepo) Restore(c context.Context, req *user_service.IdRequest) (string, error) {
+	result, err := b.db.Exec(c, `
+		UPDATE "clients"
+		SET
+			"deleted_at" = NULL,
+			"updated_at" = NOW(),
+			"updated_by" = $2
+		WHERE "id" = $1 AND "deleted_at" IS NOT NULL AND "purged_at" IS NULL`,
+		req.Id,
+		actor(c),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to restore client: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return "", errs.NotFound("deleted client with ID %d not found", req.Id)
+	}
+
+	return "restored", nil
+}
+
+// Purge scrubs the personal data of clients deleted longer than retention ago
+// and deletes their addresses. Orders refer to clients by id, so the rows are
+// kept, the birth date keeps only its year for reports.
+func (b *clientRepo) Purge(c context.Context, retention time.Duration) (int64, error) {
+	count, err := b.scrub(c, `"deleted_at" <= NOW() - $1 * INTERVAL '1 second' AND "purged_at" IS NULL`, int64(retention.Seconds()))
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge clients: %w", err)
+	}
+
+	return count, nil
+}
+
+// Anonymize scrubs the personal data of a client right away, the same way
+// Purge does. The client is deleted as well, the totals are kept.
+func (b *clientRepo) Anonymize(c context.Context, req *user_service.IdRequest) (string, error) {
+	count, err := b.scrub(c, `"id" = $1`, req.Id)
+	if err != nil {
+		return "", fmt.Errorf("failed to anonymize client: %w", err)
+	}
+
+	if count == 0 {
+		return "", errs.NotFound("client with ID %d not found", req.Id)
+	}
+
+	return "anonymized", nil
+}
+
+// scrub scrubs the personal data of the clients matching where, in the table
+// and in the audit log, and deletes their addresses. It returns the number of
+// scrubbed clients.
+func (b *clientRepo) scrub(c context.Context, where string, args ...interface{}) (int64, error) {
+	tx, err := b.db.Begin(c)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(c)
+
+	var ids []string
+	err = tx.QueryRow(c, `
+		WITH "purged" AS (
+			UPDATE "clients"
+			SET
+				"first_name" = '',
+				"last_name" = '',
+				"photo" = '',
+				"phone" = '',
+				"birth_date" = DATE_TRUNC('year', "birth_date"),
+				"deleted_at" = COALESCE("deleted_at", NOW()),
+				"purged_at" = COALESCE("purged_at", NOW())
+			WHERE `+where+`
+			RETURNING "id"
+		), "addresses" AS (
+			DELETE FROM "client_addresses" WHERE "client_id" IN (SELECT "id" FROM "purged")
+		)
+		SELECT COALESCE(ARRAY_AGG("id"::TEXT), '{}') FROM "purged"`,
+		args...,
+	).Scan(&ids)
+	if err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	err = scrubAuditLog(c, tx, "clients", ids, "first_name", "last_name", "photo", "phone", "birth_date")
+	if err != nil {
+		return 0, err
+	}
+
+	// addresses are found by the client of their create entry, deleted
+	// addresses are gone from client_addresses already
+	var addressIds []string
+	err = tx.QueryRow(c, `
+		SELECT COALESCE(ARRAY_AGG(DISTINCT "entity_id"), '{}')
+		FROM "audit_log"
+		WHERE "entity" = 'client_addresses' AND "action" = 'create' AND "after" ->> 'client_id' = ANY($1)`,
+		ids,
+	).Scan(&addressIds)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get client addresses of audit log: %w", err)
+	}
+
+	err = scrubAuditLog(c, tx, "client_addresses", addressIds,
+		"label", "address", "latitude", "longitude", "entrance", "floor", "apartment", "courier_comment")
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(c); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return int64(len(ids)), nil
+}
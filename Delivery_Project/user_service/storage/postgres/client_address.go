@@ -0,0 +1,233 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"user_service/pkg/errs"
+
+	user_service "user_service/genproto"
+	"user_service/pkg/helper"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type clientAddressRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewClientAddress(db *pgxpool.Pool) *clientAddressRepo {
+	return &clientAddressRepo{
+		db: db,
+	}
+}
+
+const clientAddressColumns = `
+			"id",
+			"client_id",
+			"label",
+			"address",
+			"latitude",
+			"longitude",
+			"entrance",
+			"floor",
+			"apartment",
+			"courier_comment",
+			"created_at"::text,
+			"updated_at"::text`
+
+func (b *clientAddressRepo) Create(c context.Context, req *user_service.CreateClientAddressRequest) (*user_service.ClientAddress, error) {
+	query := `
+		INSERT INTO "client_addresses"(
+			"client_id",
+			"label",
+			"address",
+			"latitude",
+			"longitude",
+			"entrance",
+			"floor",
+			"apartment",
+			"courier_comment",
+			"created_by",
+			"created_at"
+		)
+		SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW()
+		WHERE EXISTS (SELECT 1 FROM "clients" WHERE "id" = $1 AND "deleted_at" IS NULL)
+		RETURNING ` + clientAddressColumns
+
+	resp, err := scanClientAddress(b.db.QueryRow(c, query,
+		req.ClientId,
+		req.Label,
+		req.Address,
+		req.Latitude,
+		req.Longitude,
+		req.Entrance,
+		req.Floor,
+		req.Apartment,
+		req.CourierComment,
+		actor(c),
+	))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errs.NotFound("client not found")
+		}
+		return nil, fmt.Errorf("failed to create client address: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (b *clientAddressRepo) Get(c context.Context, req *user_service.IdRequest) (*user_service.ClientAddress, error) {
+	query := `SELECT ` + clientAddressColumns + ` FROM "client_addresses" WHERE "id" = $1 AND "deleted_at" IS NULL`
+
+	resp, err := scanClientAddress(b.db.QueryRow(c, query, req.Id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errs.NotFound("client address not found")
+		}
+		return nil, fmt.Errorf("failed to get client address: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (b *clientAddressRepo) GetList(c context.Context, req *user_service.ListClientAddressRequest) (*user_service.ListClientAddressResponse, error) {
+	var (
+		resp   user_service.ListClientAddressResponse
+		filter = ` WHERE "deleted_at" IS NULL `
+		params = make(map[string]interface{})
+	)
+
+	if req.ClientId != 0 {
+		filter += ` AND "client_id" = :client_id`
+		params["client_id"] = req.ClientId
+	}
+
+	countQuery := `SELECT count(1) FROM "client_addresses" ` + filter
+
+	q, arr := helper.ReplaceQueryParams(countQuery, params)
+	err := b.db.QueryRow(c, q, arr...).Scan(&resp.Count)
+	if err != nil {
+		return nil, fmt.Errorf("error while scanning count %w", err)
+	}
+
+	query := `SELECT ` + clientAddressColumns + ` FROM "client_addresses" ` + filter +
+		` ORDER BY "created_at" DESC LIMIT :limit OFFSET :offset`
+
+	params["limit"] = 10
+	params["offset"] = 0
+
+	if req.Limit > 0 {
+		params["limit"] = req.Limit
+	}
+	if req.Page > 0 {
+		params["offset"] = (req.Page - 1) * req.Limit
+	}
+
+	q, arr = helper.ReplaceQueryParams(query, params)
+	rows, err := b.db.Query(c, q, arr...)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting rows %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		address, err := scanClientAddress(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning client address err: %w", err)
+		}
+		resp.Addresses = append(resp.Addresses, address)
+	}
+
+	return &resp, nil
+}
+
+func (b *clientAddressRepo) Update(c context.Context, req *user_service.UpdateClientAddressRequest) (*user_service.ClientAddress, error) {
+	query := `
+		UPDATE "client_addresses"
+		SET
+			"label" = $1,
+			"address" = $2,
+			"latitude" = $3,
+			"longitude" = $4,
+			"entrance" = $5,
+			"floor" = $6,
+			"apartment" = $7,
+			"courier_comment" = $8,
+			"updated_by" = $10,
+			"updated_at" = NOW()
+		WHERE "id" = $9 AND "deleted_at" IS NULL
+		RETURNING ` + clientAddressColumns
+
+	resp, err := scanClientAddress(b.db.QueryRow(c, query,
+		req.Label,
+		req.Address,
+		req.Latitude,
+		req.Longitude,
+		req.Entrance,
+		req.Floor,
+		req.Apartment,
+		req.CourierComment,
+		req.Id,
+		actor(c),
+	))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errs.NotFound("client address with ID %d not found", req.Id)
+		}
+		return nil, fmt.Errorf("failed to update client address: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (b *clientAddressRepo) Delete(c context.Context, req *user_service.IdRequest) (string, error) {
+	query := `
+		UPDATE "client_addresses"
+		SET
+			"deleted_at" = NOW()
+		WHERE "id" = $1 AND "deleted_at" IS NULL`
+
+	result, err := b.db.Exec(c, query, req.Id)
+	if err != nil {
+		return "", fmt.Errorf("failed to delete client address: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return "", errs.NotFound("client address with ID %d not found", req.Id)
+	}
+
+	return "deleted", nil
+}
+
+func scanClientAddress(row pgx.Row) (*user_service.ClientAddress, error) {
+	var (
+		address   user_service.ClientAddress
+		createdAt sql.NullString
+		updatedAt sql.NullString
+	)
+
+	err := row.Scan(
+		&address.Id,
+		&address.ClientId,
+		&address.Label,
+		&address.Address,
+		&address.Latitude,
+		&address.Longitude,
+		&address.Entrance,
+		&address.Floor,
+		&address.Apartment,
+		&address.CourierComment,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	address.CreatedAt = createdAt.String
+	address.UpdatedAt = updatedAt.String
+
+	return &address, nil
+}
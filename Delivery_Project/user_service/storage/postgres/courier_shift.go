@@ -0,0 +1,366 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"user_service/pkg/errs"
+
+	user_service "user_service/genproto"
+	"user_service/pkg/helper"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type courierShiftRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewCourierShift(db *pgxpool.Pool) *courierShiftRepo {
+	return &courierShiftRepo{
+		db: db,
+	}
+}
+
+// an open shift counts up to now
+const courierShiftColumns = `
+			s."id",
+			s."courier_id",
+			s."branch_id",
+			s."started_at"::text,
+			s."ended_at"::text,
+			s."break_seconds",
+			COALESCE(s."duration_seconds", 0),
+			COALESCE(s."duration_seconds", EXTRACT(EPOCH FROM NOW() - s."started_at")::int) - s."break_seconds"`
+
+// breakSeconds is the time of the current break, if the courier is on one
+const breakSeconds = `CASE WHEN c."availability" = 'break' THEN COALESCE(EXTRACT(EPOCH FROM NOW() - c."availability_changed_at")::int, 0) ELSE 0 END`
+
+func (b *courierShiftRepo) StartShift(c context.Context, req *user_service.IdRequest) (*user_service.CourierShift, error) {
+	tx, err := b.db.Begin(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(c)
+
+	var branchId int32
+	err = tx.QueryRow(c, `SELECT "branch_id" FROM "couriers" WHERE "id" = $1 AND "active" AND "deleted_at" IS NULL FOR UPDATE`, req.Id).Scan(&branchId)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errs.NotFound("courier not found")
+		}
+		return nil, fmt.Errorf("failed to get courier: %w", err)
+	}
+
+	var open bool
+	err = tx.QueryRow(c, `SELECT EXISTS (SELECT 1 FROM "courier_shifts" WHERE "courier_id" = $1 AND "ended_at" IS NULL)`, req.Id).Scan(&open)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check open shift: %w", err)
+	}
+	if open {
+		return nil, errs.Conflict("courier %d already has an open shift", req.Id)
+	}
+
+	query := `
+		INSERT INTO "courier_shifts" AS s (
+			"courier_id",
+			"branch_id",
+			"started_at"
+		) VALUES ($1, $2, NOW())
+		RETURNING ` + courierShiftColumns
+
+	resp, err := scanCourierShift(tx.QueryRow(c, query, req.Id, branchId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to start shift: %w", err)
+	}
+
+	_, err = tx.Exec(c, `UPDATE "couriers" SET "availability" = 'online', "availability_changed_at" = NOW() WHERE "id" = $1`, req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update courier availability: %w", err)
+	}
+
+	if err = tx.Commit(c); err != nil {
+		return nil, fmt.Errorf("failed to commit shift: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (b *courierShiftRepo) EndShift(c context.Context, req *user_service.IdRequest) (*user_service.CourierShift, error) {
+	tx, err := b.db.Begin(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(c)
+
+	_, err = tx.Exec(c, `SELECT 1 FROM "couriers" WHERE "id" = $1 FOR UPDATE`, req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock courier: %w", err)
+	}
+
+	query := `
+		UPDATE "courier_shifts" s
+		SET
+			"ended_at" = NOW(),
+			"break_seconds" = s."break_seconds" + ` + breakSeconds + `,
+			"duration_seconds" = EXTRACT(EPOCH FROM NOW() - s."started_at")::int
+		FROM "couriers" c
+		WHERE c."id" = s."courier_id" AND s."courier_id" = $1 AND s."ended_at" IS NULL
+		RETURNING ` + courierShiftColumns
+
+	resp, err := scanCourierShift(tx.QueryRow(c, query, req.Id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errs.FailedPrecondition("courier %d has no open shift", req.Id)
+		}
+		return nil, fmt.Errorf("failed to end shift: %w", err)
+	}
+
+	_, err = tx.Exec(c, `UPDATE "couriers" SET "availability" = 'offline', "availability_changed_at" = NOW() WHERE "id" = $1`, req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update courier availability: %w", err)
+	}
+
+	if err = tx.Commit(c); err != nil {
+		return nil, fmt.Errorf("failed to commit shift: %w", err)
+	}
+
+	return resp, nil
+}
+
+// SetAvailability switches between online and break inside an open shift,
+// going offline is EndShift
+func (b *courierShiftRepo) SetAvailability(c context.Context, req *user_service.SetAvailabilityRequest) error {
+	if req.Availability != "online" && req.Availability != "break" {
+		return errs.InvalidArgument("invalid availability: %s", req.Availability)
+	}
+
+	tx, err := b.db.Begin(c)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(c)
+
+	var current string
+	err = tx.QueryRow(c, `SELECT "availability" FROM "couriers" WHERE "id" = $1 AND "active" AND "deleted_at" IS NULL FOR UPDATE`, req.CourierId).Scan(&current)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return errs.NotFound("courier not found")
+		}
+		return fmt.Errorf("failed to get courier: %w", err)
+	}
+
+	if current == req.Availability {
+		return nil
+	}
+
+	// leaving a break adds it to the open shift
+	result, err := tx.Exec(c, `
+		UPDATE "courier_shifts" s
+		SET "break_seconds" = s."break_seconds" + `+breakSeconds+`
+		FROM "couriers" c
+		WHERE c."id" = s."courier_id" AND s."courier_id" = $1 AND s."ended_at" IS NULL`,
+		req.CourierId,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update shift breaks: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return errs.FailedPrecondition("courier %d must start a shift first", req.CourierId)
+	}
+
+	_, err = tx.Exec(c, `UPDATE "couriers" SET "availability" = $1, "availability_changed_at" = NOW() WHERE "id" = $2`, req.Availability, req.CourierId)
+	if err != nil {
+		return fmt.Errorf("failed to update courier availability: %w", err)
+	}
+
+	return tx.Commit(c)
+}
+
+func (b *courierShiftRepo) Get(c context.Context, req *user_service.IdRequest) (*user_service.CourierShift, error) {
+	query := `SELECT ` + courierShiftColumns + ` FROM "courier_shifts" s WHERE s."id" = $1`
+
+	resp, err := scanCourierShift(b.db.QueryRow(c, query, req.Id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errs.NotFound("shift not found")
+		}
+		return nil, fmt.Errorf("failed to get shift: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (b *courierShiftRepo) GetList(c context.Context, req *user_service.ListShiftsRequest) (*user_service.ListShiftsResponse, error) {
+	var (
+		resp   user_service.ListShiftsResponse
+		err    error
+		filter string = ` WHERE s."courier_id" = :courier_id `
+		params        = make(map[string]interface{})
+	)
+	params["courier_id"] = req.CourierId
+
+	if req.StartedAtFrom != "" {
+		filter += ` AND s."started_at" >= :started_at_from`
+		params["started_at_from"] = req.StartedAtFrom
+	}
+
+	if req.StartedAtTo != "" {
+		filter += ` AND s."started_at" <= :started_at_to`
+		params["started_at_to"] = req.StartedAtTo
+	}
+
+	countQuery := `
+		SELECT
+			count(1),
+			COALESCE(SUM(s."duration_seconds" - s."break_seconds"), 0)
+		FROM "courier_shifts" s ` + filter
+	q, arr := helper.ReplaceQueryParams(countQuery, params)
+	err = b.db.QueryRow(c, q, arr...).Scan(&resp.Count, &resp.WorkedSeconds)
+	if err != nil {
+		return nil, fmt.Errorf("error while scanning count %w", err)
+	}
+
+	query := `SELECT ` + courierShiftColumns + ` FROM "courier_shifts" s ` + filter +
+		` ORDER BY s."started_at" DESC LIMIT :limit OFFSET :offset`
+
+	params["limit"] = 10
+	params["offset"] = 0
+
+	if req.Limit > 0 {
+		params["limit"] = req.Limit
+	}
+	if req.Page > 0 {
+		params["offset"] = (req.Page - 1) * req.Limit
+	}
+
+	q, arr = helper.ReplaceQueryParams(query, params)
+	rows, err := b.db.Query(c, q, arr...)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting rows %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		shift, err := scanCourierShift(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning shift err: %w", err)
+		}
+		resp.Shifts = append(resp.Shifts, shift)
+	}
+
+	return &resp, nil
+}
+
+func (b *courierShiftRepo) ListOnlineCouriers(c context.Context, req *user_service.ListOnlineCouriersRequest) (*user_service.ListCouriersResponse, error) {
+	var resp user_service.ListCouriersResponse
+
+	query := `
+	SELECT 
+	        id,
+	        first_name,
+	        last_name,
+	        phone,
+			active,
+	        login,
+			max_order_count,
+			branch_id,
+	    	created_at::text,
+		    updated_at::text,
+			availability
+	FROM couriers
+	WHERE "active" AND "deleted_at" IS NULL AND "availability" = 'online' AND ($1 = 0 OR "branch_id" = $1)
+	ORDER BY "availability_changed_at"`
+
+	rows, err := b.db.Query(c, query, req.BranchId)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting rows %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			courier   user_service.Couriers
+			updatedAt sql.NullString
+		)
+		err = rows.Scan(
+			&courier.Id,
+			&courier.Firstname,
+			&courier.Lastname,
+			&courier.Phone,
+			&courier.Active,
+			&courier.Login,
+			&courier.MaxOrderCount,
+			&courier.BranchId,
+			&courier.CreatedAt,
+			&updatedAt,
+			&courier.Availability,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning courier err: %w", err)
+		}
+
+		if updatedAt.Valid {
+			courier.UpdatedAt = updatedAt.String
+		}
+		resp.Couriers = append(resp.Couriers, &courier)
+	}
+	resp.Count = int32(len(resp.Couriers))
+
+	return &resp, nil
+}
+
+func scanCourierShift(row pgx.Row) (*user_service.CourierShift, error) {
+	var (
+		shift     user_service.CourierShift
+		startedAt sql.NullString
+		endedAt   sql.NullString
+	)
+
+	err := row.Scan(
+		&shift.Id,
+		&shift.CourierId,
+		&shift.BranchId,
+		&startedAt,
+		&endedAt,
+		&shift.BreakSeconds,
+		&shift.DurationSeconds,
+		&shift.WorkedSeconds,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if startedAt.Valid {
+		shift.StartedAt = startedAt.String
+	}
+	if endedAt.Valid {
+		shift.EndedAt = endedAt.String
+	}
+
+	return &shift, nil
+}
+
+// CountByAvailability counts the active couriers by availability, used by the metrics
+func (b *courierShiftRepo) CountByAvailability(c context.Context) (map[string]int64, error) {
+	rows, err := b.db.Query(c, `SELECT "availability", COUNT(*) FROM "couriers" WHERE "active" AND "deleted_at" IS NULL GROUP BY "availability"`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count couriers by availability: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int64)
+	for rows.Next() {
+		var (
+			availability string
+			count        int64
+		)
+		if err = rows.Scan(&availability, &count); err != nil {
+			return nil, fmt.Errorf("error while scanning courier availability count err: %w", err)
+		}
+		counts[availability] = count
+	}
+
+	return counts, rows.Err()
+}
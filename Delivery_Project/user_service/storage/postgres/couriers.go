@@ -4,11 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
+	"user_service/pkg/errs"
 
 	user_service "user_service/genproto"
 	"user_service/pkg/helper"
 
-	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
@@ -33,9 +35,10 @@ func (b *courierRepo) Create(c context.Context, req *user_service.CreateCouriers
 		login,
 		password,
 		max_order_count,
+		created_by,
 		created_at
 	  ) VALUES (
-		$1, $2, $3, $4, $5, $6,$7, now()
+		$1, $2, $3, $4, $5, $6,$7, $8, now()
 	  ) RETURNING id`
 
 	var id int
@@ -47,6 +50,7 @@ func (b *courierRepo) Create(c context.Context, req *user_service.CreateCouriers
 		req.Login,
 		req.Password,
 		req.MaxOrderCount,
+		actor(c),
 	).Scan(&id)
 
 	if err != nil {
@@ -72,7 +76,8 @@ func (b *courierRepo) Get(c context.Context, req *user_service.IdRequest) (resp
 		password,
 		max_order_count,
 		created_at,
-		updated_at 
+		updated_at,
+		availability
     FROM couriers 
     WHERE id = $1 AND "active" AND "deleted_at" IS NULL;`
 
@@ -90,10 +95,11 @@ func (b *courierRepo) Get(c context.Context, req *user_service.IdRequest) (resp
 		&courier.MaxOrderCount,
 		&createdAt,
 		&updatedAt,
+		&courier.Availability,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("courier not found")
+			return nil, errs.NotFound("courier not found")
 		}
 		return nil, fmt.Errorf("failed to get courier: %w", err)
 	}
@@ -109,6 +115,16 @@ func (b *courierRepo) Get(c context.Context, req *user_service.IdRequest) (resp
 }
 
 func (b *courierRepo) GetList(c context.Context, req *user_service.ListCouriersRequest) (*user_service.ListCouriersResponse, error) {
+	return b.list(c, req, `"deleted_at" IS NULL AND "active"`)
+}
+
+// GetDeletedList lists deleted couriers that can still be restored
+func (b *courierRepo) GetDeletedList(c context.Context, req *user_service.ListDeletedRequest) (*user_service.ListCouriersResponse, error) {
+	return b.list(c, &user_service.ListCouriersRequest{Limit: req.Limit, Page: req.Page}, `"deleted_at" IS NOT NULL AND "purged_at" IS NULL`)
+}
+
+// list lists the couriers of scope matching req
+func (b *courierRepo) list(c context.Context, req *user_service.ListCouriersRequest, scope string) (*user_service.ListCouriersResponse, error) {
 
 	var (
 		updatedAt sql.NullString
@@ -134,7 +150,7 @@ func (b *courierRepo) GetList(c context.Context, req *user_service.ListCouriersR
 		params["created_at_to"] = req.CreatedAtTo
 	}
 
-	countQuery := `SELECT count(1) FROM "couriers" WHERE "deleted_at" IS NULL AND "active"` + filter
+	countQuery := `SELECT count(1) FROM "couriers" WHERE ` + scope + filter
 	q, arr := helper.ReplaceQueryParams(countQuery, params)
 	err = b.db.QueryRow(c, q, arr...).Scan(
 		&resp.Count,
@@ -156,8 +172,9 @@ func (b *courierRepo) GetList(c context.Context, req *user_service.ListCouriersR
 			max_order_count,
 			branch_id,
 	    	created_at::text,
-		    updated_at 
-	FROM couriers  where "active" and "deleted_at" is null` + filter
+		    updated_at,
+			availability
+	FROM couriers  where ` + scope + filter
 
 	query += " ORDER BY created_at DESC LIMIT :limit OFFSET :offset"
 
@@ -192,6 +209,7 @@ func (b *courierRepo) GetList(c context.Context, req *user_service.ListCouriersR
 			&courier.BranchId,
 			&courier.CreatedAt,
 			&updatedAt,
+			&courier.Availability,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("error while scanning courier err: %w", err)
@@ -222,11 +240,12 @@ func (b *courierRepo) Update(c context.Context, req *user_service.UpdateCouriers
 				"login"=$5,
 				"password"=$6,
 				"max_order_count"=$7,
+				"updated_by"=$9,
 				"updated_at" = NOW() 
 				WHERE id = $8`
 
 	result, err := b.db.Exec(
-		context.Background(),
+		c,
 		query,
 		req.Firstname,
 		req.Lastname,
@@ -236,6 +255,7 @@ func (b *courierRepo) Update(c context.Context, req *user_service.UpdateCouriers
 		req.Password,
 		req.MaxOrderCount,
 		req.Id,
+		actor(c),
 	)
 
 	if err != nil {
@@ -243,7 +263,7 @@ func (b *courierRepo) Update(c context.Context, req *user_service.UpdateCouriers
 	}
 
 	if result.RowsAffected() == 0 {
-		return "", fmt.Errorf("courier with ID %d not found", req.Id)
+		return "", errs.NotFound("courier with ID %d not found", req.Id)
 	}
 
 	return fmt.Sprintf("courier with ID %d updated", req.Id), nil
@@ -259,7 +279,7 @@ func (b *courierRepo) Delete(c context.Context, req *user_service.IdRequest) (re
 				WHERE id = $1 AND "deleted_at" IS NULL and "active"`
 
 	result, err := b.db.Exec(
-		context.Background(),
+		c,
 		query,
 		req.Id,
 	)
@@ -269,7 +289,7 @@ func (b *courierRepo) Delete(c context.Context, req *user_service.IdRequest) (re
 	}
 
 	if result.RowsAffected() == 0 {
-		return "", fmt.Errorf("courier with ID %d not found", req.Id)
+		return "", errs.NotFound("courier with ID %d not found", req.Id)
 	}
 
 	return "deleted", nil
@@ -317,3 +337,69 @@ func (c *courierRepo) GetCourierByUserName(ctx context.Context, req *user_servic
 
 	return &courier, nil
 }
+
+// Restore undoes the delete of a courier that is not purged yet
+func (b *courierRepo) Restore(c context.Context, req *user_service.IdRequest) (string, error) {
+	result, err := b.db.Exec(c, `
+		UPDATE "couriers"
+		SET
+			"active" = true,
+			"deleted_at" = NULL,
+			"updated_at" = NOW(),
+			"updated_by" = $2
+		WHERE "id" = $1 AND "deleted_at" IS NOT NULL AND "purged_at" IS NULL`,
+		req.Id,
+		actor(c),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to restore courier: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return "", errs.NotFound("deleted courier with ID %d not found", req.Id)
+	}
+
+	return "restored", nil
+}
+
+// Purge scrubs the personal data and the login of couriers deleted longer
+// than retention ago, in the table and in the audit log. Orders, cash and
+// earnings refer to couriers by id, so the rows are kept.
+func (b *courierRepo) Purge(c context.Context, retention time.Duration) (int64, error) {
+	tx, err := b.db.Begin(c)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(c)
+
+	var ids []string
+	err = tx.QueryRow(c, `
+		WITH "purged" AS (
+			UPDATE "couriers"
+			SET
+				"first_name" = '',
+				"last_name" = '',
+				"phone" = '',
+				"login" = 'purged-' || "id",
+				"password" = '',
+				"purged_at" = NOW()
+			WHERE "deleted_at" <= NOW() - $1 * INTERVAL '1 second' AND "purged_at" IS NULL
+			RETURNING "id"
+		)
+		SELECT COALESCE(ARRAY_AGG("id"::TEXT), '{}') FROM "purged"`,
+		int64(retention.Seconds()),
+	).Scan(&ids)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge couriers: %w", err)
+	}
+
+	if err = scrubAuditLog(c, tx, "couriers", ids, "first_name", "last_name", "phone", "login"); err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(c); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return int64(len(ids)), nil
+}
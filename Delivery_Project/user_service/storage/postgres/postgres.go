@@ -15,6 +15,10 @@ type strg struct {
 	user    *userRepo
 	courier *courierRepo
 	client  *clientRepo
+	bonus   *bonusRepo
+	shift   *courierShiftRepo
+	address *clientAddressRepo
+	audit   *auditRepo
 }
 
 func NewStorage(ctx context.Context, cfg config.Config) (storage.StorageI, error) {
@@ -34,6 +38,7 @@ func NewStorage(ctx context.Context, cfg config.Config) (storage.StorageI, error
 	}
 
 	config.MaxConns = cfg.PostgresMaxConnections
+	config.BeforeAcquire = setAuditSession
 	pool, err := pgxpool.ConnectConfig(ctx, config)
 	if err != nil {
 		fmt.Println("ConnectConfig:", err.Error())
@@ -45,6 +50,18 @@ func NewStorage(ctx context.Context, cfg config.Config) (storage.StorageI, error
 	}, nil
 }
 
+func (d *strg) Ping(ctx context.Context) error {
+	return d.db.Ping(ctx)
+}
+
+func (d *strg) Stat() *pgxpool.Stat {
+	return d.db.Stat()
+}
+
+func (d *strg) CloseDB() {
+	d.db.Close()
+}
+
 func (d *strg) Branch() storage.BranchI {
 	if d.branch == nil {
 		d.branch = NewBranch(d.db)
@@ -70,3 +87,31 @@ func (d *strg) Clients() storage.ClientsI {
 	}
 	return d.client
 }
+
+func (d *strg) Bonus() storage.BonusI {
+	if d.bonus == nil {
+		d.bonus = NewBonus(d.db)
+	}
+	return d.bonus
+}
+
+func (d *strg) CourierShift() storage.CourierShiftI {
+	if d.shift == nil {
+		d.shift = NewCourierShift(d.db)
+	}
+	return d.shift
+}
+
+func (d *strg) ClientAddress() storage.ClientAddressI {
+	if d.address == nil {
+		d.address = NewClientAddress(d.db)
+	}
+	return d.address
+}
+
+func (d *strg) Audit() storage.AuditI {
+	if d.audit == nil {
+		d.audit = NewAudit(d.db)
+	}
+	return d.audit
+}
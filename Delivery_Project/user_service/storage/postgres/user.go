@@ -4,11 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
+	"user_service/pkg/errs"
 
 	user_service "user_service/genproto"
 	"user_service/pkg/helper"
 
-	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
@@ -32,9 +34,10 @@ func (b *userRepo) Create(c context.Context, req *user_service.CreateUsersReques
 		phone,
 		login,
 		password,
+		created_by,
 		created_at
 	  ) VALUES (
-		$1, $2, $3, $4, $5, $6, now()
+		$1, $2, $3, $4, $5, $6, $7, now()
 	  ) RETURNING id`
 
 	var id int
@@ -45,6 +48,7 @@ func (b *userRepo) Create(c context.Context, req *user_service.CreateUsersReques
 		req.Phone,
 		req.Login,
 		req.Password,
+		actor(c),
 	).Scan(&id)
 
 	if err != nil {
@@ -89,7 +93,7 @@ func (b *userRepo) Get(c context.Context, req *user_service.IdRequest) (resp *us
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("user not found")
+			return nil, errs.NotFound("user not found")
 		}
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
@@ -105,6 +109,16 @@ func (b *userRepo) Get(c context.Context, req *user_service.IdRequest) (resp *us
 }
 
 func (b *userRepo) GetList(c context.Context, req *user_service.ListUsersRequest) (*user_service.ListUsersResponse, error) {
+	return b.list(c, req, `"deleted_at" IS NULL AND "active"`)
+}
+
+// GetDeletedList lists deleted users that can still be restored
+func (b *userRepo) GetDeletedList(c context.Context, req *user_service.ListDeletedRequest) (*user_service.ListUsersResponse, error) {
+	return b.list(c, &user_service.ListUsersRequest{Limit: req.Limit, Page: req.Page}, `"deleted_at" IS NOT NULL`)
+}
+
+// list lists the users of scope matching req
+func (b *userRepo) list(c context.Context, req *user_service.ListUsersRequest, scope string) (*user_service.ListUsersResponse, error) {
 
 	var (
 		updatedAt sql.NullString
@@ -131,7 +145,7 @@ func (b *userRepo) GetList(c context.Context, req *user_service.ListUsersRequest
 		params["created_at_to"] = req.CreatedAtTo
 	}
 
-	countQuery := `SELECT count(1) FROM "users" WHERE "deleted_at" IS NULL AND "active"` + filter
+	countQuery := `SELECT count(1) FROM "users" WHERE ` + scope + filter
 	q, arr := helper.ReplaceQueryParams(countQuery, params)
 	err = b.db.QueryRow(c, q, arr...).Scan(
 		&resp.Count,
@@ -153,7 +167,7 @@ func (b *userRepo) GetList(c context.Context, req *user_service.ListUsersRequest
 	        password,
 	    	created_at::text,
 		    updated_at 
-	FROM users  where "active" and "deleted_at" is null` + filter
+	FROM users  where ` + scope + filter
 
 	query += " ORDER BY created_at DESC LIMIT :limit OFFSET :offset"
 
@@ -216,11 +230,12 @@ func (b *userRepo) Update(c context.Context, req *user_service.UpdateUsersReques
 				"phone" = $4,
 				"login"=$5,
 				"password"=$6,
+				"updated_by"=$8,
 				"updated_at" = NOW() 
 				WHERE id = $7`
 
 	result, err := b.db.Exec(
-		context.Background(),
+		c,
 		query,
 		req.Firstname,
 		req.Lastname,
@@ -229,6 +244,7 @@ func (b *userRepo) Update(c context.Context, req *user_service.UpdateUsersReques
 		req.Login,
 		req.Password,
 		req.Id,
+		actor(c),
 	)
 
 	if err != nil {
@@ -236,7 +252,7 @@ func (b *userRepo) Update(c context.Context, req *user_service.UpdateUsersReques
 	}
 
 	if result.RowsAffected() == 0 {
-		return "", fmt.Errorf("users with ID %d not found", req.Id)
+		return "", errs.NotFound("users with ID %d not found", req.Id)
 	}
 
 	return fmt.Sprintf("users with ID %d updated", req.Id), nil
@@ -252,7 +268,7 @@ func (b *userRepo) Delete(c context.Context, req *user_service.IdRequest) (resp
 				WHERE id = $1  and "active"`
 
 	result, err := b.db.Exec(
-		context.Background(),
+		c,
 		query,
 		req.Id,
 	)
@@ -262,7 +278,7 @@ func (b *userRepo) Delete(c context.Context, req *user_service.IdRequest) (resp
 	}
 
 	if result.RowsAffected() == 0 {
-		return "", fmt.Errorf("users with ID %d not found", req.Id)
+		return "", errs.NotFound("users with ID %d not found", req.Id)
 	}
 
 	return "deleted", nil
@@ -303,3 +319,61 @@ func (c *userRepo) GetUserByUserName(ctx context.Context, req *user_service.GetB
 
 	return &user, nil
 }
+
+// Restore undoes the delete of a user that is not purged yet
+func (b *userRepo) Restore(c context.Context, req *user_service.IdRequest) (string, error) {
+	result, err := b.db.Exec(c, `
+		UPDATE "users"
+		SET
+			"active" = true,
+			"deleted_at" = NULL,
+			"updated_at" = NOW(),
+			"updated_by" = $2
+		WHERE "id" = $1 AND "deleted_at" IS NOT NULL`,
+		req.Id,
+		actor(c),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to restore user: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return "", errs.NotFound("deleted user with ID %d not found", req.Id)
+	}
+
+	return "restored", nil
+}
+
+// Purge deletes users deleted longer than retention ago and scrubs their
+// personal data from the audit log, nothing refers to users by id
+func (b *userRepo) Purge(c context.Context, retention time.Duration) (int64, error) {
+	tx, err := b.db.Begin(c)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(c)
+
+	var ids []string
+	err = tx.QueryRow(c, `
+		WITH "purged" AS (
+			DELETE FROM "users"
+			WHERE "deleted_at" <= NOW() - $1 * INTERVAL '1 second'
+			RETURNING "id"
+		)
+		SELECT COALESCE(ARRAY_AGG("id"::TEXT), '{}') FROM "purged"`,
+		int64(retention.Seconds()),
+	).Scan(&ids)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge users: %w", err)
+	}
+
+	if err = scrubAuditLog(c, tx, "users", ids, "first_name", "last_name", "phone", "login"); err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(c); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return int64(len(ids)), nil
+}
@@ -2,14 +2,25 @@ package storage
 
 import (
 	"context"
+	"time"
 	pb "user_service/genproto"
+
+	"github.com/jackc/pgx/v4/pgxpool"
 )
 
 type StorageI interface {
+	Ping(ctx context.Context) error
+	Stat() *pgxpool.Stat
+	CloseDB()
+
 	Branch() BranchI
 	Users() UsersI
 	Couriers() CouriersI
 	Clients() ClientsI
+	Bonus() BonusI
+	CourierShift() CourierShiftI
+	ClientAddress() ClientAddressI
+	Audit() AuditI
 }
 
 type BranchI interface {
@@ -19,6 +30,9 @@ type BranchI interface {
 	GetListActive(context.Context, *pb.ListActiveBranchRequest) (*pb.ListBranchResponse, error)
 	Update(context.Context, *pb.UpdateBranchRequest) (string, error)
 	Delete(context.Context, *pb.IdRequest) (string, error)
+	Restore(context.Context, *pb.IdRequest) (string, error)
+	GetDeletedList(context.Context, *pb.ListDeletedRequest) (*pb.ListBranchResponse, error)
+	Purge(ctx context.Context, retention time.Duration) (int64, error)
 }
 
 type UsersI interface {
@@ -27,6 +41,9 @@ type UsersI interface {
 	GetList(context.Context, *pb.ListUsersRequest) (*pb.ListUsersResponse, error)
 	Update(context.Context, *pb.UpdateUsersRequest) (string, error)
 	Delete(context.Context, *pb.IdRequest) (string, error)
+	Restore(context.Context, *pb.IdRequest) (string, error)
+	GetDeletedList(context.Context, *pb.ListDeletedRequest) (*pb.ListUsersResponse, error)
+	Purge(ctx context.Context, retention time.Duration) (int64, error)
 
 	GetUserByUserName(context.Context, *pb.GetByUserName) (*pb.Users, error)
 }
@@ -36,15 +53,56 @@ type CouriersI interface {
 	GetList(context.Context, *pb.ListCouriersRequest) (*pb.ListCouriersResponse, error)
 	Update(context.Context, *pb.UpdateCouriersRequest) (string, error)
 	Delete(context.Context, *pb.IdRequest) (string, error)
+	Restore(context.Context, *pb.IdRequest) (string, error)
+	GetDeletedList(context.Context, *pb.ListDeletedRequest) (*pb.ListCouriersResponse, error)
+	Purge(ctx context.Context, retention time.Duration) (int64, error)
 
 	GetCourierByUserName(context.Context, *pb.GetByUserName) (*pb.Couriers, error)
 }
 type ClientsI interface {
 	Create(context.Context, *pb.CreateClientsRequest) (*pb.Response, error)
 	Get(context.Context, *pb.IdRequest) (*pb.Clients, error)
+	GetWithDeleted(context.Context, *pb.IdRequest) (*pb.Clients, error)
 	GetList(context.Context, *pb.ListClientsRequest) (*pb.ListClientsResponse, error)
 	Update(context.Context, *pb.UpdateClientsRequest) (string, error)
 	Delete(context.Context, *pb.IdRequest) (string, error)
+	Restore(context.Context, *pb.IdRequest) (string, error)
+	GetDeletedList(context.Context, *pb.ListDeletedRequest) (*pb.ListClientsResponse, error)
+	Purge(ctx context.Context, retention time.Duration) (int64, error)
+	Anonymize(context.Context, *pb.IdRequest) (string, error)
 
 	UpdateOrder(context.Context, *pb.UpdateClientsOrderRequest) (string, error)
 }
+
+type BonusI interface {
+	Accrue(ctx context.Context, req *pb.AccrueBonusRequest, amount float64, expiresAt time.Time) (*pb.BonusTransaction, error)
+	Spend(context.Context, *pb.SpendBonusRequest) (*pb.BonusTransaction, error)
+	Refund(ctx context.Context, req *pb.IdRequest, expiresAt time.Time) (*pb.BonusTransaction, error)
+	RefundOrder(ctx context.Context, req *pb.RefundOrderRequest, expiresAt time.Time) (*pb.BonusTransaction, error)
+	GetBalance(context.Context, *pb.IdRequest) (*pb.BonusBalance, error)
+	GetStatement(context.Context, *pb.BonusStatementRequest) (*pb.BonusStatementResponse, error)
+
+	ExpirePoints(context.Context) (int64, error)
+}
+
+type CourierShiftI interface {
+	StartShift(context.Context, *pb.IdRequest) (*pb.CourierShift, error)
+	EndShift(context.Context, *pb.IdRequest) (*pb.CourierShift, error)
+	SetAvailability(context.Context, *pb.SetAvailabilityRequest) error
+	Get(context.Context, *pb.IdRequest) (*pb.CourierShift, error)
+	GetList(context.Context, *pb.ListShiftsRequest) (*pb.ListShiftsResponse, error)
+	ListOnlineCouriers(context.Context, *pb.ListOnlineCouriersRequest) (*pb.ListCouriersResponse, error)
+	CountByAvailability(ctx context.Context) (map[string]int64, error)
+}
+
+type ClientAddressI interface {
+	Create(context.Context, *pb.CreateClientAddressRequest) (*pb.ClientAddress, error)
+	Get(context.Context, *pb.IdRequest) (*pb.ClientAddress, error)
+	GetList(context.Context, *pb.ListClientAddressRequest) (*pb.ListClientAddressResponse, error)
+	Update(context.Context, *pb.UpdateClientAddressRequest) (*pb.ClientAddress, error)
+	Delete(context.Context, *pb.IdRequest) (string, error)
+}
+
+type AuditI interface {
+	List(context.Context, *pb.ListAuditRequest) (*pb.ListAuditResponse, error)
+}